@@ -0,0 +1,64 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var createKeyCmd = &cobra.Command{
+	Use:           "create <name>",
+	Aliases:       []string{"new"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Create a named admin api key",
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.CreateAdminKeyV1WithResponse(c.Context(), api.CreateAdminKeyV1JSONRequestBody{
+			Name: args[0],
+		})
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON201 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.CreateAdminKeyResponse{*resp.JSON201},
+				[]tables.Column[api.CreateAdminKeyResponse]{
+					{Header: "Id", Cell: func(r api.CreateAdminKeyResponse) any { return r.Id }},
+					{Header: "Key", Cell: func(r api.CreateAdminKeyResponse) any { return r.Key }},
+				},
+				tables.WithTitle("Admin Key"),
+				tables.WithStyle(table.StyleLight),
+			))
+			fmt.Fprintln(c.OutOrStdout(), color.YellowString("WARN: the key is only shown once; store it somewhere safe"))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	flags.SetupAuthFlags(createKeyCmd, authFlags)
+	err := viper.BindPFlags(createKeyCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var listKeysCmd = &cobra.Command{
+	Use:           "list",
+	Aliases:       []string{"ls"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "List the named admin api keys (the keys themselves are never shown)",
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.GetAdminKeysV1WithResponse(c.Context())
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				*resp.JSON200,
+				keyColumns,
+				tables.WithTitle("Admin Keys"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	flags.SetupAuthFlags(listKeysCmd, authFlags)
+	err := viper.BindPFlags(listKeysCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
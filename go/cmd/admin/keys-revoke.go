@@ -0,0 +1,67 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var revokeKeyId uuid.UUID
+
+var revokeKeyCmd = &cobra.Command{
+	Use:           "revoke <key-id>",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Revoke a named admin api key (it can no longer be used to authenticate)",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("\"%v\" is not a valid key id (%v)", args[0], err)
+		}
+		revokeKeyId = id
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.RevokeAdminKeyV1WithResponse(c.Context(), revokeKeyId)
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.AdminKeyObject{*resp.JSON200},
+				keyColumns,
+				tables.WithTitle("Admin Key"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	flags.SetupAuthFlags(revokeKeyCmd, authFlags)
+	err := viper.BindPFlags(revokeKeyCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
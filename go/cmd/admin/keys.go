@@ -0,0 +1,44 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package admin
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var keysCmd = &cobra.Command{
+	Use:           "keys",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Manage named admin api keys",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	keysCmd.AddCommand(listKeysCmd)
+	keysCmd.AddCommand(createKeyCmd)
+	keysCmd.AddCommand(revokeKeyCmd)
+}
+
+// keyColumns are the columns shown for an admin key
+var keyColumns = []tables.Column[api.AdminKeyObject]{
+	{Header: "Id", Cell: func(r api.AdminKeyObject) any { return r.Id }},
+	{Header: "Name", Cell: func(r api.AdminKeyObject) any { return r.Display }},
+	{Header: "CreatedAt", Cell: func(r api.AdminKeyObject) any { return r.CreatedAt }},
+	{Header: "RevokedAt", Cell: func(r api.AdminKeyObject) any {
+		if r.RevokedAt == nil {
+			return "never"
+		}
+		return *r.RevokedAt
+	}},
+}
@@ -0,0 +1,144 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"io"
+	"os"
+)
+
+var (
+	exportParams   *api.GetAuditLogV1Params
+	exportFile     string
+	exportAfterId  int64
+	exportPageSize int
+)
+
+var exportCmd = &cobra.Command{
+	Use:           "export",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Export audit events as JSON lines (one event per line, in commit order)",
+	Long: `Export audit events as JSON lines (one event per line, in commit order).
+
+The whole log is paged through from --after-id on. Without it, an export to a file picks up
+after the last event already in the file, so repeated runs append only new events.`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if exportPageSize < 1 {
+			return errors.New("--page-size must be positive")
+		} else if cmd.Flags().Changed("after-id") && exportAfterId < 0 {
+			return errors.New("--after-id must not be negative")
+		}
+		params, err := parseFilters()
+		if params != nil {
+			params.Limit = &exportPageSize
+		}
+		exportParams = params
+		return err
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		var out io.Writer = c.OutOrStdout()
+		if exportFile != "" {
+			if !c.Flags().Changed("after-id") {
+				if id, err := lastEventId(exportFile); err != nil {
+					return err
+				} else {
+					exportAfterId = id
+				}
+			}
+			f, err := os.OpenFile(exportFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+			if err != nil {
+				return fmt.Errorf("unable to open %s: %v", exportFile, err)
+			}
+			defer f.Close()
+			out = f
+		}
+
+		client, _ := api.FromFlags(authFlags)
+		encoder := json.NewEncoder(out)
+		exportParams.AfterId = &exportAfterId
+		for {
+			resp, err := client.GetAuditLogV1WithResponse(c.Context(), exportParams)
+			if err != nil {
+				return fmt.Errorf("request failed: %v", err.Error())
+			}
+
+			if resp.JSON200 == nil {
+				return errors.New(api.GetAPIError(resp))
+			}
+
+			for _, event := range *resp.JSON200 {
+				if err := encoder.Encode(event); err != nil {
+					return fmt.Errorf("unable to write event %d: %v", event.Id, err)
+				}
+				exportAfterId = event.Id
+			}
+
+			// a short page is the end of the (committed) log
+			if len(*resp.JSON200) < exportPageSize {
+				return nil
+			}
+		}
+	},
+}
+
+// lastEventId returns the id of the last event in an export file (0 if there is none)
+func lastEventId(name string) (int64, error) {
+	f, err := os.Open(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("unable to open %s: %v", name, err)
+	}
+	defer f.Close()
+
+	var last []byte
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) != 0 {
+			last = append(last[:0], line...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("unable to read %s: %v", name, err)
+	} else if last == nil {
+		return 0, nil
+	}
+
+	var event struct {
+		Id *int64 `json:"id"`
+	}
+	if err := json.Unmarshal(last, &event); err != nil || event.Id == nil {
+		return 0, fmt.Errorf("unable to resume from %s: the last line is not an audit event (pass --after-id)", name)
+	}
+	return *event.Id, nil
+}
+
+func init() {
+	setupFilterFlags(exportCmd)
+	exportCmd.Flags().StringVarP(&exportFile, "file", "f", "", "append to a file instead of writing to stdout")
+	exportCmd.Flags().Int64Var(&exportAfterId, "after-id", 0, "only events after this one (default: the last event in --file, or the start of the log)")
+	exportCmd.Flags().IntVar(&exportPageSize, "page-size", 1000, "number of events per request")
+	flags.SetupAuthFlags(exportCmd, authFlags)
+	err := viper.BindPFlags(exportCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var listParams *api.GetAuditLogV1Params
+
+var listCmd = &cobra.Command{
+	Use:           "list",
+	Aliases:       []string{"ls"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "List audit events (oldest first)",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		params, err := parseFilters()
+		listParams = params
+		return err
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.GetAuditLogV1WithResponse(c.Context(), listParams)
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			if len(*resp.JSON200) == 0 {
+				fmt.Fprintln(c.OutOrStdout(), "no audit events found")
+			} else {
+				fmt.Fprintln(c.OutOrStdout(), tables.Build(
+					*resp.JSON200,
+					[]tables.Column[api.AuditEventObject]{
+						{Header: "Time", Cell: func(r api.AuditEventObject) any { return r.CreatedAt }},
+						{Header: "Actor", Cell: func(r api.AuditEventObject) any { return fmt.Sprintf("%s:%s", r.ActorType, r.Actor) }},
+						{Header: "Action", Cell: func(r api.AuditEventObject) any { return r.Action }},
+						{Header: "Resource", Cell: func(r api.AuditEventObject) any { return r.Resource }},
+						{Header: "EnvironmentId", Cell: func(r api.AuditEventObject) any {
+							if r.EnvironmentId == nil {
+								return ""
+							}
+							return *r.EnvironmentId
+						}},
+						{Header: "SourceIp", Cell: func(r api.AuditEventObject) any {
+							if r.SourceIp == nil {
+								return ""
+							}
+							return *r.SourceIp
+						}},
+						{Header: "Details", Cell: func(r api.AuditEventObject) any {
+							if r.Details == nil {
+								return ""
+							}
+							details, _ := json.Marshal(*r.Details)
+							return string(details)
+						}},
+					},
+					tables.WithTitle("Audit Log"),
+					tables.WithStyle(table.StyleLight),
+				))
+			}
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	setupFilterFlags(listCmd)
+	listCmd.Flags().IntVar(&limit, "limit", 1000, "maximum number of (most recent) events")
+	flags.SetupAuthFlags(listCmd, authFlags)
+	err := viper.BindPFlags(listCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package audit
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/server"
+	"time"
+)
+
+var (
+	authFlags        *flags.AuthFlags = flags.GetAuthFlags()
+	sinceStr         string
+	untilStr         string
+	actor            string
+	action           string
+	environmentIdStr string
+	limit            int
+)
+
+var Command = &cobra.Command{
+	Use:           "audit",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Read the audit log of a ProjConf server instance",
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return server.IsReady(authFlags.Url)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	Command.AddCommand(listCmd)
+	Command.AddCommand(exportCmd)
+}
+
+// setupFilterFlags adds the flags to filter audit events by
+func setupFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&sinceStr, "since", "", "only events at or after a RFC 3339 timestamp, or a duration ago (e.g. 24h)")
+	cmd.Flags().StringVar(&untilStr, "until", "", "only events before a RFC 3339 timestamp, or a duration ago (e.g. 1h)")
+	cmd.Flags().StringVar(&actor, "actor", "", "only events of an actor (admin key name, \"bootstrap\" or client id)")
+	cmd.Flags().StringVar(&action, "action", "", "only events of an action (e.g. delete or http.get)")
+	cmd.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "only events concerning an environment")
+}
+
+// parseTime parses a RFC 3339 timestamp, or a duration relative to now
+func parseTime(flag string, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return &ts, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		ts := time.Now().Add(-d)
+		return &ts, nil
+	}
+	return nil, fmt.Errorf("--%s: \"%v\" is not a valid RFC 3339 timestamp or (positive) duration", flag, value)
+}
+
+// parseFilters builds the audit log query from the filter flags
+func parseFilters() (*api.GetAuditLogV1Params, error) {
+	params := &api.GetAuditLogV1Params{Limit: &limit}
+
+	if ts, err := parseTime("since", sinceStr); err != nil {
+		return nil, err
+	} else {
+		params.Since = ts
+	}
+
+	if ts, err := parseTime("until", untilStr); err != nil {
+		return nil, err
+	} else {
+		params.Until = ts
+	}
+
+	if actor != "" {
+		params.Actor = &actor
+	}
+	if action != "" {
+		params.Action = &action
+	}
+
+	if environmentIdStr != "" {
+		id, err := uuid.Parse(environmentIdStr)
+		if err != nil {
+			return nil, fmt.Errorf("\"%v\" is not a valid environment id (%v)", environmentIdStr, err)
+		}
+		params.EnvironmentId = &id
+	}
+
+	return params, nil
+}
@@ -37,24 +37,31 @@ var listClientsCmd = &cobra.Command{
 	RunE: func(c *cobra.Command, args []string) error {
 
 		client, _ := api.FromFlags(authFlags)
-		resp, err := client.GetClientsV1WithResponse(c.Context(), environmentId)
+		clients, err := api.Paginate(func(limit api.Limit, offset api.Offset) ([]api.ClientObject, error) {
+			resp, err := client.GetClientsV1WithResponse(c.Context(), environmentId, &api.GetClientsV1Params{
+				Limit:  &limit,
+				Offset: &offset,
+				Filter: api.Optional(listFlags.Filter),
+				Sort:   api.Optional(api.GetClientsV1ParamsSort(listFlags.Sort)),
+			})
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("request failed: %v", err.Error()))
+			}
+			return api.Page(resp.JSON200, resp)
+		})
 		if err != nil {
-			return errors.New(fmt.Sprintf("request failed: %v", err.Error()))
+			return err
 		}
 
-		if resp.JSON200 != nil {
-			if len(*resp.JSON200) == 0 {
-				fmt.Fprintln(c.OutOrStdout(), "no clients found")
-			} else {
-				fmt.Fprintln(c.OutOrStdout(), tables.Build(
-					*resp.JSON200,
-					tables.ColumnsByFieldNames[api.ClientObject]("Id", "Display", "CreatedAt"),
-					tables.WithTitle("Clients"),
-					tables.WithStyle(table.StyleLight),
-				))
-			}
+		if len(clients) == 0 {
+			fmt.Fprintln(c.OutOrStdout(), "no clients found")
 		} else {
-			return errors.New(api.GetAPIError(resp))
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				clients,
+				tables.ColumnsByFieldNames[api.ClientObject]("Id", "Display", "CreatedAt"),
+				tables.WithTitle("Clients"),
+				tables.WithStyle(table.StyleLight),
+			))
 		}
 
 		return nil
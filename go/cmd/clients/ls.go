@@ -9,10 +9,13 @@ package clients
 
 import (
 	"fmt"
+	"github.com/fatih/color"
 	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
 	"github.com/train360-corp/projconf/go/internal/utils/validators"
 	"github.com/train360-corp/projconf/go/pkg/api"
 	"strings"
@@ -47,7 +50,17 @@ var createClientCmd = &cobra.Command{
 		}
 
 		if resp.JSON201 != nil {
-			fmt.Println(fmt.Sprintf("\"%v\"", resp.JSON201.Id))
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.CreateClientResponse{*resp.JSON201},
+				[]tables.Column[api.CreateClientResponse]{
+					{Header: "Id", Cell: func(r api.CreateClientResponse) any { return r.Id }},
+					{Header: "SecretId", Cell: func(r api.CreateClientResponse) any { return r.Secret.Id }},
+					{Header: "Secret", Cell: func(r api.CreateClientResponse) any { return r.Secret.Key }},
+				},
+				tables.WithTitle("Client"),
+				tables.WithStyle(table.StyleLight),
+			))
+			fmt.Fprintln(c.OutOrStdout(), color.YellowString("WARN: the secret is only shown once; store it somewhere safe"))
 		} else {
 			if strings.Index(string(resp.Body), "\"ERROR: duplicate key value violates unique constraint") != -1 {
 				return fmt.Errorf("client \"%s\" already exists", args[0])
@@ -62,6 +75,7 @@ var createClientCmd = &cobra.Command{
 func init() {
 	listClientsCmd.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to list clients for")
 	listClientsCmd.MarkFlagRequired(flags.EnvironmentIdFlag)
+	flags.SetupListFlags(listClientsCmd, &listFlags, "display", "display", "created_at")
 	flags.SetupAuthFlags(listClientsCmd, authFlags)
 	err := viper.BindPFlags(listClientsCmd.Flags())
 	if err != nil {
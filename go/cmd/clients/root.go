@@ -18,6 +18,7 @@ var (
 	authFlags        *flags.AuthFlags = flags.GetAuthFlags()
 	environmentIdStr string
 	environmentId    uuid.UUID
+	listFlags        flags.ListFlags
 )
 
 var Command = &cobra.Command{
@@ -37,4 +38,6 @@ var Command = &cobra.Command{
 func init() {
 	Command.AddCommand(listClientsCmd)
 	Command.AddCommand(createClientCmd)
+	Command.AddCommand(whoamiCmd)
+	Command.AddCommand(secretsCmd)
 }
@@ -0,0 +1,104 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"time"
+)
+
+var (
+	createClientSecretExpiresIn time.Duration
+	createClientSecretExpiresAt string
+	createClientSecretExpiry    *time.Time
+)
+
+var createClientSecretCmd = &cobra.Command{
+	Use:           "create",
+	Aliases:       []string{"new"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Create an additional secret for a client (existing secrets keep working)",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := parseClientId(); err != nil {
+			return err
+		}
+
+		createClientSecretExpiry = nil
+		if createClientSecretExpiresAt != "" {
+			ts, err := time.Parse(time.RFC3339, createClientSecretExpiresAt)
+			if err != nil {
+				return fmt.Errorf("\"%v\" is not a valid RFC 3339 timestamp (%v)", createClientSecretExpiresAt, err)
+			}
+			createClientSecretExpiry = &ts
+		} else if createClientSecretExpiresIn != 0 {
+			if createClientSecretExpiresIn < 0 {
+				return fmt.Errorf("\"%v\" is not a valid duration (must be positive)", createClientSecretExpiresIn)
+			}
+			ts := time.Now().Add(createClientSecretExpiresIn)
+			createClientSecretExpiry = &ts
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.CreateClientSecretV1WithResponse(c.Context(), clientId, api.CreateClientSecretV1JSONRequestBody{
+			ExpiresAt: createClientSecretExpiry,
+		})
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON201 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.CreateClientSecretResponse{*resp.JSON201},
+				[]tables.Column[api.CreateClientSecretResponse]{
+					{Header: "SecretId", Cell: func(r api.CreateClientSecretResponse) any { return r.Id }},
+					{Header: "Secret", Cell: func(r api.CreateClientSecretResponse) any { return r.Key }},
+					{Header: "ExpiresAt", Cell: func(r api.CreateClientSecretResponse) any {
+						if r.ExpiresAt == nil {
+							return "never"
+						}
+						return r.ExpiresAt.Format(time.RFC3339)
+					}},
+				},
+				tables.WithTitle("Client Secret"),
+				tables.WithStyle(table.StyleLight),
+			))
+			fmt.Fprintln(c.OutOrStdout(), color.YellowString("WARN: the secret is only shown once; store it somewhere safe"))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	createClientSecretCmd.Flags().DurationVar(&createClientSecretExpiresIn, "expires-in", 0, "expire the secret after a duration (e.g. 720h)")
+	createClientSecretCmd.Flags().StringVar(&createClientSecretExpiresAt, "expires-at", "", "expire the secret at a RFC 3339 timestamp")
+	createClientSecretCmd.MarkFlagsMutuallyExclusive("expires-in", "expires-at")
+
+	createClientSecretCmd.Flags().StringVar(&clientIdStr, flags.ClientIdFlag, "", "the id of the client to create a secret for")
+	createClientSecretCmd.MarkFlagRequired(flags.ClientIdFlag)
+	flags.SetupAuthFlags(createClientSecretCmd, authFlags)
+	err := viper.BindPFlags(createClientSecretCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var listClientSecretsCmd = &cobra.Command{
+	Use:           "list",
+	Aliases:       []string{"ls"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "List the secrets of a client (keys are never shown)",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return parseClientId()
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.GetClientSecretsListV1WithResponse(c.Context(), clientId)
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			if len(*resp.JSON200) == 0 {
+				fmt.Fprintln(c.OutOrStdout(), "no secrets found")
+			} else {
+				fmt.Fprintln(c.OutOrStdout(), tables.Build(
+					*resp.JSON200,
+					[]tables.Column[api.ClientSecretObject]{
+						{Header: "Id", Cell: func(r api.ClientSecretObject) any { return r.Id }},
+						{Header: "CreatedAt", Cell: func(r api.ClientSecretObject) any { return r.CreatedAt }},
+						{Header: "ExpiresAt", Cell: func(r api.ClientSecretObject) any { return orNever(r.ExpiresAt) }},
+						{Header: "RevokedAt", Cell: func(r api.ClientSecretObject) any { return orNever(r.RevokedAt) }},
+					},
+					tables.WithTitle("Client Secrets"),
+					tables.WithStyle(table.StyleLight),
+				))
+			}
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	listClientSecretsCmd.Flags().StringVar(&clientIdStr, flags.ClientIdFlag, "", "the id of the client to list secrets for")
+	listClientSecretsCmd.MarkFlagRequired(flags.ClientIdFlag)
+	flags.SetupAuthFlags(listClientSecretsCmd, authFlags)
+	err := viper.BindPFlags(listClientSecretsCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
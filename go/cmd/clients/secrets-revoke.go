@@ -0,0 +1,75 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var revokeClientSecretId uuid.UUID
+
+var revokeClientSecretCmd = &cobra.Command{
+	Use:           "revoke",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Revoke a secret of a client (it can no longer be used to authenticate)",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := parseClientId(); err != nil {
+			return err
+		}
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("\"%v\" is not a valid secret id (%v)", args[0], err)
+		}
+		revokeClientSecretId = id
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.RevokeClientSecretV1WithResponse(c.Context(), clientId, revokeClientSecretId)
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.ClientSecretObject{*resp.JSON200},
+				[]tables.Column[api.ClientSecretObject]{
+					{Header: "Id", Cell: func(r api.ClientSecretObject) any { return r.Id }},
+					{Header: "RevokedAt", Cell: func(r api.ClientSecretObject) any { return orNever(r.RevokedAt) }},
+				},
+				tables.WithTitle("Client Secret"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	revokeClientSecretCmd.Flags().StringVar(&clientIdStr, flags.ClientIdFlag, "", "the id of the client the secret belongs to")
+	revokeClientSecretCmd.MarkFlagRequired(flags.ClientIdFlag)
+	flags.SetupAuthFlags(revokeClientSecretCmd, authFlags)
+	err := viper.BindPFlags(revokeClientSecretCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package clients
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var (
+	clientIdStr string
+	clientId    uuid.UUID
+)
+
+var secretsCmd = &cobra.Command{
+	Use:           "secrets",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Manage the secrets (credentials) of a client",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	secretsCmd.AddCommand(listClientSecretsCmd)
+	secretsCmd.AddCommand(createClientSecretCmd)
+	secretsCmd.AddCommand(revokeClientSecretCmd)
+}
+
+// parseClientId parses the --client-id flag into clientId
+func parseClientId() error {
+	id, err := uuid.Parse(clientIdStr)
+	if err != nil {
+		return fmt.Errorf("\"%v\" is not a valid client id (%v)", clientIdStr, err)
+	}
+	clientId = id
+	return nil
+}
+
+// orNever formats an optional timestamp
+func orNever(ts *string) string {
+	if ts == nil {
+		return "never"
+	}
+	return *ts
+}
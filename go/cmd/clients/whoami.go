@@ -0,0 +1,68 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var whoamiCmd = &cobra.Command{
+	Use:           "whoami",
+	Aliases:       []string{"self"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Show the client (and its environment and project) the current credentials belong to",
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.GetV1ClientsSelfWithResponse(c.Context())
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.ClientSelfObject{*resp.JSON200},
+				[]tables.Column[api.ClientSelfObject]{
+					{Header: "Id", Cell: func(r api.ClientSelfObject) any { return r.Id }},
+					{Header: "Display", Cell: func(r api.ClientSelfObject) any { return r.Display }},
+					{Header: "Environment", Cell: func(r api.ClientSelfObject) any {
+						return fmt.Sprintf("%s (%s)", r.Environment.Display, r.Environment.Id)
+					}},
+					{Header: "Project", Cell: func(r api.ClientSelfObject) any {
+						return fmt.Sprintf("%s (%s)", r.Project.Display, r.Project.Id)
+					}},
+				},
+				tables.WithTitle("Client"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	flags.SetupUrlFlag(whoamiCmd, &authFlags.Url)
+	flags.SetupClientSecretFlags(whoamiCmd, &authFlags.ClientSecretId, &authFlags.ClientSecret)
+	whoamiCmd.MarkFlagsRequiredTogether(flags.ClientSecretIdFlag, flags.ClientSecretFlag)
+	err := viper.BindPFlags(whoamiCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -19,6 +19,11 @@ import (
 	"strings"
 )
 
+var (
+	createEnvironmentParentIdStr string
+	createEnvironmentParentId    *uuid.UUID
+)
+
 var createEnvironmentCmd = &cobra.Command{
 	Use:           "create",
 	Aliases:       []string{"new"},
@@ -34,6 +39,14 @@ var createEnvironmentCmd = &cobra.Command{
 		}
 		projectId = id
 
+		if createEnvironmentParentIdStr != "" {
+			parentId, err := uuid.Parse(createEnvironmentParentIdStr)
+			if err != nil {
+				return fmt.Errorf("\"%v\" is not a valid environment id (%v)", createEnvironmentParentIdStr, err)
+			}
+			createEnvironmentParentId = &parentId
+		}
+
 		if !validators.IsValidDisplay(args[0]) {
 			return fmt.Errorf("\"%v\" is not a valid display name", args[0])
 		}
@@ -43,7 +56,8 @@ var createEnvironmentCmd = &cobra.Command{
 
 		client, _ := api2.FromFlags(authFlags)
 		resp, err := client.CreateEnvironmentV1WithResponse(c.Context(), projectId, api2.CreateEnvironmentV1JSONRequestBody{
-			Name: args[0],
+			Name:     args[0],
+			ParentId: createEnvironmentParentId,
 		})
 		if err != nil {
 			return fmt.Errorf("request failed: %v", err.Error())
@@ -65,6 +79,7 @@ var createEnvironmentCmd = &cobra.Command{
 func init() {
 	createEnvironmentCmd.Flags().StringVar(&projectIdStr, flags.ProjectIdFlag, "", "the id of the project to list environments for")
 	createEnvironmentCmd.MarkFlagRequired(flags.ProjectIdFlag)
+	createEnvironmentCmd.Flags().StringVar(&createEnvironmentParentIdStr, "parent-id", "", "the id of an environment (of the same project) to inherit unset values from")
 	flags.SetupAuthFlags(createEnvironmentCmd, authFlags)
 	err := viper.BindPFlags(createEnvironmentCmd.Flags())
 	if err != nil {
@@ -36,24 +36,31 @@ var listEnvironmentsCmd = &cobra.Command{
 	},
 	RunE: func(c *cobra.Command, args []string) error {
 		client, _ := api.FromFlags(authFlags)
-		resp, err := client.GetEnvironmentsV1WithResponse(c.Context(), projectId)
+		environments, err := api.Paginate(func(limit api.Limit, offset api.Offset) ([]api.EnvironmentObject, error) {
+			resp, err := client.GetEnvironmentsV1WithResponse(c.Context(), projectId, &api.GetEnvironmentsV1Params{
+				Limit:  &limit,
+				Offset: &offset,
+				Filter: api.Optional(listFlags.Filter),
+				Sort:   api.Optional(api.GetEnvironmentsV1ParamsSort(listFlags.Sort)),
+			})
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("request failed: %v", err.Error()))
+			}
+			return api.Page(resp.JSON200, resp)
+		})
 		if err != nil {
-			return errors.New(fmt.Sprintf("request failed: %v", err.Error()))
+			return err
 		}
 
-		if resp.JSON200 != nil {
-			if len(*resp.JSON200) == 0 {
-				fmt.Fprintln(c.OutOrStdout(), "no environments found")
-			} else {
-				fmt.Fprintln(c.OutOrStdout(), tables.Build(
-					*resp.JSON200,
-					tables.ColumnsByFieldNames[api.EnvironmentObject]("Id", "Display"),
-					tables.WithTitle("Environments"),
-					tables.WithStyle(table.StyleLight),
-				))
-			}
+		if len(environments) == 0 {
+			fmt.Fprintln(c.OutOrStdout(), "no environments found")
 		} else {
-			return errors.New(api.GetAPIError(resp))
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				environments,
+				tables.ColumnsByFieldNames[api.EnvironmentObject]("Id", "Display", "ParentId"),
+				tables.WithTitle("Environments"),
+				tables.WithStyle(table.StyleLight),
+			))
 		}
 
 		return nil
@@ -63,6 +70,7 @@ var listEnvironmentsCmd = &cobra.Command{
 func init() {
 	listEnvironmentsCmd.Flags().StringVar(&projectIdStr, flags.ProjectIdFlag, "", "the id of the project to list environments for")
 	listEnvironmentsCmd.MarkFlagRequired(flags.ProjectIdFlag)
+	flags.SetupListFlags(listEnvironmentsCmd, &listFlags, "display", "display", "created_at")
 	flags.SetupAuthFlags(listEnvironmentsCmd, authFlags)
 	err := viper.BindPFlags(listEnvironmentsCmd.Flags())
 	if err != nil {
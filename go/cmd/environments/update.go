@@ -0,0 +1,110 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package environments
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	api2 "github.com/train360-corp/projconf/go/pkg/api"
+	"strings"
+)
+
+var (
+	updateEnvironmentId          uuid.UUID
+	updateEnvironmentName        string
+	updateEnvironmentParentIdStr string
+	updateEnvironmentNoParent    bool
+)
+
+var updateEnvironmentCmd = &cobra.Command{
+	Use:           "update",
+	Aliases:       []string{"edit"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Update an environment in a ProjConf server instance",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("\"%v\" is not a valid environment id (%v)", args[0], err)
+		}
+		updateEnvironmentId = id
+
+		if cmd.Flags().Changed("name") && !validators.IsValidDisplay(updateEnvironmentName) {
+			return fmt.Errorf("\"%v\" is not a valid display name", updateEnvironmentName)
+		}
+		if cmd.Flags().Changed("parent-id") {
+			if _, err := uuid.Parse(updateEnvironmentParentIdStr); err != nil {
+				return fmt.Errorf("\"%v\" is not a valid environment id (%v)", updateEnvironmentParentIdStr, err)
+			}
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		// only the fields being changed are sent (a null parent_id removes the parent)
+		body := map[string]interface{}{}
+		if c.Flags().Changed("name") {
+			body["name"] = updateEnvironmentName
+		}
+		if c.Flags().Changed("parent-id") {
+			body["parent_id"] = updateEnvironmentParentIdStr
+		} else if updateEnvironmentNoParent {
+			body["parent_id"] = nil
+		}
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		client, _ := api2.FromFlags(authFlags)
+		resp, err := client.UpdateEnvironmentV1WithBodyWithResponse(c.Context(), updateEnvironmentId, "application/json", bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api2.EnvironmentObject{*resp.JSON200},
+				tables.ColumnsByFieldNames[api2.EnvironmentObject]("Id", "Display", "ParentId"),
+				tables.WithTitle("Environment"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			if strings.Index(string(resp.Body), "\"duplicate\"") != -1 {
+				return fmt.Errorf("environment \"%s\" already exists", updateEnvironmentName)
+			}
+			return errors.New(api2.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	updateEnvironmentCmd.Flags().StringVar(&updateEnvironmentName, "name", "", "the new name of the environment")
+	updateEnvironmentCmd.Flags().StringVar(&updateEnvironmentParentIdStr, "parent-id", "", "the id of an environment (of the same project) to inherit unset values from")
+	updateEnvironmentCmd.Flags().BoolVar(&updateEnvironmentNoParent, "no-parent", false, "stop inheriting (inherited values are copied into the environment)")
+	updateEnvironmentCmd.MarkFlagsOneRequired("name", "parent-id", "no-parent")
+	updateEnvironmentCmd.MarkFlagsMutuallyExclusive("parent-id", "no-parent")
+	flags.SetupAuthFlags(updateEnvironmentCmd, authFlags)
+	err := viper.BindPFlags(updateEnvironmentCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
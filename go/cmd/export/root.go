@@ -0,0 +1,100 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package export
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/envfile"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/server"
+	"net/http"
+	"os"
+	"slices"
+)
+
+var (
+	authFlags        *flags.AuthFlags = flags.GetAuthFlags()
+	environmentIdStr string
+	environmentId    uuid.UUID
+
+	exportFormat string
+	exportName   string
+	exportTags   []string
+	exportOutput string
+)
+
+var Command = &cobra.Command{
+	Use:           "export",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Export the values of an environment as a dotenv, JSON, YAML, shell, docker env or Kubernetes manifest file",
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return server.IsReady(authFlags.Url)
+	},
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(environmentIdStr)
+		if err != nil {
+			return fmt.Errorf("\"%v\" is not a valid environment id (%v)", environmentIdStr, err)
+		}
+		environmentId = id
+
+		if !slices.Contains(envfile.ExportFormats, envfile.Format(exportFormat)) {
+			return fmt.Errorf("\"%s\" is not a valid format (must be one of %v)", exportFormat, envfile.ExportFormats)
+		}
+
+		tags, err := validators.NormalizeTags(exportTags)
+		if err != nil {
+			return err
+		}
+		exportTags = tags
+
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.ExportEnvironmentV1WithResponse(c.Context(), environmentId, &api.ExportEnvironmentV1Params{
+			Format: api.ExportFormat(exportFormat),
+			Name:   api.Optional(exportName),
+			Tag:    api.OptionalSlice(exportTags),
+		})
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		} else if resp.StatusCode() != http.StatusOK {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		if exportOutput == "" || exportOutput == "-" {
+			_, err = c.OutOrStdout().Write(resp.Body)
+			return err
+		}
+		// the file may hold sensitive values, so only the owner can read it
+		if err := os.WriteFile(exportOutput, resp.Body, 0600); err != nil {
+			return fmt.Errorf("unable to write \"%s\": %v", exportOutput, err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	Command.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to export")
+	Command.MarkFlagRequired(flags.EnvironmentIdFlag)
+	Command.Flags().StringVar(&exportFormat, "format", string(envfile.Dotenv), "the format to export: dotenv, json, yaml, shell, docker, k8s-secret or k8s-configmap (which cannot hold sensitive values)")
+	Command.Flags().StringVar(&exportName, "name", "", "the name of a Kubernetes manifest (by default, derived from the project and environment)")
+	Command.Flags().StringSliceVar(&exportTags, "tag", []string{}, "only export the values of variables with this tag (repeatable; every tag must match)")
+	Command.Flags().StringVarP(&exportOutput, "output", "o", "", "the file to write (stdout, if omitted)")
+	flags.SetupAuthFlags(Command, authFlags)
+	viper.BindPFlags(Command.Flags())
+}
@@ -0,0 +1,154 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package imports
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/internal/utils/envfile"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/server"
+	"io"
+	"os"
+	"slices"
+)
+
+var (
+	authFlags        *flags.AuthFlags = flags.GetAuthFlags()
+	projectIdStr     string
+	projectId        uuid.UUID
+	environmentIdStr string
+	environmentId    uuid.UUID
+
+	importFile     string
+	importFormat   string
+	importConflict string
+	importDryRun   bool
+	importPlain    bool
+)
+
+// maskedValue is shown in place of the values of sensitive variables
+const maskedValue = "********"
+
+var Command = &cobra.Command{
+	Use:           "import",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Import variables and their values in an environment from a dotenv, JSON or YAML file, or a Kubernetes Secret or ConfigMap",
+	Long: `Import variables and their values in an environment from a dotenv, JSON or YAML file, or a Kubernetes Secret or ConfigMap.
+
+Nested JSON and YAML is flattened to SCREAMING_SNAKE keys (e.g. {"db": {"host": "..."}} is DB_HOST).
+Each key becomes a STATIC variable of the project, with its value set in the environment only.
+The import is all or nothing: if any key fails, nothing is imported.`,
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return server.IsReady(authFlags.Url)
+	},
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if id, err := uuid.Parse(projectIdStr); err != nil {
+			return fmt.Errorf("\"%v\" is not a valid project id (%v)", projectIdStr, err)
+		} else {
+			projectId = id
+		}
+		if id, err := uuid.Parse(environmentIdStr); err != nil {
+			return fmt.Errorf("\"%v\" is not a valid environment id (%v)", environmentIdStr, err)
+		} else {
+			environmentId = id
+		}
+		if !slices.Contains(envfile.Formats, envfile.Format(importFormat)) {
+			return fmt.Errorf("\"%s\" is not a valid format (must be one of %v)", importFormat, envfile.Formats)
+		}
+		if !slices.Contains([]api.ImportConflictPolicy{api.ImportConflictPolicySkip, api.ImportConflictPolicyOverwrite, api.ImportConflictPolicyFail}, api.ImportConflictPolicy(importConflict)) {
+			return fmt.Errorf("\"%s\" is not a valid conflict policy (must be skip, overwrite or fail)", importConflict)
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		var data []byte
+		var err error
+		if importFile == "-" {
+			data, err = io.ReadAll(c.InOrStdin())
+		} else {
+			data, err = os.ReadFile(importFile)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read \"%s\": %v", importFile, err)
+		}
+
+		values, err := envfile.Parse(importFile, data, envfile.Format(importFormat))
+		if err != nil {
+			return fmt.Errorf("unable to parse \"%s\": %v", importFile, err)
+		} else if len(values) == 0 {
+			return fmt.Errorf("no variables found in \"%s\"", importFile)
+		}
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.ImportEnvironmentV1WithResponse(c.Context(), environmentId, api.ImportEnvironmentV1JSONRequestBody{
+			ProjectId: projectId,
+			Variables: values,
+			Conflict:  api.Optional(api.ImportConflictPolicy(importConflict)),
+			Sensitive: utils.Ptr(!importPlain),
+			DryRun:    api.Optional(importDryRun),
+		})
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		} else if resp.JSON200 == nil {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		title := "Imported"
+		columns := []tables.Column[api.ImportResultObject]{
+			{Header: "Key", Cell: func(r api.ImportResultObject) any { return r.Key }},
+			{Header: "Action", Cell: func(r api.ImportResultObject) any { return r.Action }},
+			{Header: "Value", Cell: func(r api.ImportResultObject) any {
+				// only new variables are plain (existing ones keep their sensitivity)
+				if importPlain && r.Action == api.ImportResultObjectActionCreate {
+					return values[r.Key]
+				}
+				return maskedValue
+			}},
+		}
+		if importDryRun {
+			title = "Import (dry run; nothing was imported)"
+			columns = append(columns, tables.Column[api.ImportResultObject]{
+				Header: "Violation", Cell: func(r api.ImportResultObject) any { return utils.Deref(r.Violation, "") },
+			})
+		}
+		fmt.Fprintln(c.OutOrStdout(), tables.Build(
+			*resp.JSON200,
+			columns,
+			tables.WithTitle(title),
+			tables.WithStyle(table.StyleLight),
+		))
+
+		return nil
+	},
+}
+
+func init() {
+	Command.Flags().StringVar(&projectIdStr, flags.ProjectIdFlag, "", "the id of the project to create the variables in")
+	Command.MarkFlagRequired(flags.ProjectIdFlag)
+	Command.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to set the values in")
+	Command.MarkFlagRequired(flags.EnvironmentIdFlag)
+	Command.Flags().StringVarP(&importFile, "file", "f", "", "the file to import (- for stdin)")
+	Command.MarkFlagRequired("file")
+	Command.Flags().StringVar(&importFormat, "format", string(envfile.Auto), "the format of the file: auto, dotenv, json, yaml, k8s-secret or k8s-configmap (auto detects json and yaml by extension, and manifests by kind)")
+	Command.Flags().StringVar(&importConflict, "conflict", string(api.ImportConflictPolicyFail), "what to do with keys that are already variables of the project: skip, overwrite or fail")
+	Command.Flags().BoolVar(&importDryRun, "dry-run", false, "only show what would be imported")
+	Command.Flags().BoolVar(&importPlain, "plain", false, "the new variables are plain configuration, not sensitive (their values are shown unmasked)")
+	flags.SetupAuthFlags(Command, authFlags)
+	viper.BindPFlags(Command.Flags())
+}
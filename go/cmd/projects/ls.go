@@ -27,24 +27,31 @@ var listProjectsCmd = &cobra.Command{
 	Short:         "List projects in a ProjConf server instance",
 	RunE: func(c *cobra.Command, args []string) error {
 		client, _ := api.FromFlags(authFlags)
-		resp, err := client.GetProjectsV1WithResponse(c.Context())
+		projects, err := api.Paginate(func(limit api.Limit, offset api.Offset) ([]api.ProjectObject, error) {
+			resp, err := client.GetProjectsV1WithResponse(c.Context(), &api.GetProjectsV1Params{
+				Limit:  &limit,
+				Offset: &offset,
+				Filter: api.Optional(listFlags.Filter),
+				Sort:   api.Optional(api.GetProjectsV1ParamsSort(listFlags.Sort)),
+			})
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("request failed: %v", err.Error()))
+			}
+			return api.Page(resp.JSON200, resp)
+		})
 		if err != nil {
-			return errors.New(fmt.Sprintf("request failed: %v", err.Error()))
+			return err
 		}
 
-		if resp.JSON200 != nil {
-			if len(*resp.JSON200) == 0 {
-				fmt.Fprintln(c.OutOrStdout(), "no projects found")
-			} else {
-				fmt.Fprintln(c.OutOrStdout(), tables.Build(
-					*resp.JSON200,
-					tables.ColumnsByFieldNames[api.ProjectObject]("Id", "Display"),
-					tables.WithTitle("Projects"),
-					tables.WithStyle(table.StyleLight),
-				))
-			}
+		if len(projects) == 0 {
+			fmt.Fprintln(c.OutOrStdout(), "no projects found")
 		} else {
-			return errors.New(api.GetAPIError(resp))
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				projects,
+				tables.ColumnsByFieldNames[api.ProjectObject]("Id", "Display"),
+				tables.WithTitle("Projects"),
+				tables.WithStyle(table.StyleLight),
+			))
 		}
 
 		return nil
@@ -52,6 +59,7 @@ var listProjectsCmd = &cobra.Command{
 }
 
 func init() {
+	flags.SetupListFlags(listProjectsCmd, &listFlags, "display", "display")
 	flags.SetupAuthFlags(listProjectsCmd, authFlags)
 	err := viper.BindPFlags(listProjectsCmd.Flags())
 	if err != nil {
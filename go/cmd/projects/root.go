@@ -15,6 +15,7 @@ import (
 
 var (
 	authFlags *flags.AuthFlags = flags.GetAuthFlags()
+	listFlags flags.ListFlags
 )
 
 var Command = &cobra.Command{
@@ -34,4 +35,5 @@ var Command = &cobra.Command{
 func init() {
 	Command.AddCommand(listProjectsCmd)
 	Command.AddCommand(createProjectCmd)
+	Command.AddCommand(updateProjectCmd)
 }
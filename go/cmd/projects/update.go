@@ -0,0 +1,85 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	api2 "github.com/train360-corp/projconf/go/pkg/api"
+	"strings"
+)
+
+var (
+	updateProjectId   uuid.UUID
+	updateProjectName string
+)
+
+var updateProjectCmd = &cobra.Command{
+	Use:           "update",
+	Aliases:       []string{"edit"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Update a project in a ProjConf server instance",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("\"%v\" is not a valid project id (%v)", args[0], err)
+		}
+		updateProjectId = id
+
+		if !validators.IsValidDisplay(updateProjectName) {
+			return fmt.Errorf("\"%v\" is not a valid display name", updateProjectName)
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api2.FromFlags(authFlags)
+		resp, err := client.UpdateProjectV1WithResponse(c.Context(), updateProjectId, api2.UpdateProjectV1JSONRequestBody{
+			Name: &updateProjectName,
+		})
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api2.ProjectObject{*resp.JSON200},
+				tables.ColumnsByFieldNames[api2.ProjectObject]("Id", "Display"),
+				tables.WithTitle("Project"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			if strings.Index(string(resp.Body), "\"duplicate\"") != -1 {
+				return fmt.Errorf("project \"%s\" already exists", updateProjectName)
+			}
+			return errors.New(api2.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	updateProjectCmd.Flags().StringVar(&updateProjectName, "name", "", "the new name of the project")
+	updateProjectCmd.MarkFlagRequired("name")
+	flags.SetupAuthFlags(updateProjectCmd, authFlags)
+	err := viper.BindPFlags(updateProjectCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -12,15 +12,24 @@ import (
 	"fmt"
 	"github.com/fatih/color"
 	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/cmd/admin"
+	"github.com/train360-corp/projconf/go/cmd/audit"
 	"github.com/train360-corp/projconf/go/cmd/clients"
 	"github.com/train360-corp/projconf/go/cmd/environments"
+	"github.com/train360-corp/projconf/go/cmd/export"
+	"github.com/train360-corp/projconf/go/cmd/imports"
 	"github.com/train360-corp/projconf/go/cmd/projects"
+	"github.com/train360-corp/projconf/go/cmd/secrets"
 	srv "github.com/train360-corp/projconf/go/cmd/server"
+	"github.com/train360-corp/projconf/go/cmd/validate"
 	"github.com/train360-corp/projconf/go/cmd/variables"
 	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
 	"github.com/train360-corp/projconf/go/pkg"
 	"github.com/train360-corp/projconf/go/pkg/api"
 	"github.com/train360-corp/projconf/go/pkg/server"
@@ -34,6 +43,8 @@ import (
 var (
 	authFlags        *flags.AuthFlags = flags.GetAuthFlags()
 	environmentIdStr string
+	showSources      bool
+	runTags          []string
 )
 
 var preRun = func(cmd *cobra.Command, args []string) error {
@@ -88,42 +99,58 @@ var run = func(cmd *cobra.Command, args []string) error {
 
 	env := os.Environ()
 
+	tags, err := validators.NormalizeTags(runTags)
+	if err != nil {
+		return err
+	}
+
 	// server must have ready state
 	if err := server.IsReady(authFlags.Url); err != nil {
 		return err
 	}
 
+	var values []api.SecretObject
 	client, _ := api.FromFlags(authFlags)
 	if authFlags.AdminApiKey == "" {
-		if resp, err := client.GetClientSecretsV1WithResponse(cmd.Context()); err != nil {
+		if resp, err := client.GetClientSecretsV1WithResponse(cmd.Context(), &api.GetClientSecretsV1Params{Tag: api.OptionalSlice(tags)}); err != nil {
 			return fmt.Errorf("could not get client secrets: %v", err)
 		} else if resp.JSON200 == nil {
 			return fmt.Errorf("could not get client secrets: %v", api.GetAPIError(resp))
 		} else if len(*resp.JSON200) == 0 {
 			fmt.Fprintln(cmd.OutOrStdout(), color.YellowString("WARN: no secrets found"))
 		} else {
-			for _, secret := range *resp.JSON200 {
-				env = append(env, fmt.Sprintf("%s=%s", secret.Variable.Key, secret.Value))
-			}
+			values = *resp.JSON200
 		}
 	} else {
 		envId, err := uuid.Parse(environmentIdStr)
 		if err != nil {
 			return fmt.Errorf("\"%v\" is not a valid environment id (%v)", environmentIdStr, err)
 		}
-		if resp, err := client.GetEnvironmentSecretsV1WithResponse(cmd.Context(), envId); err != nil {
+		if resp, err := client.GetEnvironmentSecretsV1WithResponse(cmd.Context(), envId, &api.GetEnvironmentSecretsV1Params{Tag: api.OptionalSlice(tags)}); err != nil {
 			return fmt.Errorf("could not get client secrets: %v", err)
 		} else if resp.JSON200 == nil {
 			return fmt.Errorf("could not get client secrets: %v", api.GetAPIError(resp))
 		} else if len(*resp.JSON200) == 0 {
 			fmt.Fprintln(cmd.OutOrStdout(), color.YellowString("WARN: no secrets found"))
 		} else {
-			for _, secret := range *resp.JSON200 {
-				env = append(env, fmt.Sprintf("%s=%s", secret.Variable.Key, secret.Value))
-			}
+			values = *resp.JSON200
 		}
 	}
 
+	for _, secret := range values {
+		env = append(env, fmt.Sprintf("%s=%s", secret.Variable.Key, secret.Value))
+	}
+
+	// stderr, so the command's own output is left untouched
+	if showSources && len(values) > 0 {
+		fmt.Fprintln(cmd.ErrOrStderr(), tables.Build(values, []tables.Column[api.SecretObject]{
+			{Header: "Key", Cell: func(r api.SecretObject) any { return r.Variable.Key }},
+			{Header: "Source", Cell: func(r api.SecretObject) any {
+				return fmt.Sprintf("%s (%s)", r.Source.Display, r.Source.Id)
+			}},
+		}, tables.WithTitle("Sources"), tables.WithStyle(table.StyleLight)))
+	}
+
 	c := exec.CommandContext(cmd.Context(), args[0], args[1:]...)
 	c.Env = env
 	c.Stdin = nil
@@ -161,6 +188,8 @@ The CLI supports both hosting a cmd server instance (using the Supabase framewor
 func init() {
 	flags.SetupAuthFlags(cmd, authFlags)
 	cmd.Flags().StringVarP(&environmentIdStr, flags.EnvironmentIdFlag, "e", "", "environment to run with")
+	cmd.Flags().BoolVar(&showSources, "sources", false, "print the environment each value comes from (to stderr) before running")
+	cmd.Flags().StringSliceVar(&runTags, "tag", []string{}, "only inject the values of variables with this tag (repeatable; every tag must match)")
 	cmd.MarkFlagsRequiredTogether(flags.AdminApiKeyFlag, flags.EnvironmentIdFlag)
 	viper.BindPFlags(cmd.Flags())
 
@@ -169,6 +198,12 @@ func init() {
 	cmd.AddCommand(projects.Command)
 	cmd.AddCommand(environments.Command)
 	cmd.AddCommand(clients.Command)
+	cmd.AddCommand(secrets.Command)
+	cmd.AddCommand(admin.Command)
+	cmd.AddCommand(audit.Command)
+	cmd.AddCommand(validate.Command)
+	cmd.AddCommand(imports.Command)
+	cmd.AddCommand(export.Command)
 }
 
 func ProjConf() *cobra.Command {
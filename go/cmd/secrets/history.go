@@ -0,0 +1,86 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var historySecretCmd = &cobra.Command{
+	Use:           "history",
+	Aliases:       []string{"versions"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "List the versions of a variable's value in an environment (values are never printed)",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := parseEnvironmentId(); err != nil {
+			return err
+		} else if !validators.IsValidVariable(args[0]) {
+			return fmt.Errorf("\"%v\" is not a valid variable name", args[0])
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		variable, err := findVariable(c.Context(), client, environmentId, args[0])
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetEnvironmentSecretVersionsV1WithResponse(c.Context(), environmentId, variable.Id)
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		} else if resp.JSON200 == nil {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		fmt.Fprintln(c.OutOrStdout(), tables.Build(
+			*resp.JSON200,
+			[]tables.Column[api.SecretVersionObject]{
+				{Header: "Version", Cell: func(r api.SecretVersionObject) any {
+					if r.Current {
+						return fmt.Sprintf("%d (current)", r.Version)
+					}
+					return r.Version
+				}},
+				{Header: "CreatedAt", Cell: func(r api.SecretVersionObject) any { return r.CreatedAt }},
+				{Header: "Author", Cell: func(r api.SecretVersionObject) any { return fmt.Sprintf("%s:%s", r.ActorType, r.Actor) }},
+				{Header: "Note", Cell: func(r api.SecretVersionObject) any {
+					if r.RolledBackFrom == nil {
+						return ""
+					}
+					return fmt.Sprintf("rollback to version %d", *r.RolledBackFrom)
+				}},
+			},
+			tables.WithTitle(fmt.Sprintf("History of %s", variable.Key)),
+			tables.WithStyle(table.StyleLight),
+		))
+
+		return nil
+	},
+}
+
+func init() {
+	historySecretCmd.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to list the history in")
+	historySecretCmd.MarkFlagRequired(flags.EnvironmentIdFlag)
+	flags.SetupAuthFlags(historySecretCmd, authFlags)
+	err := viper.BindPFlags(historySecretCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var rollbackSecretVersion int
+
+var rollbackSecretCmd = &cobra.Command{
+	Use:           "rollback",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Restore a previous version of a variable's value in an environment",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := parseEnvironmentId(); err != nil {
+			return err
+		} else if !validators.IsValidVariable(args[0]) {
+			return fmt.Errorf("\"%v\" is not a valid variable name", args[0])
+		} else if rollbackSecretVersion < 1 {
+			return fmt.Errorf("\"%v\" is not a valid version (see \"projconf secrets history\")", rollbackSecretVersion)
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		variable, err := findVariable(c.Context(), client, environmentId, args[0])
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.RollbackEnvironmentSecretV1WithResponse(c.Context(), environmentId, variable.Id, api.RollbackEnvironmentSecretV1JSONRequestBody{
+			Version: rollbackSecretVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.SecretObject{*resp.JSON200},
+				secretColumns,
+				tables.WithTitle(fmt.Sprintf("Rolled Back to Version %d", rollbackSecretVersion)),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rollbackSecretCmd.Flags().IntVar(&rollbackSecretVersion, "version", 0, "the version to restore (see \"projconf secrets history\")")
+	rollbackSecretCmd.MarkFlagRequired("version")
+
+	rollbackSecretCmd.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to roll back the secret in")
+	rollbackSecretCmd.MarkFlagRequired(flags.EnvironmentIdFlag)
+	flags.SetupAuthFlags(rollbackSecretCmd, authFlags)
+	err := viper.BindPFlags(rollbackSecretCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/server"
+)
+
+var (
+	authFlags        *flags.AuthFlags = flags.GetAuthFlags()
+	environmentIdStr string
+	environmentId    uuid.UUID
+)
+
+var Command = &cobra.Command{
+	Use:           "secrets",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Manage the per-environment values of variables in a ProjConf server instance",
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return server.IsReady(authFlags.Url)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+// secretColumns are the columns used to print secrets (values are never printed)
+var secretColumns = []tables.Column[api.SecretObject]{
+	{Header: "Id", Cell: func(r api.SecretObject) any { return r.Id }},
+	{Header: "Key", Cell: func(r api.SecretObject) any { return r.Variable.Key }},
+	{Header: "Environment", Cell: func(r api.SecretObject) any {
+		return fmt.Sprintf("%s (%s)", r.Environment.Display, r.Environment.Id)
+	}},
+	{Header: "Source", Cell: func(r api.SecretObject) any {
+		if r.Source.Id == r.Environment.Id {
+			return "-"
+		}
+		return fmt.Sprintf("%s (%s)", r.Source.Display, r.Source.Id)
+	}},
+}
+
+func init() {
+	Command.AddCommand(setSecretCmd)
+	Command.AddCommand(rotateSecretCmd)
+	Command.AddCommand(historySecretCmd)
+	Command.AddCommand(rollbackSecretCmd)
+	Command.AddCommand(unsetSecretCmd)
+}
+
+// parseEnvironmentId parses the --environment-id flag into environmentId
+func parseEnvironmentId() error {
+	id, err := uuid.Parse(environmentIdStr)
+	if err != nil {
+		return fmt.Errorf("\"%v\" is not a valid environment id (%v)", environmentIdStr, err)
+	}
+	environmentId = id
+	return nil
+}
+
+// findVariable looks up the variable with the given key in the project of an
+// environment (by key, so that no values are decrypted, nor their reads audited)
+func findVariable(ctx context.Context, client *api.ClientWithResponses, environmentId uuid.UUID, key string) (*api.VariableObject, error) {
+	environment, err := client.GetEnvironmentV1WithResponse(ctx, environmentId)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %v", err.Error())
+	} else if environment.JSON200 == nil {
+		return nil, errors.New(api.GetAPIError(environment))
+	}
+
+	// the filter is a (case-insensitive) prefix, so the key still has to match exactly
+	resp, err := client.GetVariablesV1WithResponse(ctx, environment.JSON200.ProjectId, &api.GetVariablesV1Params{
+		Filter: &key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %v", err.Error())
+	} else if resp.JSON200 == nil {
+		return nil, errors.New(api.GetAPIError(resp))
+	}
+	for _, variable := range *resp.JSON200 {
+		if variable.Key == key {
+			return &variable, nil
+		}
+	}
+	return nil, fmt.Errorf("variable \"%s\" not found in environment \"%s\"", key, environmentId)
+}
@@ -0,0 +1,152 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/internal/utils/certificate"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	rotateSecretAll        bool
+	rotateSecretExpiring   time.Duration
+	rotateSecretGenerators []string
+)
+
+// rotatableGenerators are the generators --generator accepts (STATIC values are never rotated)
+var rotatableGenerators = []api.GeneratorType{
+	api.GeneratorTypeRANDOM,
+	api.GeneratorTypeUUID,
+	api.GeneratorTypeHEX,
+	api.GeneratorTypeBASE64,
+	api.GeneratorTypeKEYPAIR,
+	api.GeneratorTypeCERTIFICATE,
+}
+
+var rotateSecretCmd = &cobra.Command{
+	Use:           "rotate",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.MaximumNArgs(1),
+	Short:         "Re-generate the value of a generated (non-STATIC) variable (or, with --all, of every RANDOM one) in an environment",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := parseEnvironmentId(); err != nil {
+			return err
+		}
+		if len(args) == 0 && !rotateSecretAll && !cmd.Flags().Changed("expiring-within") {
+			return errors.New("a variable name is required (to rotate every RANDOM variable, use --all)")
+		} else if cmd.Flags().Changed("generator") && !rotateSecretAll {
+			return errors.New("--generator can only be combined with --all")
+		} else if len(args) == 1 && (rotateSecretAll || cmd.Flags().Changed("expiring-within")) {
+			return errors.New("a variable name cannot be combined with --all or --expiring-within")
+		} else if rotateSecretExpiring < 0 {
+			return fmt.Errorf("\"%v\" is not a valid duration (min: 0)", rotateSecretExpiring)
+		} else if len(args) == 1 && !validators.IsValidVariable(args[0]) {
+			return fmt.Errorf("\"%v\" is not a valid variable name", args[0])
+		}
+		for _, generator := range rotateSecretGenerators {
+			if !slices.Contains(rotatableGenerators, api.GeneratorType(strings.ToUpper(generator))) {
+				return fmt.Errorf("\"%v\" is not a rotatable generator (available: %s)", generator, strings.Join(utils.ForEach(rotatableGenerators, func(g api.GeneratorType) string { return string(g) }), " | "))
+			}
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+
+		var rotated []api.SecretObject
+		if rotateSecretAll {
+			params := &api.RotateEnvironmentSecretsV1Params{}
+			if len(rotateSecretGenerators) > 0 {
+				params.Generator = utils.Ptr(utils.ForEach(rotateSecretGenerators, func(g string) api.GeneratorType { return api.GeneratorType(strings.ToUpper(g)) }))
+			}
+			resp, err := client.RotateEnvironmentSecretsV1WithResponse(c.Context(), environmentId, params)
+			if err != nil {
+				return fmt.Errorf("request failed: %v", err.Error())
+			} else if resp.JSON200 == nil {
+				return errors.New(api.GetAPIError(resp))
+			}
+			rotated = *resp.JSON200
+		} else if c.Flags().Changed("expiring-within") {
+			resp, err := client.GetEnvironmentSecretsV1WithResponse(c.Context(), environmentId, &api.GetEnvironmentSecretsV1Params{})
+			if err != nil {
+				return fmt.Errorf("request failed: %v", err.Error())
+			} else if resp.JSON200 == nil {
+				return errors.New(api.GetAPIError(resp))
+			}
+			deadline := time.Now().Add(rotateSecretExpiring)
+			for _, secret := range *resp.JSON200 {
+				// inherited certificates are reissued in the environment they are inherited from
+				cert := certificate.Parse(secret.Value)
+				if cert == nil || cert.IsCA || cert.NotAfter.After(deadline) || secret.Source.Id != secret.Environment.Id {
+					continue
+				}
+				resp, err := client.RotateEnvironmentSecretV1WithResponse(c.Context(), environmentId, secret.Variable.Id)
+				if err != nil {
+					return fmt.Errorf("request failed: %v", err.Error())
+				} else if resp.JSON200 == nil {
+					return errors.New(api.GetAPIError(resp))
+				}
+				rotated = append(rotated, *resp.JSON200)
+			}
+		} else {
+			variable, err := findVariable(c.Context(), client, environmentId, args[0])
+			if err != nil {
+				return err
+			}
+			resp, err := client.RotateEnvironmentSecretV1WithResponse(c.Context(), environmentId, variable.Id)
+			if err != nil {
+				return fmt.Errorf("request failed: %v", err.Error())
+			} else if resp.JSON200 == nil {
+				return errors.New(api.GetAPIError(resp))
+			}
+			rotated = []api.SecretObject{*resp.JSON200}
+		}
+
+		if len(rotated) == 0 {
+			fmt.Fprintln(c.OutOrStdout(), "no generated variables to rotate")
+		} else {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				rotated,
+				secretColumns,
+				tables.WithTitle("Rotated Secrets"),
+				tables.WithStyle(table.StyleLight),
+			))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rotateSecretCmd.Flags().BoolVar(&rotateSecretAll, "all", false, "rotate every RANDOM variable in the environment (in a single transaction)")
+	rotateSecretCmd.Flags().StringSliceVar(&rotateSecretGenerators, "generator", nil, "with --all, rotate the variables with this generator instead (repeatable; e.g. --generator RANDOM --generator UUID)")
+	rotateSecretCmd.Flags().DurationVar(&rotateSecretExpiring, "expiring-within", 0, "reissue every certificate in the environment that expires within a duration (e.g. 720h)")
+	rotateSecretCmd.MarkFlagsMutuallyExclusive("all", "expiring-within")
+
+	rotateSecretCmd.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to rotate secrets in")
+	rotateSecretCmd.MarkFlagRequired(flags.EnvironmentIdFlag)
+	flags.SetupAuthFlags(rotateSecretCmd, authFlags)
+	err := viper.BindPFlags(rotateSecretCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	setSecretValue     string
+	setSecretFromFile  string
+	setSecretFromStdin bool
+)
+
+var setSecretCmd = &cobra.Command{
+	Use:           "set",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Override the value of a variable in a single environment",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := parseEnvironmentId(); err != nil {
+			return err
+		}
+		if !validators.IsValidVariable(args[0]) {
+			return fmt.Errorf("\"%v\" is not a valid variable name", args[0])
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		value := setSecretValue
+		if setSecretFromFile != "" {
+			data, err := os.ReadFile(setSecretFromFile)
+			if err != nil {
+				return fmt.Errorf("unable to read \"%s\": %v", setSecretFromFile, err)
+			}
+			value = string(data)
+		} else if setSecretFromStdin {
+			data, err := io.ReadAll(c.InOrStdin())
+			if err != nil {
+				return fmt.Errorf("unable to read stdin: %v", err)
+			}
+			value = strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+		}
+
+		client, _ := api.FromFlags(authFlags)
+		variable, err := findVariable(c.Context(), client, environmentId, args[0])
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.SetEnvironmentSecretV1WithResponse(c.Context(), environmentId, variable.Id, api.SetEnvironmentSecretV1JSONRequestBody{
+			Value: value,
+		})
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.SecretObject{*resp.JSON200},
+				secretColumns,
+				tables.WithTitle("Secret"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	setSecretCmd.Flags().StringVar(&setSecretValue, "value", "", "the new value")
+	setSecretCmd.Flags().StringVar(&setSecretFromFile, "from-file", "", "read the new value from a file")
+	setSecretCmd.Flags().BoolVar(&setSecretFromStdin, "stdin", false, "read the new value from stdin")
+	setSecretCmd.MarkFlagsOneRequired("value", "from-file", "stdin")
+	setSecretCmd.MarkFlagsMutuallyExclusive("value", "from-file", "stdin")
+
+	setSecretCmd.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to set the value in")
+	setSecretCmd.MarkFlagRequired(flags.EnvironmentIdFlag)
+	flags.SetupAuthFlags(setSecretCmd, authFlags)
+	err := viper.BindPFlags(setSecretCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
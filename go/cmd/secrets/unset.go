@@ -0,0 +1,72 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	"github.com/train360-corp/projconf/go/pkg/api"
+)
+
+var unsetSecretCmd = &cobra.Command{
+	Use:           "unset",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Remove an environment's override of a variable so it inherits the value of its parent again",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := parseEnvironmentId(); err != nil {
+			return err
+		} else if !validators.IsValidVariable(args[0]) {
+			return fmt.Errorf("\"%v\" is not a valid variable name", args[0])
+		}
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		variable, err := findVariable(c.Context(), client, environmentId, args[0])
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.UnsetEnvironmentSecretV1WithResponse(c.Context(), environmentId, variable.Id)
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api.SecretObject{*resp.JSON200},
+				secretColumns,
+				tables.WithTitle("Secret"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	unsetSecretCmd.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to unset the value in")
+	unsetSecretCmd.MarkFlagRequired(flags.EnvironmentIdFlag)
+	flags.SetupAuthFlags(unsetSecretCmd, authFlags)
+	err := viper.BindPFlags(unsetSecretCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
@@ -32,4 +32,5 @@ var Command = &cobra.Command{
 
 func init() {
 	Command.AddCommand(serveCommand)
+	Command.AddCommand(showCredentialsCommand)
 }
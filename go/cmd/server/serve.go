@@ -14,10 +14,10 @@ import (
 	"github.com/docker/docker/client"
 	"github.com/spf13/cobra"
 	"github.com/train360-corp/projconf/go/internal/flags"
-	"github.com/train360-corp/projconf/go/internal/utils/random"
 	"github.com/train360-corp/projconf/go/pkg"
 	"github.com/train360-corp/projconf/go/pkg/migrations"
 	"github.com/train360-corp/projconf/go/pkg/server"
+	"github.com/train360-corp/projconf/go/pkg/server/events"
 	"github.com/train360-corp/projconf/go/pkg/server/state"
 	"github.com/train360-corp/supago"
 	"go.uber.org/zap/zapcore"
@@ -41,13 +41,10 @@ var serveCommand = &cobra.Command{
 	Long: `Create an initialize a ProjConf server.
 
 Default values will be created and stored in a local 
-file accessible only by the current user.`,
+file accessible only by the current user.
+Use "projconf server show-credentials" to read them back.`,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 
-		if strings.TrimSpace(server.AdminApiKey) == "" {
-			server.AdminApiKey = random.String(32)
-		}
-
 		if level, err := zapcore.ParseLevel(logLevelStr); err != nil {
 			return fmt.Errorf("invalid log level (\"%s\"): %v", logLevelStr, err)
 		} else {
@@ -93,6 +90,23 @@ file accessible only by the current user.`,
 			logger.Debugf("system-wide data directory: %s", dir)
 		}
 
+		// load (or, on first run, generate) persisted settings
+		settings, err := server.EnsureSettings(dir)
+		if err != nil {
+			panic(fmt.Sprintf("unable to load settings: %v", err))
+		} else {
+			logger.Debugf("settings file: %s", server.SettingsPath(dir))
+		}
+		if strings.TrimSpace(server.AdminApiKey) == "" {
+			server.AdminApiKey = settings.AdminApiKey
+		}
+		if settings.Host != server.Host || settings.Port != server.Port {
+			settings.Host, settings.Port = server.Host, server.Port
+			if err := server.SaveSettings(dir, settings); err != nil {
+				panic(fmt.Sprintf("unable to save settings: %v", err))
+			}
+		}
+
 		// create config
 		cfg, err := supago.ConfigBuilder().
 			Platform("projconf").
@@ -113,33 +127,40 @@ file accessible only by the current user.`,
 			logger.Panicf("failed to initialize server: %v", err)
 		}
 		srv := server.Start(ctx)
-		logger.Infof("admin api key: %s", server.AdminApiKey)
+		logger.Infof("admin api key: %s", maskSecret(server.AdminApiKey))
 
 		// patch postgres after-start to handle migrations
 		postgres := supago.Services.Postgres(*cfg)
 		postgres.Cmd = append(postgres.Cmd, "-c", "projconf.x_admin_api_key="+server.AdminApiKey)
 		patchPgPass := postgres.AfterStart
-		postgres.AfterStart = func(ctx context.Context, docker *client.Client, containerID string) error {
+		postgres.AfterStart = func(startCtx context.Context, docker *client.Client, containerID string) error {
 
 			// run hard-wired after-start
-			err := patchPgPass(ctx, docker, containerID)
+			err := patchPgPass(startCtx, docker, containerID)
 			if err != nil {
 				return err
 			}
 
 			// get existing migrations
-			existingMigrations, err := migrations.LoadExistingSchemaMigrations(ctx, docker, containerID)
+			existingMigrations, err := migrations.LoadExistingSchemaMigrations(startCtx, docker, containerID)
 			if err != nil {
 				return err
 			}
 
 			// apply migrations
-			if err, applied, passed := migrations.ApplyMigrations(ctx, docker, containerID, existingMigrations); err != nil {
+			if err, applied, passed := migrations.ApplyMigrations(startCtx, docker, containerID, existingMigrations); err != nil {
 				return err
 			} else {
 				logger.Debugf("%v new migrations applied (%v already applied, %d total)", applied, passed, applied+passed)
-				return nil
 			}
+
+			// listen for secret events (for as long as the server runs); watchers fall back to polling without it
+			if connect, err := events.Connector(startCtx, docker, containerID); err != nil {
+				logger.Warnf("unable to listen for secret events: %v", err)
+			} else {
+				go events.Listen(ctx, connect)
+			}
+			return nil
 		}
 
 		version := pkg.Version
@@ -199,6 +220,14 @@ file accessible only by the current user.`,
 		state.Get().SetPostgresAlive(true)
 		state.Get().SetPostgrestAlive(true)
 
+		// keys and certificates are generated after the request that needs them; any a failed (or interrupted)
+		// request left waiting are generated now
+		go func() {
+			if err := server.GeneratePendingSecrets(ctx, cfg); err != nil {
+				logger.Warnf("unable to generate pending keys and certificates: %v", err)
+			}
+		}()
+
 		<-srv.Done() // wait for stop signal
 		logger.Warn("shutdown signal received")
 		logger.Debugf("exit cause: %v", context.Cause(srv))
@@ -216,8 +245,17 @@ func init() {
 	flags.SetupAdminApiKeyFlag(serveCommand, &server.AdminApiKey)
 	serveCommand.Flags().StringVarP(&server.Host, "host", "H", server.Host, fmt.Sprintf("host to serveCommand on (default: %s)", server.Host))
 	serveCommand.Flags().Uint16VarP(&server.Port, "port", "P", server.Port, fmt.Sprintf("port to serveCommand on (default: %d)", server.Port))
+	serveCommand.Flags().StringSliceVar(&server.TrustedProxies, "trusted-proxy", []string{}, "a proxy (ip or cidr) whose X-Forwarded-For header is trusted for the audited source ip (repeatable; default: none)")
 
 	// logging flags
 	serveCommand.Flags().StringVarP(&logLevelStr, "log-level", "l", logLevelStr, "log level (default: warn; available: debug | info | warn | error | panic | fatal)")
 	serveCommand.Flags().BoolVar(&logJsonFmt, "log-json", logJsonFmt, "log json-formatted output (default: false/human-readable)")
 }
+
+// maskSecret hides all but the last 4 characters of a secret so it can be logged
+func maskSecret(secret string) string {
+	if len(secret) <= 4 {
+		return strings.Repeat("*", len(secret))
+	}
+	return strings.Repeat("*", len(secret)-4) + secret[len(secret)-4:]
+}
@@ -0,0 +1,55 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/server"
+	"os"
+	"time"
+)
+
+var showCredentialsCommand = &cobra.Command{
+	Use:           "show-credentials",
+	Aliases:       []string{"credentials", "creds"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Show the credentials of the ProjConf server instance hosted on this machine",
+	RunE: func(c *cobra.Command, args []string) error {
+
+		dir, err := server.EnsureSystemProjConfDir()
+		if err != nil {
+			return fmt.Errorf("unable to get system-wide data directory: %v", err)
+		}
+
+		settings, err := server.LoadSettings(dir)
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no credentials found in %s (has \"projconf server serve\" been run on this machine?)", dir)
+		} else if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(c.OutOrStdout(), tables.Build(
+			[]server.Settings{*settings},
+			[]tables.Column[server.Settings]{
+				{Header: "Url", Cell: func(s server.Settings) any { return s.Url() }},
+				{Header: "AdminApiKey", Cell: func(s server.Settings) any { return s.AdminApiKey }},
+				{Header: "CreatedAt", Cell: func(s server.Settings) any { return s.CreatedAt.Format(time.RFC3339) }},
+			},
+			tables.WithTitle("Server Credentials"),
+			tables.WithStyle(table.StyleLight),
+		))
+
+		return nil
+	},
+}
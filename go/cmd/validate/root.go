@@ -0,0 +1,83 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/server"
+)
+
+var (
+	authFlags        *flags.AuthFlags = flags.GetAuthFlags()
+	environmentIdStr string
+	environmentId    uuid.UUID
+)
+
+var Command = &cobra.Command{
+	Use:           "validate",
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.NoArgs,
+	Short:         "Check every value of an environment against the type and constraints of its variable",
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return server.IsReady(authFlags.Url)
+	},
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(environmentIdStr)
+		if err != nil {
+			return fmt.Errorf("\"%v\" is not a valid environment id (%v)", environmentIdStr, err)
+		}
+		environmentId = id
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api.FromFlags(authFlags)
+		resp, err := client.ValidateEnvironmentV1WithResponse(c.Context(), environmentId)
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 == nil {
+			return errors.New(api.GetAPIError(resp))
+		}
+
+		if resp.JSON200.Valid {
+			fmt.Fprintln(c.OutOrStdout(), "all values are valid")
+			return nil
+		}
+
+		fmt.Fprintln(c.OutOrStdout(), tables.Build(
+			resp.JSON200.Violations,
+			[]tables.Column[api.ViolationObject]{
+				{Header: "Key", Cell: func(r api.ViolationObject) any { return r.VariableKey }},
+				{Header: "Type", Cell: func(r api.ViolationObject) any { return r.Type }},
+				{Header: "Violation", Cell: func(r api.ViolationObject) any { return r.Message }},
+			},
+			tables.WithTitle("Violations"),
+			tables.WithStyle(table.StyleLight),
+		))
+
+		return fmt.Errorf("%d value(s) are invalid", len(resp.JSON200.Violations))
+	},
+}
+
+func init() {
+	Command.Flags().StringVar(&environmentIdStr, flags.EnvironmentIdFlag, "", "the id of the environment to validate")
+	Command.MarkFlagRequired(flags.EnvironmentIdFlag)
+	flags.SetupAuthFlags(Command, authFlags)
+	viper.BindPFlags(Command.Flags())
+}
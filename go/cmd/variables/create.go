@@ -14,6 +14,9 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/internal/utils/certificate"
+	"github.com/train360-corp/projconf/go/internal/utils/keypair"
 	"github.com/train360-corp/projconf/go/internal/utils/validators"
 	api2 "github.com/train360-corp/projconf/go/pkg/api"
 	"strings"
@@ -24,11 +27,45 @@ var (
 	createVariableStaticValue      string
 	createVariableStaticValueEmpty bool
 
-	createVariableTypeRandom            bool // random generator
-	createVariableRandomValueLength     int
-	createVariableRandomValueUseNumbers bool
-	createVariableRandomValueUseLetters bool
-	createVariableRandomValueUseSymbols bool
+	createVariableTypeRandom             bool // random generator
+	createVariableRandomValueLength      int
+	createVariableRandomValueUseNumbers  bool
+	createVariableRandomValueUseLetters  bool
+	createVariableRandomValueUseSymbols  bool
+	createVariableRandomAlphabet         string
+	createVariableRandomExclude          string
+	createVariableRandomExcludeAmbiguous bool
+	createVariableRandomMinLowercase     int
+	createVariableRandomMinUppercase     int
+	createVariableRandomMinNumbers       int
+	createVariableRandomMinSymbols       int
+
+	createVariableTypeUuid    bool // uuid generator
+	createVariableUuidVersion int
+
+	createVariableTypeHex    bool // random bytes generators
+	createVariableTypeBase64 bool
+	createVariableBytes      int
+	createVariableBase64Url  bool
+
+	createVariableTypeKeypair      bool // keypair generator
+	createVariableKeypairAlg       string
+	createVariableKeypairBits      int
+	createVariableKeypairFormat    string
+	createVariableKeypairPublicKey string
+
+	createVariableTypeCertificate       bool // certificate generator
+	createVariableCertificateCommonName string
+	createVariableCertificateSans       []string
+	createVariableCertificateDays       int
+	createVariableCertificatePrivateKey string
+	createVariableCertificateCaBundle   string
+
+	createVariableTypeFlags typeFlags // value type and constraints
+
+	createVariableTags  []string // metadata
+	createVariableOwner string
+	createVariablePlain bool
 )
 
 var createVariableCmd = &cobra.Command{
@@ -54,8 +91,49 @@ var createVariableCmd = &cobra.Command{
 			if createVariableRandomValueLength < 1 {
 				return fmt.Errorf("\"%v\" is not a valid variable length (min: 1)", createVariableTypeRandom)
 			}
-			if !createVariableRandomValueUseNumbers && !createVariableRandomValueUseLetters && !createVariableRandomValueUseSymbols {
-				return fmt.Errorf("at least one of --numbers, --letters, or --symbols must be provided")
+			if !createVariableRandomValueUseNumbers && !createVariableRandomValueUseLetters && !createVariableRandomValueUseSymbols && createVariableRandomAlphabet == "" {
+				return fmt.Errorf("at least one of --numbers, --letters, --symbols, or --alphabet must be provided")
+			}
+			minimums := 0
+			for _, n := range []int{createVariableRandomMinLowercase, createVariableRandomMinUppercase, createVariableRandomMinNumbers, createVariableRandomMinSymbols} {
+				if n < 0 {
+					return fmt.Errorf("\"%v\" is not a valid minimum (min: 0)", n)
+				}
+				minimums += n
+			}
+			if minimums > createVariableRandomValueLength {
+				return fmt.Errorf("the minimums (%d) do not fit in the length (%d)", minimums, createVariableRandomValueLength)
+			}
+		}
+
+		if createVariableTypeUuid && createVariableUuidVersion != 4 && createVariableUuidVersion != 7 {
+			return fmt.Errorf("\"%v\" is not a valid uuid version (4 or 7)", createVariableUuidVersion)
+		}
+
+		if (createVariableTypeHex || createVariableTypeBase64) && (createVariableBytes < 1 || createVariableBytes > 1024) {
+			return fmt.Errorf("\"%v\" is not a valid number of bytes (min: 1, max: 1024)", createVariableBytes)
+		}
+
+		if createVariableTypeKeypair {
+			if !cmd.Flags().Changed("bits") {
+				createVariableKeypairBits = keypair.DefaultBits[createVariableKeypairAlg]
+			}
+			if err := keypair.Validate(createVariableKeypairAlg, createVariableKeypairBits, createVariableKeypairFormat); err != nil {
+				return err
+			}
+			if createVariableKeypairPublicKey != "" && !validators.IsValidVariable(createVariableKeypairPublicKey) {
+				return fmt.Errorf("\"%v\" is not a valid variable name", createVariableKeypairPublicKey)
+			}
+		}
+
+		if createVariableTypeCertificate {
+			if err := certificate.Validate(createVariableCertificateCommonName, createVariableCertificateSans, createVariableCertificateDays); err != nil {
+				return err
+			}
+			for _, key := range []string{createVariableCertificatePrivateKey, createVariableCertificateCaBundle} {
+				if key != "" && !validators.IsValidVariable(key) {
+					return fmt.Errorf("\"%v\" is not a valid variable name", key)
+				}
 			}
 		}
 
@@ -63,6 +141,16 @@ var createVariableCmd = &cobra.Command{
 			return fmt.Errorf("\"%v\" is required when using static variable type (if you want the value to be empty, use --empty)", args[0])
 		}
 
+		if err := createVariableTypeFlags.validate(cmd); err != nil {
+			return err
+		}
+
+		if tags, err := validators.NormalizeTags(createVariableTags); err != nil {
+			return err
+		} else {
+			createVariableTags = tags
+		}
+
 		return nil
 	},
 	RunE: func(c *cobra.Command, args []string) error {
@@ -76,14 +164,36 @@ var createVariableCmd = &cobra.Command{
 		found := false
 		if createVariableTypeRandom {
 			found = true
+			data := api2.RandomGeneratorData{
+				Length:  float32(createVariableRandomValueLength),
+				Letters: createVariableRandomValueUseLetters,
+				Symbols: createVariableRandomValueUseSymbols,
+				Numbers: createVariableRandomValueUseNumbers,
+			}
+			if c.Flags().Changed("alphabet") {
+				data.Alphabet = &createVariableRandomAlphabet
+			}
+			if c.Flags().Changed("exclude") {
+				data.Exclude = &createVariableRandomExclude
+			}
+			if c.Flags().Changed("exclude-ambiguous") {
+				data.ExcludeAmbiguous = &createVariableRandomExcludeAmbiguous
+			}
+			if c.Flags().Changed("min-lowercase") {
+				data.MinLowercase = &createVariableRandomMinLowercase
+			}
+			if c.Flags().Changed("min-uppercase") {
+				data.MinUppercase = &createVariableRandomMinUppercase
+			}
+			if c.Flags().Changed("min-numbers") {
+				data.MinNumbers = &createVariableRandomMinNumbers
+			}
+			if c.Flags().Changed("min-symbols") {
+				data.MinSymbols = &createVariableRandomMinSymbols
+			}
 			req.Generator.FromSecretGeneratorRandom(api2.SecretGeneratorRandom{
 				Type: api2.SecretGeneratorRandomType(api2.GeneratorTypeRANDOM),
-				Data: api2.RandomGeneratorData{
-					Length:  float32(createVariableRandomValueLength),
-					Letters: createVariableRandomValueUseLetters,
-					Symbols: createVariableRandomValueUseSymbols,
-					Numbers: createVariableRandomValueUseNumbers,
-				},
+				Data: data,
 			})
 		}
 		if createVariableTypeStatic {
@@ -97,10 +207,87 @@ var createVariableCmd = &cobra.Command{
 				Data: value,
 			})
 		}
+		if createVariableTypeUuid {
+			found = true
+			req.Generator.FromSecretGeneratorUuid(api2.SecretGeneratorUuid{
+				Type: api2.SecretGeneratorUuidType(api2.GeneratorTypeUUID),
+				Data: api2.UuidGeneratorData{
+					Version: api2.UuidGeneratorDataVersion(createVariableUuidVersion),
+				},
+			})
+		}
+		if createVariableTypeHex {
+			found = true
+			req.Generator.FromSecretGeneratorHex(api2.SecretGeneratorHex{
+				Type: api2.SecretGeneratorHexType(api2.GeneratorTypeHEX),
+				Data: api2.HexGeneratorData{
+					Bytes: createVariableBytes,
+				},
+			})
+		}
+		if createVariableTypeBase64 {
+			found = true
+			req.Generator.FromSecretGeneratorBase64(api2.SecretGeneratorBase64{
+				Type: api2.SecretGeneratorBase64Type(api2.GeneratorTypeBASE64),
+				Data: api2.Base64GeneratorData{
+					Bytes: createVariableBytes,
+					Url:   createVariableBase64Url,
+				},
+			})
+		}
+		if createVariableTypeKeypair {
+			found = true
+			publicKey := createVariableKeypairPublicKey
+			if publicKey == "" {
+				publicKey = publicKeyOf(args[0])
+			}
+			data := api2.KeypairGeneratorData{
+				Alg:       api2.KeypairGeneratorDataAlg(createVariableKeypairAlg),
+				Format:    api2.KeypairGeneratorDataFormat(createVariableKeypairFormat),
+				PublicKey: publicKey,
+			}
+			if createVariableKeypairBits != 0 {
+				data.Bits = &createVariableKeypairBits
+			}
+			req.Generator.FromSecretGeneratorKeypair(api2.SecretGeneratorKeypair{
+				Type: api2.SecretGeneratorKeypairType(api2.GeneratorTypeKEYPAIR),
+				Data: data,
+			})
+		}
+		if createVariableTypeCertificate {
+			found = true
+			privateKey, caBundle := createVariableCertificatePrivateKey, createVariableCertificateCaBundle
+			if privateKey == "" {
+				privateKey = certificateKeyOf(args[0], "KEY")
+			}
+			if caBundle == "" {
+				caBundle = certificateKeyOf(args[0], "CA")
+			}
+			data := api2.CertificateGeneratorData{
+				Sans:       createVariableCertificateSans,
+				Days:       &createVariableCertificateDays,
+				PrivateKey: privateKey,
+				CaBundle:   caBundle,
+			}
+			if createVariableCertificateCommonName != "" {
+				data.CommonName = &createVariableCertificateCommonName
+			}
+			req.Generator.FromSecretGeneratorCertificate(api2.SecretGeneratorCertificate{
+				Type: api2.SecretGeneratorCertificateType(api2.GeneratorTypeCERTIFICATE),
+				Data: data,
+			})
+		}
 		if !found {
 			return errors.New("an unexpected error occurred when choosing the variable type to generate")
 		}
 
+		req.Type, req.Constraints = createVariableTypeFlags.request(c)
+		req.Tags = api2.OptionalSlice(createVariableTags)
+		req.Owner = api2.Optional(createVariableOwner)
+		if createVariablePlain {
+			req.Sensitive = utils.Ptr(false)
+		}
+
 		resp, err := client.CreateVariableV1WithResponse(c.Context(), projectId, req)
 		if err != nil {
 			return fmt.Errorf("request failed: %v", err.Error())
@@ -126,6 +313,13 @@ func init() {
 	createVariableCmd.Flags().BoolVar(&createVariableRandomValueUseNumbers, "numbers", false, "include numbers in a random value")
 	createVariableCmd.Flags().BoolVar(&createVariableRandomValueUseSymbols, "symbols", false, "include symbols in a random value")
 	createVariableCmd.Flags().IntVar(&createVariableRandomValueLength, "length", 1, "length of the random value (min: 1)")
+	createVariableCmd.Flags().StringVar(&createVariableRandomAlphabet, "alphabet", "", "the characters of a random value (instead of --letters, --numbers and --symbols)")
+	createVariableCmd.Flags().StringVar(&createVariableRandomExclude, "exclude", "", "characters never to use in a random value")
+	createVariableCmd.Flags().BoolVar(&createVariableRandomExcludeAmbiguous, "exclude-ambiguous", false, "never use easily confused characters (0Oo1lI|) in a random value")
+	createVariableCmd.Flags().IntVar(&createVariableRandomMinLowercase, "min-lowercase", 0, "the minimum number of lowercase letters in a random value")
+	createVariableCmd.Flags().IntVar(&createVariableRandomMinUppercase, "min-uppercase", 0, "the minimum number of uppercase letters in a random value")
+	createVariableCmd.Flags().IntVar(&createVariableRandomMinNumbers, "min-numbers", 0, "the minimum number of numbers in a random value")
+	createVariableCmd.Flags().IntVar(&createVariableRandomMinSymbols, "min-symbols", 0, "the minimum number of symbols in a random value")
 
 	// static value generator
 	createVariableCmd.Flags().BoolVar(&createVariableTypeStatic, "static", false, "generate a static value")
@@ -133,9 +327,42 @@ func init() {
 	createVariableCmd.Flags().BoolVar(&createVariableStaticValueEmpty, "empty", false, "generate an empty static value")
 	createVariableCmd.MarkFlagsMutuallyExclusive("value", "empty")
 
+	// uuid generator
+	createVariableCmd.Flags().BoolVar(&createVariableTypeUuid, "uuid", false, "generate a uuid")
+	createVariableCmd.Flags().IntVar(&createVariableUuidVersion, "uuid-version", 4, "the version of a generated uuid (4 or 7)")
+
+	// random bytes generators
+	createVariableCmd.Flags().BoolVar(&createVariableTypeHex, "hex", false, "generate random bytes, hex-encoded")
+	createVariableCmd.Flags().BoolVar(&createVariableTypeBase64, "base64", false, "generate random bytes, base64-encoded")
+	createVariableCmd.Flags().IntVar(&createVariableBytes, "bytes", 32, "the number of random bytes to generate (min: 1, max: 1024)")
+	createVariableCmd.Flags().BoolVar(&createVariableBase64Url, "url-safe", false, "use the unpadded, URL-safe base64 alphabet (base64url)")
+
+	// keypair generator
+	createVariableCmd.Flags().BoolVar(&createVariableTypeKeypair, "keypair", false, "generate a private key, with its public key in a second variable")
+	createVariableCmd.Flags().StringVar(&createVariableKeypairAlg, "alg", keypair.Ed25519, "the algorithm of a keypair (ed25519, rsa or ecdsa)")
+	createVariableCmd.Flags().IntVar(&createVariableKeypairBits, "bits", 0, "the size of an rsa key (2048, 3072 or 4096) or ecdsa curve (256, 384 or 521)")
+	createVariableCmd.Flags().StringVar(&createVariableKeypairFormat, "format", keypair.PEM, "the encoding of a keypair (pem or jwk)")
+	createVariableCmd.Flags().StringVar(&createVariableKeypairPublicKey, "public-key", "", "the key of the variable holding the public key (default: the key with PRIVATE replaced by PUBLIC, or suffixed with _PUBLIC_KEY)")
+
+	// certificate generator
+	createVariableCmd.Flags().BoolVar(&createVariableTypeCertificate, "certificate", false, "issue a tls certificate (signed by the ca of the project), with its private key and ca bundle in two more variables")
+	createVariableCmd.Flags().StringVar(&createVariableCertificateCommonName, "common-name", "", "the common name of a certificate (default: its first --san)")
+	createVariableCmd.Flags().StringSliceVar(&createVariableCertificateSans, "san", []string{}, "a subject alternative name (dns name or ip address) of a certificate (repeatable)")
+	createVariableCmd.Flags().IntVar(&createVariableCertificateDays, "days", certificate.DefaultDays, fmt.Sprintf("how long a certificate is valid for, in days (max: %d)", certificate.MaxDays))
+	createVariableCmd.Flags().StringVar(&createVariableCertificatePrivateKey, "private-key", "", "the key of the variable holding the private key of a certificate (default: the key with CERT replaced by KEY, or suffixed with _KEY)")
+	createVariableCmd.Flags().StringVar(&createVariableCertificateCaBundle, "ca-bundle", "", "the key of the variable holding the ca bundle of a certificate (default: the key with CERT replaced by CA, or suffixed with _CA)")
+
+	// value type and constraints
+	setupTypeFlags(createVariableCmd, &createVariableTypeFlags)
+
+	// metadata
+	createVariableCmd.Flags().StringSliceVar(&createVariableTags, "tag", []string{}, "a tag of the variable, e.g. billing or service:api (repeatable)")
+	createVariableCmd.Flags().StringVar(&createVariableOwner, "owner", "", "the person or team responsible for the variable")
+	createVariableCmd.Flags().BoolVar(&createVariablePlain, "plain", false, "the variable is plain configuration, not sensitive (its values are shown unmasked)")
+
 	// XOR
-	createVariableCmd.MarkFlagsOneRequired("random", "static")
-	createVariableCmd.MarkFlagsMutuallyExclusive("random", "static")
+	createVariableCmd.MarkFlagsOneRequired("random", "static", "uuid", "hex", "base64", "keypair", "certificate")
+	createVariableCmd.MarkFlagsMutuallyExclusive("random", "static", "uuid", "hex", "base64", "keypair", "certificate")
 
 	createVariableCmd.Flags().StringVar(&projectIdStr, flags.ProjectIdFlag, "", "the id of the project to create the variable with")
 	err := createVariableCmd.MarkFlagRequired(flags.ProjectIdFlag)
@@ -146,3 +373,22 @@ func init() {
 	flags.SetupAuthFlags(createVariableCmd, authFlags)
 	viper.BindPFlags(createVariableCmd.Flags())
 }
+
+// publicKeyOf is the default key of the variable holding the public key of a keypair
+func publicKeyOf(key string) string {
+	if strings.Contains(key, "PRIVATE") {
+		return strings.Replace(key, "PRIVATE", "PUBLIC", 1)
+	}
+	return key + "_PUBLIC_KEY"
+}
+
+// certificateKeyOf is the default key of the variable holding the private key ("KEY") or ca bundle
+// ("CA") of a certificate
+func certificateKeyOf(key string, suffix string) string {
+	for _, cert := range []string{"CERTIFICATE", "CERT"} {
+		if strings.Contains(key, cert) {
+			return strings.Replace(key, cert, suffix, 1)
+		}
+	}
+	return key + "_" + suffix
+}
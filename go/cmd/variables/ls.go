@@ -16,7 +16,15 @@ import (
 	"github.com/spf13/viper"
 	"github.com/train360-corp/projconf/go/internal/flags"
 	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
 	"github.com/train360-corp/projconf/go/pkg/api"
+	"strings"
+)
+
+var (
+	listVariablesTags          []string
+	listVariablesEnvironmentId string // show the values in an environment
+	listVariablesReveal        bool
 )
 
 var listVariablesCmd = &cobra.Command{
@@ -32,28 +40,68 @@ var listVariablesCmd = &cobra.Command{
 			return fmt.Errorf("\"%v\" is not a valid project id (%v)", projectIdStr, err)
 		}
 		projectId = id
+
+		tags, err := validators.NormalizeTags(listVariablesTags)
+		if err != nil {
+			return err
+		}
+		listVariablesTags = tags
+
+		if listVariablesEnvironmentId != "" {
+			if _, err := uuid.Parse(listVariablesEnvironmentId); err != nil {
+				return fmt.Errorf("\"%v\" is not a valid environment id (%v)", listVariablesEnvironmentId, err)
+			}
+		} else if listVariablesReveal {
+			return fmt.Errorf("--reveal requires --%s", flags.EnvironmentIdFlag)
+		}
+
 		return nil
 	},
 	RunE: func(c *cobra.Command, args []string) error {
 		client, _ := api.FromFlags(authFlags)
-		resp, err := client.GetVariablesV1WithResponse(c.Context(), projectId)
+		variables, err := api.Paginate(func(limit api.Limit, offset api.Offset) ([]api.VariableObject, error) {
+			resp, err := client.GetVariablesV1WithResponse(c.Context(), projectId, &api.GetVariablesV1Params{
+				Limit:  &limit,
+				Offset: &offset,
+				Filter: api.Optional(listFlags.Filter),
+				Sort:   api.Optional(api.GetVariablesV1ParamsSort(listFlags.Sort)),
+				Tag:    api.OptionalSlice(listVariablesTags),
+			})
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("request failed: %v", err.Error()))
+			}
+			return api.Page(resp.JSON200, resp)
+		})
 		if err != nil {
-			return errors.New(fmt.Sprintf("request failed: %v", err.Error()))
+			return err
 		}
 
-		if resp.JSON200 != nil {
-			if len(*resp.JSON200) == 0 {
-				fmt.Fprintln(c.OutOrStdout(), "no variables found")
-			} else {
-				fmt.Fprintln(c.OutOrStdout(), tables.Build[api.VariableObject](
-					*resp.JSON200,
-					tables.ColumnsByFieldNames[api.VariableObject]("Id", "Key", "GeneratorType", "GeneratorData"),
-					tables.WithTitle("Variables"),
-					tables.WithStyle(table.StyleLight),
-				))
+		columns := variableColumns()
+		if listVariablesEnvironmentId != "" {
+			values, err := listValues(c, client, uuid.MustParse(listVariablesEnvironmentId))
+			if err != nil {
+				return err
 			}
+			columns = append(columns, tables.Column[api.VariableObject]{Header: "Value", Cell: func(r api.VariableObject) any {
+				if value, ok := values[r.Id]; !ok {
+					return "-"
+				} else if value.Masked || value.Value == nil {
+					return maskedValue
+				} else {
+					return *value.Value
+				}
+			}})
+		}
+
+		if len(variables) == 0 {
+			fmt.Fprintln(c.OutOrStdout(), "no variables found")
 		} else {
-			return errors.New(api.GetAPIError(resp))
+			fmt.Fprintln(c.OutOrStdout(), tables.Build[api.VariableObject](
+				variables,
+				columns,
+				tables.WithTitle("Variables"),
+				tables.WithStyle(table.StyleLight),
+			))
 		}
 
 		return nil
@@ -63,6 +111,44 @@ var listVariablesCmd = &cobra.Command{
 func init() {
 	listVariablesCmd.Flags().StringVar(&projectIdStr, flags.ProjectIdFlag, "", "the id of the project to list variables for")
 	listVariablesCmd.MarkFlagRequired(flags.ProjectIdFlag)
+	flags.SetupListFlags(listVariablesCmd, &listFlags, "key", "key")
+	listVariablesCmd.Flags().StringSliceVar(&listVariablesTags, "tag", []string{}, "only list variables with this tag (repeatable; every tag must match)")
+	listVariablesCmd.Flags().StringVar(&listVariablesEnvironmentId, flags.EnvironmentIdFlag, "", "also show the values of the variables in this environment (sensitive ones are masked)")
+	listVariablesCmd.Flags().BoolVar(&listVariablesReveal, "reveal", false, "show the values of sensitive variables too (recorded in the audit log)")
 	flags.SetupAuthFlags(listVariablesCmd, authFlags)
 	viper.BindPFlags(listVariablesCmd.Flags())
 }
+
+// maskedValue is shown in place of the value of a sensitive variable
+const maskedValue = "********"
+
+// listValues fetches the values of an environment, keyed by the id of their variable
+func listValues(c *cobra.Command, client *api.ClientWithResponses, environmentId uuid.UUID) (map[uuid.UUID]api.ValueObject, error) {
+	resp, err := client.GetEnvironmentValuesV1WithResponse(c.Context(), environmentId, &api.GetEnvironmentValuesV1Params{
+		Reveal: api.Optional(listVariablesReveal),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %v", err.Error())
+	} else if resp.JSON200 == nil {
+		return nil, errors.New(api.GetAPIError(resp))
+	}
+	values := make(map[uuid.UUID]api.ValueObject, len(*resp.JSON200))
+	for _, value := range *resp.JSON200 {
+		values[value.VariableId] = value
+	}
+	return values, nil
+}
+
+// variableColumns are the columns of a table of variables
+func variableColumns() []tables.Column[api.VariableObject] {
+	return append(
+		tables.ColumnsByFieldNames[api.VariableObject]("Id", "Key", "Type", "Sensitive", "GeneratorType", "GeneratorData"),
+		tables.Column[api.VariableObject]{Header: "Tags", Cell: func(r api.VariableObject) any { return strings.Join(r.Tags, ", ") }},
+		tables.Column[api.VariableObject]{Header: "Owner", Cell: func(r api.VariableObject) any {
+			if r.Owner == nil {
+				return ""
+			}
+			return *r.Owner
+		}},
+	)
+}
@@ -18,6 +18,7 @@ var (
 	authFlags    *flags.AuthFlags = flags.GetAuthFlags()
 	projectIdStr string
 	projectId    uuid.UUID
+	listFlags    flags.ListFlags
 )
 
 var Command = &cobra.Command{
@@ -37,4 +38,5 @@ var Command = &cobra.Command{
 func init() {
 	Command.AddCommand(listVariablesCmd)
 	Command.AddCommand(createVariableCmd)
+	Command.AddCommand(updateVariableCmd)
 }
@@ -0,0 +1,134 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package variables
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	api2 "github.com/train360-corp/projconf/go/pkg/api"
+	"slices"
+	"strings"
+)
+
+// valueTypes are the types a variable may declare with --type
+var valueTypes = []api2.ValueType{api2.STRING, api2.INT, api2.BOOL, api2.URL, api2.ENUM, api2.REGEX, api2.JSON}
+
+// typeFlags are the type of a variable and the constraints on its values
+type typeFlags struct {
+	Type      string
+	MinLength int
+	MaxLength int
+	Min       int64
+	Max       int64
+	Schemes   []string
+	Values    []string
+	Pattern   string
+}
+
+// typeConstraintFlags are the constraint flags, by the type they apply to
+var typeConstraintFlags = map[api2.ValueType][]string{
+	api2.STRING: {"min-length", "max-length"},
+	api2.INT:    {"min", "max"},
+	api2.URL:    {"scheme"},
+	api2.ENUM:   {"enum"},
+	api2.REGEX:  {"pattern"},
+}
+
+func setupTypeFlags(cmd *cobra.Command, flags *typeFlags) {
+	cmd.Flags().StringVar(&flags.Type, "type", "", "the type of the values (string, int, bool, url, enum, regex or json)")
+	cmd.Flags().IntVar(&flags.MinLength, "min-length", 0, "the minimum length of a string value")
+	cmd.Flags().IntVar(&flags.MaxLength, "max-length", 0, "the maximum length of a string value")
+	cmd.Flags().Int64Var(&flags.Min, "min", 0, "the minimum of an int value")
+	cmd.Flags().Int64Var(&flags.Max, "max", 0, "the maximum of an int value")
+	cmd.Flags().StringSliceVar(&flags.Schemes, "scheme", []string{}, "a scheme a url value may use (repeatable; default: any)")
+	cmd.Flags().StringSliceVar(&flags.Values, "enum", []string{}, "a value an enum may take (repeatable)")
+	cmd.Flags().StringVar(&flags.Pattern, "pattern", "", "the regular expression a regex value must match in full")
+}
+
+// validate checks the type and that every constraint given applies to it (the type of an
+// existing variable is not known here, so constraints without --type are left to the server)
+func (flags *typeFlags) validate(cmd *cobra.Command) error {
+	if !cmd.Flags().Changed("type") {
+		return nil
+	}
+
+	t := api2.ValueType(strings.ToUpper(flags.Type))
+	if !slices.Contains(valueTypes, t) {
+		return fmt.Errorf("\"%v\" is not a valid type (string, int, bool, url, enum, regex or json)", flags.Type)
+	}
+	flags.Type = string(t)
+
+	for other, names := range typeConstraintFlags {
+		for _, name := range names {
+			if other != t && cmd.Flags().Changed(name) {
+				return fmt.Errorf("--%s does not apply to a %s value", name, strings.ToLower(string(t)))
+			}
+		}
+	}
+
+	switch t {
+	case api2.STRING:
+		if flags.MinLength < 0 || flags.MaxLength < 0 {
+			return fmt.Errorf("a length cannot be negative")
+		}
+		if cmd.Flags().Changed("min-length") && cmd.Flags().Changed("max-length") && flags.MinLength > flags.MaxLength {
+			return fmt.Errorf("--min-length (%d) is greater than --max-length (%d)", flags.MinLength, flags.MaxLength)
+		}
+	case api2.INT:
+		if cmd.Flags().Changed("min") && cmd.Flags().Changed("max") && flags.Min > flags.Max {
+			return fmt.Errorf("--min (%d) is greater than --max (%d)", flags.Min, flags.Max)
+		}
+	case api2.ENUM:
+		if len(flags.Values) == 0 {
+			return fmt.Errorf("an enum requires at least one --enum value")
+		}
+	case api2.REGEX:
+		if flags.Pattern == "" {
+			return fmt.Errorf("a regex requires a --pattern")
+		}
+	}
+
+	return nil
+}
+
+// request is the type and constraints to send (nil for those not given)
+func (flags *typeFlags) request(cmd *cobra.Command) (*api2.ValueType, *api2.ValueConstraints) {
+	var constraints api2.ValueConstraints
+	changed := false
+	if cmd.Flags().Changed("min-length") {
+		constraints.MinLength, changed = &flags.MinLength, true
+	}
+	if cmd.Flags().Changed("max-length") {
+		constraints.MaxLength, changed = &flags.MaxLength, true
+	}
+	if cmd.Flags().Changed("min") {
+		constraints.Min, changed = &flags.Min, true
+	}
+	if cmd.Flags().Changed("max") {
+		constraints.Max, changed = &flags.Max, true
+	}
+	if cmd.Flags().Changed("scheme") {
+		constraints.Schemes, changed = &flags.Schemes, true
+	}
+	if cmd.Flags().Changed("enum") {
+		constraints.Values, changed = &flags.Values, true
+	}
+	if cmd.Flags().Changed("pattern") {
+		constraints.Pattern, changed = &flags.Pattern, true
+	}
+
+	var t *api2.ValueType
+	if cmd.Flags().Changed("type") {
+		value := api2.ValueType(flags.Type)
+		t = &value
+	}
+	if !changed {
+		return t, nil
+	}
+	return t, &constraints
+}
@@ -0,0 +1,391 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package variables
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/train360-corp/projconf/go/internal/flags"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/internal/utils/certificate"
+	"github.com/train360-corp/projconf/go/internal/utils/keypair"
+	"github.com/train360-corp/projconf/go/internal/utils/tables"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	api2 "github.com/train360-corp/projconf/go/pkg/api"
+	"strings"
+)
+
+var (
+	updateVariableId          uuid.UUID
+	updateVariableKey         string
+	updateVariableDescription string
+	updateVariableRegenerate  bool
+
+	updateVariableTypeStatic       bool // static generator
+	updateVariableStaticValue      string
+	updateVariableStaticValueEmpty bool
+
+	updateVariableTypeRandom             bool // random generator
+	updateVariableRandomValueLength      int
+	updateVariableRandomValueUseNumbers  bool
+	updateVariableRandomValueUseLetters  bool
+	updateVariableRandomValueUseSymbols  bool
+	updateVariableRandomAlphabet         string
+	updateVariableRandomExclude          string
+	updateVariableRandomExcludeAmbiguous bool
+	updateVariableRandomMinLowercase     int
+	updateVariableRandomMinUppercase     int
+	updateVariableRandomMinNumbers       int
+	updateVariableRandomMinSymbols       int
+
+	updateVariableTypeUuid    bool // uuid generator
+	updateVariableUuidVersion int
+
+	updateVariableTypeHex    bool // random bytes generators
+	updateVariableTypeBase64 bool
+	updateVariableBytes      int
+	updateVariableBase64Url  bool
+
+	updateVariableTypeKeypair      bool // keypair generator
+	updateVariableKeypairAlg       string
+	updateVariableKeypairBits      int
+	updateVariableKeypairFormat    string
+	updateVariableKeypairPublicKey string
+
+	updateVariableTypeCertificate       bool // certificate generator
+	updateVariableCertificateCommonName string
+	updateVariableCertificateSans       []string
+	updateVariableCertificateDays       int
+	updateVariableCertificatePrivateKey string
+	updateVariableCertificateCaBundle   string
+
+	updateVariableTypeFlags typeFlags // value type and constraints
+
+	updateVariableTags      []string // metadata
+	updateVariableOwner     string
+	updateVariableSensitive bool
+	updateVariablePlain     bool
+)
+
+var updateVariableCmd = &cobra.Command{
+	Use:           "update",
+	Aliases:       []string{"edit"},
+	SilenceUsage:  false,
+	SilenceErrors: false,
+	Args:          cobra.ExactArgs(1),
+	Short:         "Update a variable (key, description, generator, type, tags, owner or sensitivity) in a ProjConf server instance",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("\"%v\" is not a valid variable id (%v)", args[0], err)
+		}
+		updateVariableId = id
+
+		if cmd.Flags().Changed("key") && !validators.IsValidVariable(updateVariableKey) {
+			return fmt.Errorf("\"%v\" is not a valid variable name", updateVariableKey)
+		}
+
+		if updateVariableTypeRandom {
+			if updateVariableRandomValueLength < 1 {
+				return fmt.Errorf("\"%v\" is not a valid variable length (min: 1)", updateVariableRandomValueLength)
+			}
+			if !updateVariableRandomValueUseNumbers && !updateVariableRandomValueUseLetters && !updateVariableRandomValueUseSymbols && updateVariableRandomAlphabet == "" {
+				return fmt.Errorf("at least one of --numbers, --letters, --symbols, or --alphabet must be provided")
+			}
+			minimums := 0
+			for _, n := range []int{updateVariableRandomMinLowercase, updateVariableRandomMinUppercase, updateVariableRandomMinNumbers, updateVariableRandomMinSymbols} {
+				if n < 0 {
+					return fmt.Errorf("\"%v\" is not a valid minimum (min: 0)", n)
+				}
+				minimums += n
+			}
+			if minimums > updateVariableRandomValueLength {
+				return fmt.Errorf("the minimums (%d) do not fit in the length (%d)", minimums, updateVariableRandomValueLength)
+			}
+		}
+
+		if updateVariableTypeUuid && updateVariableUuidVersion != 4 && updateVariableUuidVersion != 7 {
+			return fmt.Errorf("\"%v\" is not a valid uuid version (4 or 7)", updateVariableUuidVersion)
+		}
+
+		if (updateVariableTypeHex || updateVariableTypeBase64) && (updateVariableBytes < 1 || updateVariableBytes > 1024) {
+			return fmt.Errorf("\"%v\" is not a valid number of bytes (min: 1, max: 1024)", updateVariableBytes)
+		}
+
+		if updateVariableTypeKeypair {
+			if !cmd.Flags().Changed("bits") {
+				updateVariableKeypairBits = keypair.DefaultBits[updateVariableKeypairAlg]
+			}
+			if err := keypair.Validate(updateVariableKeypairAlg, updateVariableKeypairBits, updateVariableKeypairFormat); err != nil {
+				return err
+			}
+			if updateVariableKeypairPublicKey != "" && !validators.IsValidVariable(updateVariableKeypairPublicKey) {
+				return fmt.Errorf("\"%v\" is not a valid variable name", updateVariableKeypairPublicKey)
+			}
+		}
+
+		if updateVariableTypeCertificate {
+			if err := certificate.Validate(updateVariableCertificateCommonName, updateVariableCertificateSans, updateVariableCertificateDays); err != nil {
+				return err
+			}
+			for _, key := range []string{updateVariableCertificatePrivateKey, updateVariableCertificateCaBundle} {
+				if key != "" && !validators.IsValidVariable(key) {
+					return fmt.Errorf("\"%v\" is not a valid variable name", key)
+				}
+			}
+		}
+
+		if updateVariableTypeStatic && updateVariableStaticValue == "" && !updateVariableStaticValueEmpty {
+			return fmt.Errorf("--value is required when using static variable type (if you want the value to be empty, use --empty)")
+		}
+
+		if err := updateVariableTypeFlags.validate(cmd); err != nil {
+			return err
+		}
+
+		if tags, err := validators.NormalizeTags(updateVariableTags); err != nil {
+			return err
+		} else {
+			updateVariableTags = tags
+		}
+
+		return nil
+	},
+	RunE: func(c *cobra.Command, args []string) error {
+
+		client, _ := api2.FromFlags(authFlags)
+
+		req := api2.UpdateVariableV1JSONRequestBody{}
+		if c.Flags().Changed("key") {
+			req.Key = &updateVariableKey
+		}
+		if c.Flags().Changed("description") {
+			req.Description = &updateVariableDescription
+		}
+		if c.Flags().Changed("regenerate") {
+			req.Regenerate = &updateVariableRegenerate
+		}
+
+		if updateVariableTypeRandom {
+			req.Generator = &api2.SecretGenerator{}
+			data := api2.RandomGeneratorData{
+				Length:  float32(updateVariableRandomValueLength),
+				Letters: updateVariableRandomValueUseLetters,
+				Symbols: updateVariableRandomValueUseSymbols,
+				Numbers: updateVariableRandomValueUseNumbers,
+			}
+			if c.Flags().Changed("alphabet") {
+				data.Alphabet = &updateVariableRandomAlphabet
+			}
+			if c.Flags().Changed("exclude") {
+				data.Exclude = &updateVariableRandomExclude
+			}
+			if c.Flags().Changed("exclude-ambiguous") {
+				data.ExcludeAmbiguous = &updateVariableRandomExcludeAmbiguous
+			}
+			if c.Flags().Changed("min-lowercase") {
+				data.MinLowercase = &updateVariableRandomMinLowercase
+			}
+			if c.Flags().Changed("min-uppercase") {
+				data.MinUppercase = &updateVariableRandomMinUppercase
+			}
+			if c.Flags().Changed("min-numbers") {
+				data.MinNumbers = &updateVariableRandomMinNumbers
+			}
+			if c.Flags().Changed("min-symbols") {
+				data.MinSymbols = &updateVariableRandomMinSymbols
+			}
+			req.Generator.FromSecretGeneratorRandom(api2.SecretGeneratorRandom{
+				Type: api2.SecretGeneratorRandomType(api2.GeneratorTypeRANDOM),
+				Data: data,
+			})
+		}
+		if updateVariableTypeStatic {
+			value := ""
+			if !updateVariableStaticValueEmpty {
+				value = updateVariableStaticValue
+			}
+			req.Generator = &api2.SecretGenerator{}
+			req.Generator.FromSecretGeneratorStatic(api2.SecretGeneratorStatic{
+				Type: api2.SecretGeneratorStaticType(api2.GeneratorTypeSTATIC),
+				Data: value,
+			})
+		}
+
+		if updateVariableTypeUuid {
+			req.Generator = &api2.SecretGenerator{}
+			req.Generator.FromSecretGeneratorUuid(api2.SecretGeneratorUuid{
+				Type: api2.SecretGeneratorUuidType(api2.GeneratorTypeUUID),
+				Data: api2.UuidGeneratorData{
+					Version: api2.UuidGeneratorDataVersion(updateVariableUuidVersion),
+				},
+			})
+		}
+		if updateVariableTypeHex {
+			req.Generator = &api2.SecretGenerator{}
+			req.Generator.FromSecretGeneratorHex(api2.SecretGeneratorHex{
+				Type: api2.SecretGeneratorHexType(api2.GeneratorTypeHEX),
+				Data: api2.HexGeneratorData{
+					Bytes: updateVariableBytes,
+				},
+			})
+		}
+		if updateVariableTypeBase64 {
+			req.Generator = &api2.SecretGenerator{}
+			req.Generator.FromSecretGeneratorBase64(api2.SecretGeneratorBase64{
+				Type: api2.SecretGeneratorBase64Type(api2.GeneratorTypeBASE64),
+				Data: api2.Base64GeneratorData{
+					Bytes: updateVariableBytes,
+					Url:   updateVariableBase64Url,
+				},
+			})
+		}
+		if updateVariableTypeKeypair {
+			data := api2.KeypairGeneratorData{
+				Alg:       api2.KeypairGeneratorDataAlg(updateVariableKeypairAlg),
+				Format:    api2.KeypairGeneratorDataFormat(updateVariableKeypairFormat),
+				PublicKey: updateVariableKeypairPublicKey,
+			}
+			if updateVariableKeypairBits != 0 {
+				data.Bits = &updateVariableKeypairBits
+			}
+			req.Generator = &api2.SecretGenerator{}
+			req.Generator.FromSecretGeneratorKeypair(api2.SecretGeneratorKeypair{
+				Type: api2.SecretGeneratorKeypairType(api2.GeneratorTypeKEYPAIR),
+				Data: data,
+			})
+		}
+		if updateVariableTypeCertificate {
+			data := api2.CertificateGeneratorData{
+				Sans:       updateVariableCertificateSans,
+				Days:       &updateVariableCertificateDays,
+				PrivateKey: updateVariableCertificatePrivateKey,
+				CaBundle:   updateVariableCertificateCaBundle,
+			}
+			if updateVariableCertificateCommonName != "" {
+				data.CommonName = &updateVariableCertificateCommonName
+			}
+			req.Generator = &api2.SecretGenerator{}
+			req.Generator.FromSecretGeneratorCertificate(api2.SecretGeneratorCertificate{
+				Type: api2.SecretGeneratorCertificateType(api2.GeneratorTypeCERTIFICATE),
+				Data: data,
+			})
+		}
+
+		req.Type, req.Constraints = updateVariableTypeFlags.request(c)
+		if c.Flags().Changed("tag") {
+			req.Tags = &updateVariableTags
+		}
+		if c.Flags().Changed("owner") {
+			req.Owner = &updateVariableOwner
+		}
+		if updateVariableSensitive {
+			req.Sensitive = utils.Ptr(true)
+		} else if updateVariablePlain {
+			req.Sensitive = utils.Ptr(false)
+		}
+
+		resp, err := client.UpdateVariableV1WithResponse(c.Context(), updateVariableId, req)
+		if err != nil {
+			return fmt.Errorf("request failed: %v", err.Error())
+		}
+
+		if resp.JSON200 != nil {
+			fmt.Fprintln(c.OutOrStdout(), tables.Build(
+				[]api2.VariableObject{*resp.JSON200},
+				variableColumns(),
+				tables.WithTitle("Variable"),
+				tables.WithStyle(table.StyleLight),
+			))
+		} else {
+			if strings.Index(string(resp.Body), "\"duplicate\"") != -1 {
+				return fmt.Errorf("variable \"%s\" already exists", updateVariableKey)
+			}
+			return errors.New(api2.GetAPIError(resp))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	updateVariableCmd.Flags().StringVar(&updateVariableKey, "key", "", "the new key of the variable")
+	updateVariableCmd.Flags().StringVar(&updateVariableDescription, "description", "", "the new description of the variable")
+	updateVariableCmd.Flags().BoolVar(&updateVariableRegenerate, "regenerate", false, "regenerate the secret in every environment from the (new) generator")
+
+	// random value generator
+	updateVariableCmd.Flags().BoolVar(&updateVariableTypeRandom, "random", false, "switch to a random value generator")
+	updateVariableCmd.Flags().BoolVar(&updateVariableRandomValueUseLetters, "letters", false, "include letters in a random value")
+	updateVariableCmd.Flags().BoolVar(&updateVariableRandomValueUseNumbers, "numbers", false, "include numbers in a random value")
+	updateVariableCmd.Flags().BoolVar(&updateVariableRandomValueUseSymbols, "symbols", false, "include symbols in a random value")
+	updateVariableCmd.Flags().IntVar(&updateVariableRandomValueLength, "length", 1, "length of the random value (min: 1)")
+	updateVariableCmd.Flags().StringVar(&updateVariableRandomAlphabet, "alphabet", "", "the characters of a random value (instead of --letters, --numbers and --symbols)")
+	updateVariableCmd.Flags().StringVar(&updateVariableRandomExclude, "exclude", "", "characters never to use in a random value")
+	updateVariableCmd.Flags().BoolVar(&updateVariableRandomExcludeAmbiguous, "exclude-ambiguous", false, "never use easily confused characters (0Oo1lI|) in a random value")
+	updateVariableCmd.Flags().IntVar(&updateVariableRandomMinLowercase, "min-lowercase", 0, "the minimum number of lowercase letters in a random value")
+	updateVariableCmd.Flags().IntVar(&updateVariableRandomMinUppercase, "min-uppercase", 0, "the minimum number of uppercase letters in a random value")
+	updateVariableCmd.Flags().IntVar(&updateVariableRandomMinNumbers, "min-numbers", 0, "the minimum number of numbers in a random value")
+	updateVariableCmd.Flags().IntVar(&updateVariableRandomMinSymbols, "min-symbols", 0, "the minimum number of symbols in a random value")
+
+	// static value generator
+	updateVariableCmd.Flags().BoolVar(&updateVariableTypeStatic, "static", false, "switch to a static value generator")
+	updateVariableCmd.Flags().StringVar(&updateVariableStaticValue, "value", "", "the value to use for a static generator")
+	updateVariableCmd.Flags().BoolVar(&updateVariableStaticValueEmpty, "empty", false, "generate an empty static value")
+	updateVariableCmd.MarkFlagsMutuallyExclusive("value", "empty")
+
+	// uuid generator
+	updateVariableCmd.Flags().BoolVar(&updateVariableTypeUuid, "uuid", false, "switch to a uuid generator")
+	updateVariableCmd.Flags().IntVar(&updateVariableUuidVersion, "uuid-version", 4, "the version of a generated uuid (4 or 7)")
+
+	// random bytes generators
+	updateVariableCmd.Flags().BoolVar(&updateVariableTypeHex, "hex", false, "switch to a generator of random bytes, hex-encoded")
+	updateVariableCmd.Flags().BoolVar(&updateVariableTypeBase64, "base64", false, "switch to a generator of random bytes, base64-encoded")
+	updateVariableCmd.Flags().IntVar(&updateVariableBytes, "bytes", 32, "the number of random bytes to generate (min: 1, max: 1024)")
+	updateVariableCmd.Flags().BoolVar(&updateVariableBase64Url, "url-safe", false, "use the unpadded, URL-safe base64 alphabet (base64url)")
+
+	// keypair generator
+	updateVariableCmd.Flags().BoolVar(&updateVariableTypeKeypair, "keypair", false, "switch to a keypair generator")
+	updateVariableCmd.Flags().StringVar(&updateVariableKeypairAlg, "alg", keypair.Ed25519, "the algorithm of a keypair (ed25519, rsa or ecdsa)")
+	updateVariableCmd.Flags().IntVar(&updateVariableKeypairBits, "bits", 0, "the size of an rsa key (2048, 3072 or 4096) or ecdsa curve (256, 384 or 521)")
+	updateVariableCmd.Flags().StringVar(&updateVariableKeypairFormat, "format", keypair.PEM, "the encoding of a keypair (pem or jwk)")
+	updateVariableCmd.Flags().StringVar(&updateVariableKeypairPublicKey, "public-key", "", "the key of the variable holding the public key")
+
+	// certificate generator
+	updateVariableCmd.Flags().BoolVar(&updateVariableTypeCertificate, "certificate", false, "switch to a certificate generator")
+	updateVariableCmd.Flags().StringVar(&updateVariableCertificateCommonName, "common-name", "", "the common name of a certificate (default: its first --san)")
+	updateVariableCmd.Flags().StringSliceVar(&updateVariableCertificateSans, "san", []string{}, "a subject alternative name (dns name or ip address) of a certificate (repeatable)")
+	updateVariableCmd.Flags().IntVar(&updateVariableCertificateDays, "days", certificate.DefaultDays, fmt.Sprintf("how long a certificate is valid for, in days (max: %d)", certificate.MaxDays))
+	updateVariableCmd.Flags().StringVar(&updateVariableCertificatePrivateKey, "private-key", "", "the key of the variable holding the private key of a certificate")
+	updateVariableCmd.Flags().StringVar(&updateVariableCertificateCaBundle, "ca-bundle", "", "the key of the variable holding the ca bundle of a certificate")
+
+	// value type and constraints
+	setupTypeFlags(updateVariableCmd, &updateVariableTypeFlags)
+
+	// metadata
+	updateVariableCmd.Flags().StringSliceVar(&updateVariableTags, "tag", []string{}, "a tag of the variable (repeatable; replaces its tags, --tag= removes them)")
+	updateVariableCmd.Flags().StringVar(&updateVariableOwner, "owner", "", "the person or team responsible for the variable (--owner= removes it)")
+	updateVariableCmd.Flags().BoolVar(&updateVariableSensitive, "sensitive", false, "the variable is sensitive (its values are masked unless revealed)")
+	updateVariableCmd.Flags().BoolVar(&updateVariablePlain, "plain", false, "the variable is plain configuration, not sensitive (its values are shown unmasked)")
+
+	updateVariableCmd.MarkFlagsOneRequired("key", "description", "random", "static", "uuid", "hex", "base64", "keypair", "certificate", "tag", "owner", "sensitive", "plain", "type", "min-length", "max-length", "min", "max", "scheme", "enum", "pattern")
+	updateVariableCmd.MarkFlagsMutuallyExclusive("random", "static", "uuid", "hex", "base64", "keypair", "certificate")
+	updateVariableCmd.MarkFlagsMutuallyExclusive("sensitive", "plain")
+	updateVariableCmd.MarkFlagsRequiredTogether("certificate", "private-key", "ca-bundle")
+	updateVariableCmd.MarkFlagsRequiredTogether("keypair", "public-key")
+
+	flags.SetupAuthFlags(updateVariableCmd, authFlags)
+	err := viper.BindPFlags(updateVariableCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+}
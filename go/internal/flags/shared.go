@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"github.com/spf13/cobra"
 	"github.com/train360-corp/projconf/go/internal/defaults"
+	"strings"
 )
 
 const (
@@ -20,6 +21,9 @@ const (
 	ClientSecretFlag   string = "client-secret"
 	EnvironmentIdFlag  string = "environment-id"
 	ProjectIdFlag      string = "project-id"
+	ClientIdFlag       string = "client-id"
+	FilterFlag         string = "filter"
+	SortFlag           string = "sort"
 )
 
 type AuthFlags struct {
@@ -38,6 +42,16 @@ func GetAuthFlags() *AuthFlags {
 	}
 }
 
+type ListFlags struct {
+	Filter string
+	Sort   string
+}
+
+func SetupListFlags(cmd *cobra.Command, flags *ListFlags, field string, sorts ...string) {
+	cmd.Flags().StringVar(&flags.Filter, FilterFlag, "", fmt.Sprintf("only list rows whose %s starts with this prefix (case-insensitive)", field))
+	cmd.Flags().StringVar(&flags.Sort, SortFlag, "", fmt.Sprintf("sort by one of: %s (prefix with \"-\" for descending)", strings.Join(sorts, ", ")))
+}
+
 func SetupAuthFlags(cmd *cobra.Command, flags *AuthFlags) {
 	SetupUrlFlag(cmd, &flags.Url)
 	SetupAdminApiKeyFlag(cmd, &flags.AdminApiKey)
@@ -0,0 +1,190 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultDays is the validity of a certificate when none is given
+	DefaultDays = 90
+
+	// MaxDays is the longest validity of a certificate (the limit browsers put on leaf certificates)
+	MaxDays = 825
+
+	// authorityYears is the validity of the ca of a project
+	authorityYears = 10
+
+	// backdate allows for clocks that are slightly behind
+	backdate = 5 * time.Minute
+)
+
+var dnsName = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// Validate checks that a certificate can be issued for the given names and validity; every
+// subject alternative name must be a dns name (optionally a wildcard) or an ip address
+func Validate(commonName string, sans []string, days int) error {
+	if len(commonName) > 64 {
+		return fmt.Errorf("\"%s\" is not a valid common name (max: 64 characters)", commonName)
+	}
+	if commonName == "" && len(sans) == 0 {
+		return errors.New("a common name or at least one subject alternative name is required")
+	}
+	for _, san := range sans {
+		if net.ParseIP(san) == nil && (len(san) > 253 || !dnsName.MatchString(san)) {
+			return fmt.Errorf("\"%s\" is not a valid subject alternative name (a dns name or ip address)", san)
+		}
+	}
+	if days < 1 || days > MaxDays {
+		return fmt.Errorf("%d is not a valid validity (min: 1, max: %d days)", days, MaxDays)
+	}
+	return nil
+}
+
+// NewAuthority creates a self-signed ca and returns its certificate and private key, encoded as PEM
+func NewAuthority(name string) (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: name, Organization: []string{"ProjConf"}},
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              now.AddDate(authorityYears, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", err
+	}
+	return encode(der, key)
+}
+
+// Issue creates a certificate (and its private key) signed by a ca, for both server and client
+// authentication, and returns them encoded as PEM
+func Issue(authorityCertificate string, authorityKey string, commonName string, sans []string, days int) (string, string, error) {
+	if err := Validate(commonName, sans, days); err != nil {
+		return "", "", err
+	}
+
+	authority, signer, err := parseAuthority(authorityCertificate, authorityKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return "", "", err
+	}
+
+	if commonName == "" {
+		commonName = sans[0]
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              now.AddDate(0, 0, days),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, san := range sans {
+		if ip := net.ParseIP(san); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, strings.ToLower(san))
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, authority, &key.PublicKey, signer)
+	if err != nil {
+		return "", "", err
+	}
+	return encode(der, key)
+}
+
+// Parse decodes the first PEM certificate of a value (nil if it has none)
+func Parse(value string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return certificate
+}
+
+func parseAuthority(certificatePEM string, keyPEM string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certificate := Parse(certificatePEM)
+	if certificate == nil || !certificate.IsCA {
+		return nil, nil, errors.New("invalid ca certificate")
+	}
+
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, nil, errors.New("invalid ca private key")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid ca private key: %v", err)
+	}
+	signer, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, nil, errors.New("invalid ca private key (not an ecdsa key)")
+	}
+	return certificate, signer, nil
+}
+
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+func encode(der []byte, key *ecdsa.PrivateKey) (string, string, error) {
+	private, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: private})),
+		nil
+}
@@ -0,0 +1,84 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package envfile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseDotenv reads KEY=VALUE lines (optionally prefixed with "export"); values
+// may be single-quoted (literal) or double-quoted (with \n, \t, \" and \\ escapes),
+// and quoted values may span lines. "#" starts a comment, except within quotes
+// or when not preceded by whitespace
+func parseDotenv(data []byte) (map[string]string, error) {
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	values := make(map[string]string)
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", i+1)
+		}
+		value = strings.TrimSpace(value)
+
+		if len(value) > 0 && (value[0] == '"' || value[0] == '\'') {
+			// a quoted value ends at the (first unescaped) closing quote, possibly on a later line
+			start, quote := i, value[0]
+			raw := value[1:]
+			end := closingQuote(raw, quote)
+			for end < 0 && i+1 < len(lines) {
+				i++
+				raw += "\n" + lines[i]
+				end = closingQuote(raw, quote)
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated %c", start+1, quote)
+			}
+			if rest := strings.TrimSpace(raw[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+				return nil, fmt.Errorf("line %d: unexpected \"%s\" after the value of %s", i+1, rest, key)
+			}
+			value = raw[:end]
+			if quote == '"' {
+				value = unescape(value)
+			}
+		} else if comment := strings.Index(value, " #"); comment >= 0 {
+			value = strings.TrimSpace(value[:comment])
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// closingQuote returns the index of the first quote in s not escaped by a
+// backslash (only double quotes can be escaped), or -1 if there is none
+func closingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		if quote == '"' && s[i] == '\\' {
+			i++
+		} else if s[i] == quote {
+			return i
+		}
+	}
+	return -1
+}
+
+var escapes = strings.NewReplacer(`\n`, "\n", `\r`, "\r", `\t`, "\t", `\"`, `"`, `\\`, `\`)
+
+func unescape(s string) string {
+	return escapes.Replace(s)
+}
@@ -0,0 +1,87 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+// Package envfile reads and writes variables (keys and values) in the files
+// services are commonly configured with: dotenv, JSON/YAML documents, shell
+// scripts, docker env-files and Kubernetes Secrets and ConfigMaps
+package envfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"path/filepath"
+	"strings"
+)
+
+// Format is a kind of file variables can be read from or written to
+type Format string
+
+const (
+	Auto      Format = "auto" // by the extension (and, for JSON/YAML, the content) of the file
+	Dotenv    Format = "dotenv"
+	JSON      Format = "json"
+	YAML      Format = "yaml"
+	Shell     Format = "shell"         // export KEY='value' lines (write only)
+	Docker    Format = "docker"        // a docker --env-file (write only)
+	Secret    Format = "k8s-secret"    // a Kubernetes Secret manifest (YAML, or JSON when read)
+	ConfigMap Format = "k8s-configmap" // a Kubernetes ConfigMap manifest (YAML, or JSON when read)
+)
+
+// Formats are the formats that can be parsed
+var Formats = []Format{Auto, Dotenv, JSON, YAML, Secret, ConfigMap}
+
+// Parse reads the variables of a file; name is only used to detect its format
+func Parse(name string, data []byte, format Format) (map[string]string, error) {
+	if format == Auto {
+		format = detect(name, data)
+	}
+	switch format {
+	case Dotenv:
+		return parseDotenv(data)
+	case JSON:
+		var doc interface{}
+		if err := unmarshalJSON(data, &doc); err != nil {
+			return nil, fmt.Errorf("invalid json: %v", err)
+		}
+		return flatten(doc)
+	case YAML:
+		var doc interface{}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("invalid yaml: %v", err)
+		}
+		return flatten(doc)
+	case Secret, ConfigMap:
+		return parseManifest(data, format)
+	default:
+		return nil, fmt.Errorf("unsupported format \"%s\"", format)
+	}
+}
+
+// detect guesses the format of a file: JSON and YAML by their extension, and
+// manifests by their kind; anything else is taken to be a dotenv file
+func detect(name string, data []byte) Format {
+	format := Dotenv
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json":
+		format = JSON
+	case ".yaml", ".yml":
+		format = YAML
+	default:
+		return format
+	}
+	if kind, ok := manifestKind(data); ok {
+		return kind
+	}
+	return format
+}
+
+func unmarshalJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,215 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package envfile
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		data   string
+		format Format
+		want   map[string]string
+	}{
+		{
+			name: "dotenv",
+			file: ".env",
+			data: "# a comment\n" +
+				"\n" +
+				"PLAIN=value\n" +
+				"export EXPORTED=yes\n" +
+				"  SPACED = around  \n" +
+				"EMPTY=\n" +
+				"COMMENTED=value # a comment\n" +
+				"HASH=value#not-a-comment\n" +
+				"EQUALS=a=b=c\n",
+			format: Auto,
+			want: map[string]string{
+				"PLAIN":     "value",
+				"EXPORTED":  "yes",
+				"SPACED":    "around",
+				"EMPTY":     "",
+				"COMMENTED": "value",
+				"HASH":      "value#not-a-comment",
+				"EQUALS":    "a=b=c",
+			},
+		},
+		{
+			name: "dotenv quoting",
+			file: ".env",
+			data: `SINGLE='literal \n $HOME "x" # kept'` + "\n" +
+				`DOUBLE="escaped \n \t \" \\ 'x' # kept"` + "\n" +
+				`TRAILING="value" # a comment` + "\n" +
+				`SPACES='  padded  '` + "\n",
+			format: Dotenv,
+			want: map[string]string{
+				"SINGLE":   `literal \n $HOME "x" # kept`,
+				"DOUBLE":   "escaped \n \t \" \\ 'x' # kept",
+				"TRAILING": "value",
+				"SPACES":   "  padded  ",
+			},
+		},
+		{
+			name: "dotenv multi-line values",
+			file: ".env",
+			data: "KEY='-----BEGIN KEY-----\n" +
+				"MIIB # not a comment\n" +
+				"-----END KEY-----'\n" +
+				"QUOTED=\"line one\n" +
+				"line \\\"two\\\"\"\n" +
+				"AFTER=after\n",
+			format: Dotenv,
+			want: map[string]string{
+				"KEY":    "-----BEGIN KEY-----\nMIIB # not a comment\n-----END KEY-----",
+				"QUOTED": "line one\nline \"two\"",
+				"AFTER":  "after",
+			},
+		},
+		{
+			name:   "dotenv with windows line endings",
+			file:   ".env",
+			data:   "ONE=1\r\nTWO='a\r\nb'\r\n",
+			format: Dotenv,
+			want:   map[string]string{"ONE": "1", "TWO": "a\nb"},
+		},
+		{
+			name:   "nested json",
+			file:   "config.json",
+			data:   `{"db": {"host": "db.internal", "port": 5432, "readReplicas": ["a", "b"], "ssl": true, "password": null}, "api-key": "k", "ratio": 0.25}`,
+			format: Auto,
+			want: map[string]string{
+				"DB_HOST":            "db.internal",
+				"DB_PORT":            "5432",
+				"DB_READ_REPLICAS_0": "a",
+				"DB_READ_REPLICAS_1": "b",
+				"DB_SSL":             "true",
+				"DB_PASSWORD":        "",
+				"API_KEY":            "k",
+				"RATIO":              "0.25",
+			},
+		},
+		{
+			name: "nested yaml",
+			file: "config.yml",
+			data: "server:\n" +
+				"  httpPort: 8080\n" +
+				"  hosts:\n" +
+				"    - a.internal\n" +
+				"  motd: |\n" +
+				"    hello\n" +
+				"    world\n" +
+				"2fa: on\n",
+			format: Auto,
+			want: map[string]string{
+				"SERVER_HTTP_PORT": "8080",
+				"SERVER_HOSTS_0":   "a.internal",
+				"SERVER_MOTD":      "hello\nworld\n",
+				"_2FA":             "on",
+			},
+		},
+		{
+			name: "secret",
+			file: "secret.yaml",
+			data: "apiVersion: v1\n" +
+				"kind: Secret\n" +
+				"metadata:\n" +
+				"  name: app\n" +
+				"data:\n" +
+				"  DB_PASSWORD: czNjcmV0\n" +
+				"  tls.key: bXVsdGkKbGluZQ==\n" +
+				"stringData:\n" +
+				"  api-token: plain\n",
+			format: Auto,
+			want:   map[string]string{"DB_PASSWORD": "s3cret", "TLS_KEY": "multi\nline", "API_TOKEN": "plain"},
+		},
+		{
+			name:   "configmap as json",
+			file:   "configmap.json",
+			data:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "app"}, "data": {"log.level": "debug"}}`,
+			format: Auto,
+			want:   map[string]string{"LOG_LEVEL": "debug"},
+		},
+		{
+			name:   "manifests are only detected in json and yaml files",
+			file:   "secret.env",
+			data:   "kind=Secret\n",
+			format: Auto,
+			want:   map[string]string{"kind": "Secret"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.file, []byte(tt.data), tt.format)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("Parse() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		data   string
+		format Format
+		want   string
+	}{
+		{name: "dotenv without =", file: ".env", data: "A=1\nNOT_A_PAIR\n", format: Dotenv, want: "line 2"},
+		{name: "dotenv without a key", file: ".env", data: "=value\n", format: Dotenv, want: "line 1"},
+		{name: "unterminated quote", file: ".env", data: "A='one\ntwo\n", format: Dotenv, want: "unterminated '"},
+		{name: "text after a quoted value", file: ".env", data: `A="one" two` + "\n", format: Dotenv, want: `unexpected "two"`},
+		{name: "json that is not an object", file: "values.json", data: `["a"]`, format: JSON, want: "expected an object"},
+		{name: "invalid json", file: "values.json", data: `{"a": `, format: JSON, want: "invalid json"},
+		{name: "invalid yaml", file: "values.yaml", data: "a: [\n", format: YAML, want: "invalid yaml"},
+		{name: "colliding keys", file: "values.json", data: `{"db": {"host": "a"}, "db_host": "b"}`, format: JSON, want: "are both DB_HOST"},
+		{name: "colliding case", file: "values.yaml", data: "apiKey: a\napi-key: b\n", format: YAML, want: "are both API_KEY"},
+		{name: "key without letters or digits", file: "values.json", data: `{"--": "a"}`, format: JSON, want: "not a valid key"},
+		{name: "secret with invalid base64", file: "secret.yaml", data: "kind: Secret\ndata:\n  KEY: not base64!\n", format: Secret, want: "not valid base64"},
+		{name: "colliding manifest keys", file: "cm.yaml", data: "kind: ConfigMap\ndata:\n  log.level: a\n  log-level: b\n", format: ConfigMap, want: "are both LOG_LEVEL"},
+		{name: "manifest of another kind", file: "cm.yaml", data: "kind: Deployment\n", format: ConfigMap, want: "expected a manifest of kind ConfigMap"},
+		{name: "unsupported format", file: "values", data: "", format: Shell, want: "unsupported format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.file, []byte(tt.data), tt.format)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse() error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := map[string]string{
+		"db.readReplicas": "DB_READ_REPLICAS",
+		"api-key":         "API_KEY",
+		"HTTPRequest":     "HTTP_REQUEST",
+		"already_SNAKE":   "ALREADY_SNAKE",
+		"v2Api":           "V2_API",
+		"2fa":             "_2FA",
+		"  spaced  out ":  "SPACED_OUT",
+		"--":              "",
+	}
+
+	for name, want := range tests {
+		if got := Key(name); got != want {
+			t.Errorf("Key(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
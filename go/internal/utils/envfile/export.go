@@ -0,0 +1,112 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package envfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"regexp"
+	"strings"
+)
+
+// ExportFormats are the formats that can be written
+var ExportFormats = []Format{Dotenv, JSON, YAML, Shell, Docker, Secret, ConfigMap}
+
+// Write writes the variables in a format (sorted by key); name is the name of
+// a Kubernetes manifest, and is ignored by the other formats
+func Write(values map[string]string, format Format, name string) ([]byte, error) {
+	switch format {
+	case Dotenv:
+		return writeLines(values, "", dotenvQuote), nil
+	case Shell:
+		return writeLines(values, "export ", shellQuote), nil
+	case Docker:
+		// docker reads values verbatim (no quoting), so they cannot span lines
+		for _, key := range sortedKeys(values) {
+			if strings.ContainsAny(values[key], "\r\n") {
+				return nil, fmt.Errorf("the value of %s spans multiple lines, which a docker env-file cannot hold", key)
+			}
+		}
+		return writeLines(values, "", func(value string) string { return value }), nil
+	case JSON:
+		data, err := json.MarshalIndent(values, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	case YAML:
+		doc := yaml.Node{Kind: yaml.MappingNode}
+		for _, key := range sortedKeys(values) {
+			doc.Content = append(doc.Content, scalarNode(key), scalarNode(values[key]))
+		}
+		return marshalYAML(&doc)
+	case Secret, ConfigMap:
+		return writeManifest(values, format, name)
+	default:
+		return nil, fmt.Errorf("unsupported format \"%s\"", format)
+	}
+}
+
+func writeLines(values map[string]string, prefix string, quote func(string) string) []byte {
+	var b bytes.Buffer
+	for _, key := range sortedKeys(values) {
+		fmt.Fprintf(&b, "%s%s=%s\n", prefix, key, quote(values[key]))
+	}
+	return b.Bytes()
+}
+
+// unquoted are values that need no quoting in dotenv files or shells
+var unquoted = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,-]*$`)
+
+// dotenvQuote single-quotes values (which dotenv parsers take literally) unless
+// they need escaping: those spanning lines, or containing a single quote, are
+// double-quoted with \n, \r, \t, \" and \\ escapes
+func dotenvQuote(value string) string {
+	if unquoted.MatchString(value) {
+		return value
+	} else if !strings.ContainsAny(value, "'\r\n") {
+		return "'" + value + "'"
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(value) + `"`
+}
+
+// shellQuote single-quotes values for POSIX shells (closing, escaping and reopening
+// the quotes around any single quote within)
+func shellQuote(value string) string {
+	if unquoted.MatchString(value) && value != "" {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// marshalYAML writes a document indented by two spaces (as is usual for manifests)
+func marshalYAML(doc *yaml.Node) ([]byte, error) {
+	var b bytes.Buffer
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, err
+	} else if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+var nameInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Name converts a display name to a valid Kubernetes object name (lowercase
+// alphanumerics and dashes, at most 63 characters), or "" if it has no alphanumerics
+func Name(display string) string {
+	name := strings.Trim(nameInvalid.ReplaceAllString(strings.ToLower(display), "-"), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
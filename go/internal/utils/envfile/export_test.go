@@ -0,0 +1,186 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package envfile
+
+import (
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// awkward are values that need quoting (or escaping) to survive a round trip
+var awkward = map[string]string{
+	"EMPTY":        "",
+	"PLAIN":        "postgres://app@db.internal:5432/app",
+	"SPACES":       "  leading and trailing  ",
+	"SINGLE_QUOTE": "it's",
+	"DOUBLE_QUOTE": `say "hi"`,
+	"BOTH_QUOTES":  `it's "quoted"`,
+	"DOLLAR":       "$HOME ${USER} $$ $(whoami) `id`",
+	"DOLLAR_QUOTE": "'$HOME'",
+	"BACKSLASH":    `C:\path\to\n not a newline \\`,
+	"HASH":         "value # not a comment",
+	"MULTI_LINE":   "-----BEGIN KEY-----\nMIIB\n-----END KEY-----\n",
+	"MULTI_QUOTE":  "line 'one'\nline \"two\"\n",
+	"CRLF":         "one\r\ntwo",
+	"TAB":          "a\tb",
+	"UNICODE":      "héllo wörld ✓",
+}
+
+func TestDotenvRoundTrip(t *testing.T) {
+	data, err := Write(awkward, Dotenv, "")
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	got, err := Parse(".env", data, Dotenv)
+	if err != nil {
+		t.Fatalf("Parse() error = %v\n%s", err, data)
+	}
+	if !maps.Equal(got, awkward) {
+		for key, want := range awkward {
+			if got[key] != want {
+				t.Errorf("%s = %q, want %q", key, got[key], want)
+			}
+		}
+		t.Logf("written:\n%s", data)
+	}
+}
+
+func TestDotenvQuote(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "", want: ""},
+		{value: "abc-123_./:@%+,", want: "abc-123_./:@%+,"},
+		{value: "has space", want: "'has space'"},
+		{value: "$HOME", want: "'$HOME'"},
+		{value: `say "hi"`, want: `'say "hi"'`},
+		{value: "it's", want: `"it's"`},
+		{value: "a\nb", want: `"a\nb"`},
+		{value: "it's \\ \"x\"\n", want: `"it's \\ \"x\"\n"`},
+	}
+
+	for _, tt := range tests {
+		if got := dotenvQuote(tt.value); got != tt.want {
+			t.Errorf("dotenvQuote(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestShellRoundTrip(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no sh to source the script with")
+	}
+
+	data, err := Write(awkward, Shell, "")
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	script := filepath.Join(t.TempDir(), "values.sh")
+	if err := os.WriteFile(script, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for key, want := range awkward {
+		// printf, unlike echo, writes the value as it is
+		out, err := exec.Command(sh, "-c", `. "$1" && eval "printf '%s' \"\$$2\""`, "sh", script, key).Output()
+		if err != nil {
+			t.Fatalf("sourcing the script: %v\n%s", err, data)
+		}
+		if got := string(out); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "", want: "''"},
+		{value: "plain", want: "plain"},
+		{value: "$HOME `id`", want: "'$HOME `id`'"},
+		{value: "it's", want: `'it'\''s'`},
+		{value: "a\nb", want: "'a\nb'"},
+	}
+
+	for _, tt := range tests {
+		if got := shellQuote(tt.value); got != tt.want {
+			t.Errorf("shellQuote(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestWriteManifest(t *testing.T) {
+	values := map[string]string{"PORT": "8080", "DEBUG": "true", "MOTD": "hello\nworld"}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{
+			format: ConfigMap,
+			want: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-production
+data:
+  DEBUG: "true"
+  MOTD: |-
+    hello
+    world
+  PORT: "8080"
+`,
+		},
+		{
+			format: Secret,
+			want: `apiVersion: v1
+kind: Secret
+metadata:
+  name: app-production
+type: Opaque
+data:
+  DEBUG: dHJ1ZQ==
+  MOTD: aGVsbG8Kd29ybGQ=
+  PORT: ODA4MA==
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			data, err := Write(values, tt.format, "app-production")
+			if err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Write() =\n%s\nwant\n%s", data, tt.want)
+			}
+
+			// and reads back as it was written
+			got, err := Parse("manifest.yaml", data, Auto)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !maps.Equal(got, values) {
+				t.Errorf("Parse() = %v, want %v", got, values)
+			}
+		})
+	}
+}
+
+func TestWriteDockerRefusesMultiLine(t *testing.T) {
+	if _, err := Write(map[string]string{"KEY": "a\nb"}, Docker, ""); err == nil {
+		t.Error("Write() error = nil, want an error for a multi-line value")
+	}
+}
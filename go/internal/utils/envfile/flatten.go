@@ -0,0 +1,123 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package envfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// flatten turns a (nested) document into variables: the path to each scalar,
+// joined with underscores and converted to SCREAMING_SNAKE case, is its key
+// (e.g. {"db": {"readReplicas": ["a"]}} is DB_READ_REPLICAS_0=a)
+func flatten(doc interface{}) (map[string]string, error) {
+	if _, ok := asMap(doc); !ok {
+		return nil, fmt.Errorf("expected an object (of keys to values) at the top level")
+	}
+	values := make(map[string]string)
+	paths := make(map[string]string)
+	if err := flattenInto(values, paths, nil, doc); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func flattenInto(values map[string]string, paths map[string]string, path []string, node interface{}) error {
+	if m, ok := asMap(node); ok {
+		for k, v := range m {
+			if err := flattenInto(values, paths, append(path[:len(path):len(path)], k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	} else if list, ok := node.([]interface{}); ok {
+		for i, v := range list {
+			if err := flattenInto(values, paths, append(path[:len(path):len(path)], strconv.Itoa(i)), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	key := Key(strings.Join(path, "_"))
+	if key == "" {
+		return fmt.Errorf("\"%s\" is not a valid key", strings.Join(path, "."))
+	} else if other, ok := paths[key]; ok {
+		return fmt.Errorf("\"%s\" and \"%s\" are both %s", other, strings.Join(path, "."), key)
+	}
+	paths[key] = strings.Join(path, ".")
+	values[key] = scalar(node)
+	return nil
+}
+
+// asMap returns the keys of a JSON object or YAML mapping (as strings)
+func asMap(node interface{}) (map[string]interface{}, bool) {
+	switch m := node.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			converted[fmt.Sprint(k)] = v
+		}
+		return converted, true
+	default:
+		return nil, false
+	}
+}
+
+// scalar is the value of a scalar as it would be written in the file
+func scalar(node interface{}) string {
+	switch v := node.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Key converts a name (e.g. "db.readReplicas" or "api-key") to SCREAMING_SNAKE
+// case (DB_READ_REPLICAS, API_KEY); names starting with a digit are prefixed with
+// an underscore, and names without any letters or digits convert to ""
+func Key(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	underscore := false // whether an underscore is pending
+	for i, r := range runes {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			underscore = b.Len() > 0
+			continue
+		}
+		// a word boundary within camelCase (readReplicas, or the "R" of HTTPRequest)
+		if i > 0 && unicode.IsUpper(r) && b.Len() > 0 {
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				underscore = true
+			}
+		}
+		if underscore {
+			b.WriteRune('_')
+			underscore = false
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	key := b.String()
+	if key != "" && unicode.IsDigit(rune(key[0])) {
+		key = "_" + key
+	}
+	return key
+}
@@ -0,0 +1,127 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package envfile
+
+import (
+	"encoding/base64"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"sort"
+)
+
+// kinds are the kinds of Kubernetes manifests holding variables, by format
+var kinds = map[Format]string{
+	Secret:    "Secret",
+	ConfigMap: "ConfigMap",
+}
+
+// manifest is a Kubernetes Secret or ConfigMap (only what holds its values)
+type manifest struct {
+	Kind       string            `yaml:"kind"`
+	Data       map[string]string `yaml:"data"`       // base64-encoded in a Secret
+	StringData map[string]string `yaml:"stringData"` // plain (Secrets only; takes precedence over data)
+}
+
+// manifestKind returns the format of a YAML (or JSON) document that is a
+// Kubernetes Secret or ConfigMap
+func manifestKind(data []byte) (Format, bool) {
+	var m manifest
+	if yaml.Unmarshal(data, &m) != nil {
+		return "", false
+	}
+	for format, kind := range kinds {
+		if m.Kind == kind {
+			return format, true
+		}
+	}
+	return "", false
+}
+
+// parseManifest reads the values of a Kubernetes Secret or ConfigMap (YAML being
+// a superset of JSON, either works); its keys are converted as in flatten
+func parseManifest(data []byte, format Format) (map[string]string, error) {
+	var m manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %v", err)
+	} else if m.Kind != kinds[format] {
+		return nil, fmt.Errorf("expected a manifest of kind %s, not \"%s\"", kinds[format], m.Kind)
+	}
+
+	values := make(map[string]string, len(m.Data)+len(m.StringData))
+	names := make(map[string]string, len(m.Data)+len(m.StringData))
+	add := func(name string, value string) error {
+		key := Key(name)
+		if key == "" {
+			return fmt.Errorf("\"%s\" is not a valid key", name)
+		} else if other, ok := names[key]; ok && other != name {
+			return fmt.Errorf("\"%s\" and \"%s\" are both %s", other, name, key)
+		}
+		names[key] = name
+		values[key] = value
+		return nil
+	}
+
+	for name, value := range m.Data {
+		if format == Secret {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("the data of \"%s\" is not valid base64: %v", name, err)
+			}
+			value = string(decoded)
+		}
+		if err := add(name, value); err != nil {
+			return nil, err
+		}
+	}
+	for name, value := range m.StringData {
+		if err := add(name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// writeManifest writes the values as a Kubernetes Secret (base64-encoded) or
+// ConfigMap named name
+func writeManifest(values map[string]string, format Format, name string) ([]byte, error) {
+	data := yaml.Node{Kind: yaml.MappingNode}
+	for _, key := range sortedKeys(values) {
+		value := values[key]
+		if format == Secret {
+			value = base64.StdEncoding.EncodeToString([]byte(value))
+		}
+		data.Content = append(data.Content, scalarNode(key), scalarNode(value))
+	}
+
+	doc := yaml.Node{Kind: yaml.MappingNode}
+	doc.Content = append(doc.Content,
+		scalarNode("apiVersion"), scalarNode("v1"),
+		scalarNode("kind"), scalarNode(kinds[format]),
+		scalarNode("metadata"), &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{scalarNode("name"), scalarNode(name)}},
+	)
+	if format == Secret {
+		doc.Content = append(doc.Content, scalarNode("type"), scalarNode("Opaque"))
+	}
+	doc.Content = append(doc.Content, scalarNode("data"), &data)
+
+	return marshalYAML(&doc)
+}
+
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
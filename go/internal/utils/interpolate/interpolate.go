@@ -0,0 +1,173 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package interpolate
+
+import (
+	"errors"
+	"fmt"
+	"github.com/train360-corp/projconf/go/internal/utils/validators"
+	"slices"
+	"strings"
+)
+
+// Error is a value whose references cannot be resolved
+type Error struct {
+	Key    string
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("\"%s\" %s", e.Key, e.Reason)
+}
+
+// Errors are the errors of every key that could not be resolved, sorted by key
+type Errors []*Error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+type resolver struct {
+	templates map[string]string
+	resolved  map[string]string
+	visiting  []string
+}
+
+// Resolve replaces every ${KEY} in the templates with the (resolved) value of KEY, a template or a
+// literal; "$${" is a literal "${". Literals (generated values, which may well contain a "${" of
+// their own) are taken as they are, and returned along with the resolved templates
+func Resolve(templates map[string]string, literals map[string]string) (map[string]string, error) {
+	r := newResolver(templates, literals)
+
+	// sorted, so the same values always produce the same error
+	keys := make([]string, 0, len(templates))
+	for key := range templates {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if _, err := r.resolve(key); err != nil {
+			return nil, err
+		}
+	}
+	return r.resolved, nil
+}
+
+// ResolveKeys is Resolve for some of the keys only (and the values they reference), so a value
+// that cannot be resolved fails the keys that need it and no others. Every one of those gets an
+// error of its own; the values that could be resolved are returned either way
+func ResolveKeys(templates map[string]string, literals map[string]string, keys []string) (map[string]string, Errors) {
+	r := newResolver(templates, literals)
+
+	keys = slices.Compact(slices.Sorted(slices.Values(keys)))
+	var errs Errors
+	for _, key := range keys {
+		if _, err := r.resolve(key); err != nil {
+			var e *Error
+			if !errors.As(err, &e) || e.Key != key {
+				e = &Error{Key: key, Reason: fmt.Sprintf("cannot be resolved: %v", err)}
+			}
+			errs = append(errs, e)
+		}
+	}
+	return r.resolved, errs
+}
+
+func newResolver(templates map[string]string, literals map[string]string) *resolver {
+	r := &resolver{templates: templates, resolved: make(map[string]string, len(templates)+len(literals))}
+	for key, value := range literals {
+		if _, ok := templates[key]; !ok {
+			r.resolved[key] = value
+		}
+	}
+	return r
+}
+
+func (r *resolver) resolve(key string) (string, error) {
+	if value, ok := r.resolved[key]; ok {
+		return value, nil
+	}
+
+	if i := slices.Index(r.visiting, key); i != -1 {
+		cycle := append(slices.Clone(r.visiting[i:]), key)
+		return "", &Error{Key: key, Reason: fmt.Sprintf("has a circular reference (%s)", strings.Join(cycle, " -> "))}
+	}
+	r.visiting = append(r.visiting, key)
+	defer func() { r.visiting = r.visiting[:len(r.visiting)-1] }()
+
+	value := r.templates[key]
+	var b strings.Builder
+	for i := 0; i < len(value); {
+		switch {
+		case strings.HasPrefix(value[i:], "$${"):
+			b.WriteString("${")
+			i += 3
+		case strings.HasPrefix(value[i:], "${"):
+			end := strings.IndexByte(value[i+2:], '}')
+			if end == -1 {
+				return "", &Error{Key: key, Reason: "has an unterminated reference (a \"${\" without a \"}\"; use \"$${\" for a literal \"${\")"}
+			}
+			ref := value[i+2 : i+2+end]
+			if !validators.IsValidVariable(ref) {
+				return "", &Error{Key: key, Reason: fmt.Sprintf("has an invalid reference \"${%s}\" (use \"$${\" for a literal \"${\")", ref)}
+			} else if !r.exists(ref) {
+				return "", &Error{Key: key, Reason: fmt.Sprintf("references \"%s\", which is not a variable of this environment", ref)}
+			}
+			resolved, err := r.resolve(ref)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(resolved)
+			i += end + 3
+		default:
+			b.WriteByte(value[i])
+			i++
+		}
+	}
+
+	r.resolved[key] = b.String()
+	return r.resolved[key], nil
+}
+
+// exists reports whether a key is a template or a literal
+func (r *resolver) exists(key string) bool {
+	if _, ok := r.templates[key]; ok {
+		return true
+	}
+	_, ok := r.resolved[key]
+	return ok
+}
+
+// References returns the keys a template references directly, each once and in order of first
+// reference ("$${" is not a reference). References Resolve would reject are left out
+func References(template string) []string {
+	refs := make([]string, 0)
+	for i := 0; i < len(template); {
+		switch {
+		case strings.HasPrefix(template[i:], "$${"):
+			i += 3
+		case strings.HasPrefix(template[i:], "${"):
+			end := strings.IndexByte(template[i+2:], '}')
+			if end == -1 {
+				return refs
+			}
+			if ref := template[i+2 : i+2+end]; validators.IsValidVariable(ref) && !slices.Contains(refs, ref) {
+				refs = append(refs, ref)
+			}
+			i += end + 3
+		default:
+			i++
+		}
+	}
+	return refs
+}
@@ -0,0 +1,170 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package keypair
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+)
+
+const (
+	Ed25519 = "ed25519"
+	RSA     = "rsa"
+	ECDSA   = "ecdsa"
+
+	PEM = "pem"
+	JWK = "jwk"
+)
+
+// DefaultBits is the size used for an algorithm when none is given (ed25519 has a fixed size)
+var DefaultBits = map[string]int{
+	RSA:   2048,
+	ECDSA: 256,
+}
+
+var curves = map[int]elliptic.Curve{
+	256: elliptic.P256(),
+	384: elliptic.P384(),
+	521: elliptic.P521(),
+}
+
+// Validate checks that a keypair can be generated with the given algorithm, size and format
+func Validate(alg string, bits int, format string) error {
+	switch alg {
+	case Ed25519:
+		if bits != 0 {
+			return fmt.Errorf("ed25519 keys have a fixed size")
+		}
+	case RSA:
+		if bits != 2048 && bits != 3072 && bits != 4096 {
+			return fmt.Errorf("%d is not a valid rsa key size (2048, 3072 or 4096)", bits)
+		}
+	case ECDSA:
+		if _, ok := curves[bits]; !ok {
+			return fmt.Errorf("%d is not a valid ecdsa curve size (256, 384 or 521)", bits)
+		}
+	default:
+		return fmt.Errorf("\"%s\" is not a valid algorithm (ed25519, rsa or ecdsa)", alg)
+	}
+	if format != PEM && format != JWK {
+		return fmt.Errorf("\"%s\" is not a valid key format (pem or jwk)", format)
+	}
+	return nil
+}
+
+// Generate creates a keypair and returns its private and public key, encoded as PEM (PKCS #8 and
+// PKIX) or as JWK; bits is the modulus size of an rsa key or the curve size of an ecdsa key
+func Generate(alg string, bits int, format string) (string, string, error) {
+	if err := Validate(alg, bits, format); err != nil {
+		return "", "", err
+	}
+
+	var private crypto.Signer
+	var err error
+	switch alg {
+	case Ed25519:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	case RSA:
+		private, err = rsa.GenerateKey(rand.Reader, bits)
+	case ECDSA:
+		private, err = ecdsa.GenerateKey(curves[bits], rand.Reader)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	if format == JWK {
+		return toJWK(private)
+	}
+	return toPEM(private)
+}
+
+func toPEM(private crypto.Signer) (string, string, error) {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return "", "", err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(private.Public())
+	if err != nil {
+		return "", "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		nil
+}
+
+// toJWK encodes a key as a JSON Web Key (RFC 7517); the public key is the private key without its private members
+func toJWK(private crypto.Signer) (string, string, error) {
+	b64 := base64.RawURLEncoding.EncodeToString
+	var public, secret map[string]string
+
+	switch key := private.(type) {
+	case ed25519.PrivateKey:
+		public = map[string]string{"kty": "OKP", "crv": "Ed25519", "alg": "EdDSA", "x": b64(key.Public().(ed25519.PublicKey))}
+		secret = map[string]string{"d": b64(key.Seed())}
+	case *rsa.PrivateKey:
+		key.Precompute()
+		public = map[string]string{
+			"kty": "RSA",
+			"alg": "RS256",
+			"n":   b64(key.N.Bytes()),
+			"e":   b64(big.NewInt(int64(key.E)).Bytes()),
+		}
+		secret = map[string]string{
+			"d":  b64(key.D.Bytes()),
+			"p":  b64(key.Primes[0].Bytes()),
+			"q":  b64(key.Primes[1].Bytes()),
+			"dp": b64(key.Precomputed.Dp.Bytes()),
+			"dq": b64(key.Precomputed.Dq.Bytes()),
+			"qi": b64(key.Precomputed.Qinv.Bytes()),
+		}
+	case *ecdsa.PrivateKey:
+		ecdh, err := key.ECDH()
+		if err != nil {
+			return "", "", err
+		}
+		// an uncompressed point: 0x04 || x || y (each the size of the curve)
+		point := ecdh.PublicKey().Bytes()
+		size := (len(point) - 1) / 2
+		bits := key.Curve.Params().BitSize
+		public = map[string]string{
+			"kty": "EC",
+			"crv": key.Curve.Params().Name,
+			"alg": fmt.Sprintf("ES%d", map[int]int{256: 256, 384: 384, 521: 512}[bits]),
+			"x":   b64(point[1 : 1+size]),
+			"y":   b64(point[1+size:]),
+		}
+		secret = map[string]string{"d": b64(ecdh.Bytes())}
+	default:
+		return "", "", fmt.Errorf("unhandled key type (%T)", private)
+	}
+
+	public["use"] = "sig"
+	publicJSON, err := json.Marshal(public)
+	if err != nil {
+		return "", "", err
+	}
+	for k, v := range secret {
+		public[k] = v
+	}
+	privateJSON, err := json.Marshal(public)
+	if err != nil {
+		return "", "", err
+	}
+	return string(privateJSON), string(publicJSON), nil
+}
@@ -16,15 +16,24 @@ package random
 
 import "crypto/rand"
 
-// String returns a secure random string of length n.
+// String returns a secure random string of length n. Random bytes are rejected when they are at
+// or above the largest multiple of the alphabet's size (248 for 62 characters), as mapping those
+// with a modulo would favor the first characters of the alphabet.
 func String(n int) string {
 	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	const limit = 256 - 256%len(chars)
+
+	result := make([]byte, 0, n)
 	bytes := make([]byte, n)
-	if _, err := rand.Read(bytes); err != nil {
-		panic(err)
-	}
-	for i, b := range bytes {
-		bytes[i] = chars[b%byte(len(chars))]
+	for len(result) < n {
+		if _, err := rand.Read(bytes); err != nil {
+			panic(err)
+		}
+		for _, b := range bytes {
+			if int(b) < limit && len(result) < n {
+				result = append(result, chars[int(b)%len(chars)])
+			}
+		}
 	}
-	return string(bytes)
+	return string(result)
 }
@@ -0,0 +1,52 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package random
+
+import (
+	"strings"
+	"testing"
+)
+
+const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+func TestStringLength(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 62, 248, 1000} {
+		got := String(n)
+		if len(got) != n {
+			t.Errorf("String(%d) has length %d", n, len(got))
+		}
+		if i := strings.IndexFunc(got, func(r rune) bool { return !strings.ContainsRune(alphabet, r) }); i >= 0 {
+			t.Errorf("String(%d) contains %q, outside the alphabet", n, got[i])
+		}
+	}
+}
+
+// TestStringUniformity is a chi-squared goodness of fit against a uniform distribution over the
+// 62 characters (61 degrees of freedom), compared to its critical value at p = 0.001: a correct
+// generator fails one in a thousand runs, while the modulo bias rejection sampling avoids (the
+// first 8 characters drawn 5/4 as often as the others) fails every run.
+func TestStringUniformity(t *testing.T) {
+	const draws = 1000 * len(alphabet)
+	const critical = 100.888
+
+	counts := make(map[rune]int, len(alphabet))
+	for _, r := range String(draws) {
+		counts[r]++
+	}
+
+	expected := float64(draws) / float64(len(alphabet))
+	statistic := 0.0
+	for _, r := range alphabet {
+		diff := float64(counts[r]) - expected
+		statistic += diff * diff / expected
+	}
+
+	if statistic >= critical {
+		t.Errorf("chi-squared statistic %.3f is not below %.3f (counts: %v)", statistic, critical, counts)
+	}
+}
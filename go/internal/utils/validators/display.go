@@ -8,12 +8,14 @@
 package validators
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 )
 
 var displayRegex = regexp.MustCompile(`^[[:alnum:] _]+$`)
 var variableRegex = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+var tagRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_.:=/-]{0,62}$`)
 
 func IsValidDisplay(s string) bool {
 	return len(strings.Trim(s, " ")) > 0 && displayRegex.MatchString(s)
@@ -22,3 +24,20 @@ func IsValidDisplay(s string) bool {
 func IsValidVariable(s string) bool {
 	return len(strings.Trim(s, " ")) > 0 && variableRegex.MatchString(s)
 }
+
+// IsValidTag reports whether s is a tag of a variable (a short, lowercase label like "service:api")
+func IsValidTag(s string) bool {
+	return tagRegex.MatchString(s)
+}
+
+// NormalizeTags lowercases tags, failing on the first one that is not a valid tag
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, len(tags))
+	for i, tag := range tags {
+		normalized[i] = strings.ToLower(strings.TrimSpace(tag))
+		if !IsValidTag(normalized[i]) {
+			return nil, fmt.Errorf("\"%v\" is not a valid tag (lowercase letters, numbers and _.:=/-, at most 63 characters)", tag)
+		}
+	}
+	return normalized, nil
+}
@@ -13,16 +13,122 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AuditEventObjectActorType.
+const (
+	AuditEventObjectActorTypeAdmin  AuditEventObjectActorType = "admin"
+	AuditEventObjectActorTypeClient AuditEventObjectActorType = "client"
+	AuditEventObjectActorTypeSystem AuditEventObjectActorType = "system"
+)
+
+// Defines values for ExportFormat.
+const (
+	Docker       ExportFormat = "docker"
+	Dotenv       ExportFormat = "dotenv"
+	Json         ExportFormat = "json"
+	K8sConfigmap ExportFormat = "k8s-configmap"
+	K8sSecret    ExportFormat = "k8s-secret"
+	Shell        ExportFormat = "shell"
+	Yaml         ExportFormat = "yaml"
+)
+
 // Defines values for GeneratorType.
 const (
-	GeneratorTypeRANDOM GeneratorType = "RANDOM"
-	GeneratorTypeSTATIC GeneratorType = "STATIC"
+	GeneratorTypeBASE64      GeneratorType = "BASE64"
+	GeneratorTypeCERTIFICATE GeneratorType = "CERTIFICATE"
+	GeneratorTypeHEX         GeneratorType = "HEX"
+	GeneratorTypeKEYPAIR     GeneratorType = "KEYPAIR"
+	GeneratorTypeRANDOM      GeneratorType = "RANDOM"
+	GeneratorTypeSTATIC      GeneratorType = "STATIC"
+	GeneratorTypeUUID        GeneratorType = "UUID"
+)
+
+// Defines values for GetClientsV1ParamsSort.
+const (
+	GetClientsV1ParamsSortDisplay        GetClientsV1ParamsSort = "display"
+	GetClientsV1ParamsSortMinusDisplay   GetClientsV1ParamsSort = "-display"
+	GetClientsV1ParamsSortCreatedAt      GetClientsV1ParamsSort = "created_at"
+	GetClientsV1ParamsSortMinusCreatedAt GetClientsV1ParamsSort = "-created_at"
+)
+
+// Defines values for GetEnvironmentsV1ParamsSort.
+const (
+	GetEnvironmentsV1ParamsSortDisplay        GetEnvironmentsV1ParamsSort = "display"
+	GetEnvironmentsV1ParamsSortMinusDisplay   GetEnvironmentsV1ParamsSort = "-display"
+	GetEnvironmentsV1ParamsSortCreatedAt      GetEnvironmentsV1ParamsSort = "created_at"
+	GetEnvironmentsV1ParamsSortMinusCreatedAt GetEnvironmentsV1ParamsSort = "-created_at"
+)
+
+// Defines values for GetProjectsV1ParamsSort.
+const (
+	GetProjectsV1ParamsSortDisplay      GetProjectsV1ParamsSort = "display"
+	GetProjectsV1ParamsSortMinusDisplay GetProjectsV1ParamsSort = "-display"
+)
+
+// Defines values for GetVariablesV1ParamsSort.
+const (
+	GetVariablesV1ParamsSortKey      GetVariablesV1ParamsSort = "key"
+	GetVariablesV1ParamsSortMinusKey GetVariablesV1ParamsSort = "-key"
+)
+
+// Defines values for ImportConflictPolicy.
+const (
+	ImportConflictPolicyFail      ImportConflictPolicy = "fail"
+	ImportConflictPolicyOverwrite ImportConflictPolicy = "overwrite"
+	ImportConflictPolicySkip      ImportConflictPolicy = "skip"
+)
+
+// Defines values for ImportResultObjectAction.
+const (
+	ImportResultObjectActionCreate    ImportResultObjectAction = "create"
+	ImportResultObjectActionOverwrite ImportResultObjectAction = "overwrite"
+	ImportResultObjectActionSkip      ImportResultObjectAction = "skip"
+)
+
+// Defines values for KeypairGeneratorDataAlg.
+const (
+	Ecdsa   KeypairGeneratorDataAlg = "ecdsa"
+	Ed25519 KeypairGeneratorDataAlg = "ed25519"
+	Rsa     KeypairGeneratorDataAlg = "rsa"
+)
+
+// Defines values for KeypairGeneratorDataFormat.
+const (
+	Jwk KeypairGeneratorDataFormat = "jwk"
+	Pem KeypairGeneratorDataFormat = "pem"
+)
+
+// Defines values for SecretEventObjectAction.
+const (
+	Delete SecretEventObjectAction = "delete"
+	Insert SecretEventObjectAction = "insert"
+	Update SecretEventObjectAction = "update"
+)
+
+// Defines values for SecretGeneratorBase64Type.
+const (
+	SecretGeneratorBase64TypeBASE64 SecretGeneratorBase64Type = "BASE64"
+)
+
+// Defines values for SecretGeneratorCertificateType.
+const (
+	SecretGeneratorCertificateTypeCERTIFICATE SecretGeneratorCertificateType = "CERTIFICATE"
+)
+
+// Defines values for SecretGeneratorHexType.
+const (
+	SecretGeneratorHexTypeHEX SecretGeneratorHexType = "HEX"
+)
+
+// Defines values for SecretGeneratorKeypairType.
+const (
+	SecretGeneratorKeypairTypeKEYPAIR SecretGeneratorKeypairType = "KEYPAIR"
 )
 
 // Defines values for SecretGeneratorRandomType.
@@ -35,6 +141,94 @@ const (
 	STATIC SecretGeneratorStaticType = "STATIC"
 )
 
+// Defines values for SecretGeneratorUuidType.
+const (
+	SecretGeneratorUuidTypeUUID SecretGeneratorUuidType = "UUID"
+)
+
+// Defines values for UuidGeneratorDataVersion.
+const (
+	N4 UuidGeneratorDataVersion = 4
+	N7 UuidGeneratorDataVersion = 7
+)
+
+// Defines values for ValueType.
+const (
+	BOOL   ValueType = "BOOL"
+	ENUM   ValueType = "ENUM"
+	INT    ValueType = "INT"
+	JSON   ValueType = "JSON"
+	REGEX  ValueType = "REGEX"
+	STRING ValueType = "STRING"
+	URL    ValueType = "URL"
+)
+
+// AdminKeyObject A named admin API key; the key itself is never returned.
+type AdminKeyObject struct {
+	CreatedAt string `json:"created_at"`
+	Display   string `json:"display"`
+	Id        ID     `json:"id"`
+
+	// RevokedAt when the key was revoked (if it was)
+	RevokedAt *string `json:"revoked_at,omitempty"`
+}
+
+// AdminKeys defines model for AdminKeys.
+type AdminKeys = []AdminKeyObject
+
+// AuditEventObject An (immutable) entry of the audit log.
+type AuditEventObject struct {
+	// Action `insert`, `update` or `delete` for changes, `http.<method>` for requests
+	Action string `json:"action"`
+
+	// Actor the admin key name (or `bootstrap`), the client id, or the database role
+	Actor         string                    `json:"actor"`
+	ActorType     AuditEventObjectActorType `json:"actor_type"`
+	CreatedAt     string                    `json:"created_at"`
+	Details       *map[string]interface{}   `json:"details,omitempty"`
+	EnvironmentId *openapi_types.UUID       `json:"environment_id,omitempty"`
+	Id            int64                     `json:"id"`
+
+	// Resource `<table>/<id>` for changes, the path for requests
+	Resource string  `json:"resource"`
+	SourceIp *string `json:"source_ip,omitempty"`
+}
+
+// AuditEventObjectActorType defines model for AuditEventObject.ActorType.
+type AuditEventObjectActorType string
+
+// AuditEvents defines model for AuditEvents.
+type AuditEvents = []AuditEventObject
+
+// Base64GeneratorData defines model for Base64GeneratorData.
+type Base64GeneratorData struct {
+	// Bytes the number of random bytes
+	Bytes int `json:"bytes"`
+
+	// Url use the unpadded, URL-safe alphabet (base64url) instead of standard base64
+	Url bool `json:"url"`
+}
+
+// CertificateGeneratorData a tls certificate, issued per environment by a ca of the project (created with its first
+// certificate); its private key and the ca bundle are kept in two more, linked variables
+// (created with it, and deleted with it)
+type CertificateGeneratorData struct {
+	// CaBundle the key of the variable holding the ca certificate (e.g. `TLS_CA`)
+	CaBundle string `json:"ca_bundle"`
+
+	// CommonName the common name of the certificate (default the first subject alternative name)
+	CommonName *string `json:"common_name,omitempty"`
+
+	// Days how long the certificate is valid for, in days (default 90)
+	Days *int `json:"days,omitempty"`
+
+	// PrivateKey the key of the variable holding the private key (e.g. `TLS_KEY`)
+	PrivateKey string `json:"private_key"`
+
+	// Sans the subject alternative names of the certificate (dns names or ip addresses)
+	Sans []string `json:"sans"`
+}
+
 // ClientObject defines model for ClientObject.
 type ClientObject struct {
 	CreatedAt     string             `json:"created_at"`
@@ -43,10 +237,39 @@ type ClientObject struct {
 	Id            ID                 `json:"id"`
 }
 
+// ClientSecretObject A secret (credential) of a client; the key itself is never returned.
+type ClientSecretObject struct {
+	ClientId  openapi_types.UUID `json:"client_id"`
+	CreatedAt string             `json:"created_at"`
+
+	// ExpiresAt when the secret stops being accepted (never, if not set)
+	ExpiresAt *string `json:"expires_at,omitempty"`
+	Id        ID      `json:"id"`
+
+	// RevokedAt when the secret was revoked (if it was)
+	RevokedAt *string `json:"revoked_at,omitempty"`
+}
+
+// ClientSecrets defines model for ClientSecrets.
+type ClientSecrets = []ClientSecretObject
+
+// ClientSelfObject The currently-authenticated client, with its environment and project.
+type ClientSelfObject struct {
+	CreatedAt   string            `json:"created_at"`
+	Display     string            `json:"display"`
+	Environment EnvironmentObject `json:"environment"`
+	Id          ID                `json:"id"`
+	Project     ProjectObject     `json:"project"`
+}
+
 // EnvironmentObject defines model for EnvironmentObject.
 type EnvironmentObject struct {
 	Display string `json:"display"`
 	Id      ID     `json:"id"`
+
+	// ParentId the environment that unset secrets are inherited from (if any)
+	ParentId  *ID `json:"parent_id,omitempty"`
+	ProjectId ID  `json:"project_id"`
 }
 
 // Environments defines model for Environments.
@@ -58,12 +281,70 @@ type Error struct {
 	Error       string `json:"error"`
 }
 
+// ExportFormat a format to export values in: a dotenv file (quoted as needed, multi-line values double-quoted with `\n` escapes),
+// JSON, YAML, `export K=V` shell syntax, a docker `--env-file` (which cannot hold multi-line values), or a Kubernetes
+// `Secret` or `ConfigMap` manifest
+type ExportFormat string
+
 // GeneratorType defines model for GeneratorType.
 type GeneratorType string
 
+// HexGeneratorData defines model for HexGeneratorData.
+type HexGeneratorData struct {
+	// Bytes the number of random bytes (the value is twice as long)
+	Bytes int `json:"bytes"`
+}
+
 // ID defines model for ID.
 type ID = openapi_types.UUID
 
+// ImportConflictPolicy what to do with keys that are already variables of the project
+type ImportConflictPolicy string
+
+// ImportResultObject defines model for ImportResultObject.
+type ImportResultObject struct {
+	Action ImportResultObjectAction `json:"action"`
+	Key    string                   `json:"key"`
+
+	// VariableId the variable (omitted for variables not yet created, i.e. in a dry run)
+	VariableId *ID `json:"variable_id,omitempty"`
+
+	// Violation in a dry run, why the value does not satisfy the type (or constraints) of its variable, so importing it
+	// would fail (omitted if it does)
+	Violation *string `json:"violation,omitempty"`
+}
+
+// ImportResultObjectAction defines model for ImportResultObject.Action.
+type ImportResultObjectAction string
+
+// ImportResults defines model for ImportResults.
+type ImportResults = []ImportResultObject
+
+// KeypairGeneratorData a private key, generated per environment; its public key is kept in a second, linked variable
+// (created with it, and deleted with it)
+type KeypairGeneratorData struct {
+	Alg KeypairGeneratorDataAlg `json:"alg"`
+
+	// Bits the modulus size of an rsa key (2048, 3072 or 4096; default 2048) or the curve size of an
+	// ecdsa key (256, 384 or 521; default 256); not used for ed25519
+	Bits *int `json:"bits,omitempty"`
+
+	// Format PEM (PKCS #8 private key, PKIX public key) or JWK
+	Format KeypairGeneratorDataFormat `json:"format"`
+
+	// PublicKey the key of the variable holding the public key (e.g. `JWT_PUBLIC_KEY`)
+	PublicKey string `json:"public_key"`
+}
+
+// KeypairGeneratorDataAlg defines model for KeypairGeneratorData.Alg.
+type KeypairGeneratorDataAlg string
+
+// KeypairGeneratorDataFormat PEM (PKCS #8 private key, PKIX public key) or JWK
+type KeypairGeneratorDataFormat string
+
+// Owner the person or team responsible for a variable
+type Owner = string
+
 // ProjectObject defines model for ProjectObject.
 type ProjectObject struct {
 	Display string `json:"display"`
@@ -75,12 +356,45 @@ type Projects = []ProjectObject
 
 // RandomGeneratorData defines model for RandomGeneratorData.
 type RandomGeneratorData struct {
-	Length  float32 `json:"length"`
-	Letters bool    `json:"letters"`
-	Numbers bool    `json:"numbers"`
-	Symbols bool    `json:"symbols"`
+	// Alphabet the characters to draw from, instead of those enabled by letters, numbers and symbols
+	Alphabet *string `json:"alphabet,omitempty"`
+
+	// Exclude characters never to use
+	Exclude *string `json:"exclude,omitempty"`
+
+	// ExcludeAmbiguous never use characters that are easily confused (`0Oo1lI|`)
+	ExcludeAmbiguous *bool   `json:"exclude_ambiguous,omitempty"`
+	Length           float32 `json:"length"`
+	Letters          bool    `json:"letters"`
+
+	// MinLowercase the minimum number of lowercase letters
+	MinLowercase *int `json:"min_lowercase,omitempty"`
+
+	// MinNumbers the minimum number of numbers
+	MinNumbers *int `json:"min_numbers,omitempty"`
+
+	// MinSymbols the minimum number of symbols (anything that is not a letter or a number)
+	MinSymbols *int `json:"min_symbols,omitempty"`
+
+	// MinUppercase the minimum number of uppercase letters
+	MinUppercase *int `json:"min_uppercase,omitempty"`
+	Numbers      bool `json:"numbers"`
+	Symbols      bool `json:"symbols"`
+}
+
+// SecretEventObject A change to a secret (sent as the data of a watched event); the value itself is never sent.
+type SecretEventObject struct {
+	Action        SecretEventObjectAction `json:"action"`
+	CreatedAt     string                  `json:"created_at"`
+	EnvironmentId ID                      `json:"environment_id"`
+	Id            int64                   `json:"id"`
+	VariableId    ID                      `json:"variable_id"`
+	VariableKey   string                  `json:"variable_key"`
 }
 
+// SecretEventObjectAction defines model for SecretEventObject.Action.
+type SecretEventObjectAction string
+
 // SecretGenerator defines model for SecretGenerator.
 type SecretGenerator struct {
 	union json.RawMessage
@@ -92,6 +406,42 @@ type SecretGeneratorBase struct {
 	Type GeneratorType `json:"type"`
 }
 
+// SecretGeneratorBase64 defines model for SecretGeneratorBase64.
+type SecretGeneratorBase64 struct {
+	Data Base64GeneratorData       `json:"data"`
+	Type SecretGeneratorBase64Type `json:"type"`
+}
+
+// SecretGeneratorBase64Type defines model for SecretGeneratorBase64.Type.
+type SecretGeneratorBase64Type string
+
+// SecretGeneratorCertificate defines model for SecretGeneratorCertificate.
+type SecretGeneratorCertificate struct {
+	Data CertificateGeneratorData       `json:"data"`
+	Type SecretGeneratorCertificateType `json:"type"`
+}
+
+// SecretGeneratorCertificateType defines model for SecretGeneratorCertificate.Type.
+type SecretGeneratorCertificateType string
+
+// SecretGeneratorHex defines model for SecretGeneratorHex.
+type SecretGeneratorHex struct {
+	Data HexGeneratorData       `json:"data"`
+	Type SecretGeneratorHexType `json:"type"`
+}
+
+// SecretGeneratorHexType defines model for SecretGeneratorHex.Type.
+type SecretGeneratorHexType string
+
+// SecretGeneratorKeypair defines model for SecretGeneratorKeypair.
+type SecretGeneratorKeypair struct {
+	Data KeypairGeneratorData       `json:"data"`
+	Type SecretGeneratorKeypairType `json:"type"`
+}
+
+// SecretGeneratorKeypairType defines model for SecretGeneratorKeypair.Type.
+type SecretGeneratorKeypairType string
+
 // SecretGeneratorRandom defines model for SecretGeneratorRandom.
 type SecretGeneratorRandom struct {
 	Data RandomGeneratorData       `json:"data"`
@@ -110,6 +460,15 @@ type SecretGeneratorStatic struct {
 // SecretGeneratorStaticType defines model for SecretGeneratorStatic.Type.
 type SecretGeneratorStaticType string
 
+// SecretGeneratorUuid defines model for SecretGeneratorUuid.
+type SecretGeneratorUuid struct {
+	Data UuidGeneratorData       `json:"data"`
+	Type SecretGeneratorUuidType `json:"type"`
+}
+
+// SecretGeneratorUuidType defines model for SecretGeneratorUuid.Type.
+type SecretGeneratorUuidType string
+
 // SecretObject Binding of a Secret to a Variable and an Environment (with nested Project), modeled in a single schema.
 type SecretObject struct {
 	Environment struct {
@@ -120,7 +479,17 @@ type SecretObject struct {
 			Id      openapi_types.UUID `json:"id"`
 		} `json:"project"`
 	} `json:"environment"`
-	Id       ID     `json:"id"`
+	Id ID `json:"id"`
+
+	// Source the environment the value was resolved from (the environment itself, unless the value is inherited)
+	Source struct {
+		Display string `json:"display"`
+		Id      ID     `json:"id"`
+	} `json:"source"`
+
+	// Value the value; a value set by hand (or the default of a STATIC variable) may reference other variables of the
+	// environment as `${KEY}` (`$${` is a literal `${`), which are resolved when the secrets of an environment
+	// are listed; generated values are never interpolated
 	Value    string `json:"value"`
 	Variable struct {
 		Id ID `json:"id"`
@@ -130,22 +499,117 @@ type SecretObject struct {
 	} `json:"variable"`
 }
 
+// SecretVersionObject A version of a secret; the value itself is never returned.
+type SecretVersionObject struct {
+	// Actor the admin key name (or `bootstrap`), the client id, or the database role
+	Actor string `json:"actor"`
+
+	// ActorType `admin`, `client` or `system`
+	ActorType string `json:"actor_type"`
+	CreatedAt string `json:"created_at"`
+
+	// Current whether this is the current value of the secret
+	Current bool `json:"current"`
+
+	// RolledBackFrom the version this version restored the value of (if it was a rollback)
+	RolledBackFrom *int `json:"rolled_back_from,omitempty"`
+	Version        int  `json:"version"`
+}
+
+// SecretVersions defines model for SecretVersions.
+type SecretVersions = []SecretVersionObject
+
 // Secrets defines model for Secrets.
 type Secrets = []SecretObject
 
 // StaticGeneratorData defines model for StaticGeneratorData.
 type StaticGeneratorData = string
 
+// Tag a lowercase label of a variable (e.g. `billing` or `service:api`)
+type Tag = string
+
+// UuidGeneratorData defines model for UuidGeneratorData.
+type UuidGeneratorData struct {
+	// Version 4 (random) or 7 (random, prefixed with the creation time so values sort by age)
+	Version UuidGeneratorDataVersion `json:"version"`
+}
+
+// UuidGeneratorDataVersion 4 (random) or 7 (random, prefixed with the creation time so values sort by age)
+type UuidGeneratorDataVersion int
+
+// ValidationObject defines model for ValidationObject.
+type ValidationObject struct {
+	// Valid whether every value satisfies the type and constraints of its variable
+	Valid      bool              `json:"valid"`
+	Violations []ViolationObject `json:"violations"`
+}
+
+// ValueConstraints constraints on the values of a variable (which ones apply depends on its type)
+type ValueConstraints struct {
+	// Max INT values must be at most this
+	Max *int64 `json:"max,omitempty"`
+
+	// MaxLength STRING values must be at most this long
+	MaxLength *int `json:"max_length,omitempty"`
+
+	// Min INT values must be at least this
+	Min *int64 `json:"min,omitempty"`
+
+	// MinLength STRING values must be at least this long
+	MinLength *int `json:"min_length,omitempty"`
+
+	// Pattern a (POSIX) regular expression REGEX values must match in full (required for REGEX)
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Schemes the schemes URL values may use (any, if omitted)
+	Schemes *[]string `json:"schemes,omitempty"`
+
+	// Values the values an ENUM may take (required for ENUM)
+	Values *[]string `json:"values,omitempty"`
+}
+
+// ValueObject defines model for ValueObject.
+type ValueObject struct {
+	// Masked whether the value was withheld (sensitive and not revealed)
+	Masked bool `json:"masked"`
+
+	// Sensitive whether the value is sensitive (the variable is, or its value references one that is)
+	Sensitive bool `json:"sensitive"`
+
+	// Value the (resolved) value, omitted when masked
+	Value       *string `json:"value,omitempty"`
+	VariableId  ID      `json:"variable_id"`
+	VariableKey string  `json:"variable_key"`
+}
+
+// ValueType the type the values of a variable must have (checked whenever a value is written)
+type ValueType string
+
+// Values defines model for Values.
+type Values = []ValueObject
+
 // VariableObject defines model for VariableObject.
 type VariableObject struct {
-	Description string `json:"description"`
+	// Constraints constraints on the values of a variable (which ones apply depends on its type)
+	Constraints ValueConstraints `json:"constraints"`
+	Description string           `json:"description"`
 
 	// GeneratorData arbitrary generator data payload, based on type
 	GeneratorData VariableObject_GeneratorData `json:"generator_data"`
 	GeneratorType GeneratorType                `json:"generator_type"`
 	Id            ID                           `json:"id"`
 	Key           string                       `json:"key"`
-	ProjectId     openapi_types.UUID           `json:"project_id"`
+
+	// Owner the person or team responsible for a variable
+	Owner     *Owner             `json:"owner,omitempty"`
+	ProjectId openapi_types.UUID `json:"project_id"`
+
+	// Sensitive whether the values of the variable are masked unless explicitly revealed
+	Sensitive bool  `json:"sensitive"`
+	Tags      []Tag `json:"tags"`
+
+	// Type the type the values of a variable must have (checked whenever a value is written)
+	Type ValueType `json:"type"`
 }
 
 // VariableObjectGeneratorData0 defines model for .
@@ -171,12 +635,41 @@ type VariableObject_GeneratorData struct {
 // Variables defines model for Variables.
 type Variables = []VariableObject
 
+// ViolationObject defines model for ViolationObject.
+type ViolationObject struct {
+	// Message why the value is invalid
+	Message string `json:"message"`
+
+	// Type the type the values of a variable must have (checked whenever a value is written)
+	Type        ValueType `json:"type"`
+	VariableId  ID        `json:"variable_id"`
+	VariableKey string    `json:"variable_key"`
+}
+
+// LastEventId defines model for LastEventId.
+type LastEventId = int64
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// TagFilter defines model for TagFilter.
+type TagFilter = []Tag
+
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
 // ClientRepresentationResponse defines model for ClientRepresentationResponse.
 type ClientRepresentationResponse = ClientObject
 
+// CreateAdminKeyResponse defines model for CreateAdminKeyResponse.
+type CreateAdminKeyResponse struct {
+	Id  openapi_types.UUID `json:"id"`
+	Key string             `json:"key"`
+}
+
 // CreateClientResponse defines model for CreateClientResponse.
 type CreateClientResponse struct {
 	Id     openapi_types.UUID `json:"id"`
@@ -186,6 +679,13 @@ type CreateClientResponse struct {
 	} `json:"secret"`
 }
 
+// CreateClientSecretResponse defines model for CreateClientSecretResponse.
+type CreateClientSecretResponse struct {
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Key       string             `json:"key"`
+}
+
 // Forbidden defines model for Forbidden.
 type Forbidden = Error
 
@@ -197,6 +697,9 @@ type IDResponse struct {
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
+// NotFound defines model for NotFound.
+type NotFound = Error
+
 // Ready defines model for Ready.
 type Ready struct {
 	// Msg ready
@@ -238,104 +741,530 @@ type Unauthorized = Error
 
 // CreateVariableRequestBody defines model for CreateVariableRequestBody.
 type CreateVariableRequestBody struct {
-	Generator SecretGenerator `json:"generator"`
+	// Constraints constraints on the values of a variable (which ones apply depends on its type)
+	Constraints *ValueConstraints `json:"constraints,omitempty"`
+	Generator   SecretGenerator   `json:"generator"`
 
 	// Key the key in the environment
 	Key string `json:"key"`
+
+	// Owner the person or team responsible for a variable
+	Owner *Owner `json:"owner,omitempty"`
+
+	// Sensitive whether the values of the variable are masked unless explicitly revealed
+	Sensitive *bool  `json:"sensitive,omitempty"`
+	Tags      *[]Tag `json:"tags,omitempty"`
+
+	// Type the type the values of a variable must have (checked whenever a value is written)
+	Type *ValueType `json:"type,omitempty"`
 }
 
-// CreateClientV1JSONBody defines parameters for CreateClientV1.
-type CreateClientV1JSONBody struct {
-	// Name client name
+// CreateAdminKeyV1JSONBody defines parameters for CreateAdminKeyV1.
+type CreateAdminKeyV1JSONBody struct {
+	// Name admin key name (must be unique)
 	Name string `json:"name"`
 }
 
-// CreateProjectV1JSONBody defines parameters for CreateProjectV1.
-type CreateProjectV1JSONBody struct {
-	// Name project name (must be unique)
-	Name string `json:"name"`
+// GetAuditLogV1Params defines parameters for GetAuditLogV1.
+type GetAuditLogV1Params struct {
+	// Since only events at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until only events before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Actor only events of this actor (admin key name, `bootstrap` or client id)
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action only events of this action (e.g. `delete` or `http.get`)
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// EnvironmentId only events concerning this environment
+	EnvironmentId *ID `form:"environment_id,omitempty" json:"environment_id,omitempty"`
+
+	// Limit maximum number of events to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// AfterId only events after this one, in commit order (0 for the start of the log)
+	AfterId *int64 `form:"after_id,omitempty" json:"after_id,omitempty"`
 }
 
-// CreateEnvironmentV1JSONBody defines parameters for CreateEnvironmentV1.
-type CreateEnvironmentV1JSONBody struct {
-	// Name environment name (must be unique)
-	Name string `json:"name"`
+// GetClientSecretsV1Params defines parameters for GetClientSecretsV1.
+type GetClientSecretsV1Params struct {
+	// Tag only those of variables with every one of these tags (repeatable)
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
 }
 
-// CreateVariableV1JSONBody defines parameters for CreateVariableV1.
-type CreateVariableV1JSONBody struct {
-	Generator SecretGenerator `json:"generator"`
+// WatchClientSecretsV1Params defines parameters for WatchClientSecretsV1.
+type WatchClientSecretsV1Params struct {
+	// LastEventId resume after this event id (the `Last-Event-ID` header takes precedence)
+	LastEventId *LastEventId `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+}
 
-	// Key the key in the environment
-	Key string `json:"key"`
+// CreateClientSecretV1JSONBody defines parameters for CreateClientSecretV1.
+type CreateClientSecretV1JSONBody struct {
+	// ExpiresAt when the secret stops being accepted (never, if omitted)
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
-// CreateClientV1JSONRequestBody defines body for CreateClientV1 for application/json ContentType.
-type CreateClientV1JSONRequestBody CreateClientV1JSONBody
+// UpdateEnvironmentV1JSONBody defines parameters for UpdateEnvironmentV1.
+type UpdateEnvironmentV1JSONBody struct {
+	// Name environment name (must be unique)
+	Name *string `json:"name,omitempty"`
 
-// CreateProjectV1JSONRequestBody defines body for CreateProjectV1 for application/json ContentType.
-type CreateProjectV1JSONRequestBody CreateProjectV1JSONBody
+	// ParentId an environment of the same project to inherit secrets from, or `null` for none
+	// (inherited values are then copied into the environment)
+	ParentId *ID `json:"parent_id"`
+}
 
-// CreateEnvironmentV1JSONRequestBody defines body for CreateEnvironmentV1 for application/json ContentType.
-type CreateEnvironmentV1JSONRequestBody CreateEnvironmentV1JSONBody
+// GetClientsV1Params defines parameters for GetClientsV1.
+type GetClientsV1Params struct {
+	// Limit maximum number of objects to return (all, if omitted)
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
-// CreateVariableV1JSONRequestBody defines body for CreateVariableV1 for application/json ContentType.
-type CreateVariableV1JSONRequestBody CreateVariableV1JSONBody
+	// Offset number of objects to skip (use with `limit` to page through a list)
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 
-// AsSecretGeneratorStatic returns the union data inside the SecretGenerator as a SecretGeneratorStatic
-func (t SecretGenerator) AsSecretGeneratorStatic() (SecretGeneratorStatic, error) {
-	var body SecretGeneratorStatic
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+	// Filter only clients whose name starts with this prefix (case-insensitive)
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Sort the field to sort by (prefix with `-` for descending order)
+	Sort *GetClientsV1ParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
-// FromSecretGeneratorStatic overwrites any union data inside the SecretGenerator as the provided SecretGeneratorStatic
-func (t *SecretGenerator) FromSecretGeneratorStatic(v SecretGeneratorStatic) error {
-	v.Type = "STATIC"
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// GetClientsV1ParamsSort defines parameters for GetClientsV1.
+type GetClientsV1ParamsSort string
+
+// CreateClientV1JSONBody defines parameters for CreateClientV1.
+type CreateClientV1JSONBody struct {
+	// Name client name
+	Name string `json:"name"`
 }
 
-// MergeSecretGeneratorStatic performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorStatic
-func (t *SecretGenerator) MergeSecretGeneratorStatic(v SecretGeneratorStatic) error {
-	v.Type = "STATIC"
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// GetEnvironmentSecretsV1Params defines parameters for GetEnvironmentSecretsV1.
+type GetEnvironmentSecretsV1Params struct {
+	// Tag only those of variables with every one of these tags (repeatable)
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+}
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+// RotateEnvironmentSecretsV1Params defines parameters for RotateEnvironmentSecretsV1.
+type RotateEnvironmentSecretsV1Params struct {
+	// Generator only variables with one of these generators (repeatable; default RANDOM; STATIC cannot be rotated)
+	Generator *[]GeneratorType `form:"generator,omitempty" json:"generator,omitempty"`
 }
 
-// AsSecretGeneratorRandom returns the union data inside the SecretGenerator as a SecretGeneratorRandom
-func (t SecretGenerator) AsSecretGeneratorRandom() (SecretGeneratorRandom, error) {
-	var body SecretGeneratorRandom
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// WatchEnvironmentSecretsV1Params defines parameters for WatchEnvironmentSecretsV1.
+type WatchEnvironmentSecretsV1Params struct {
+	// LastEventId resume after this event id (the `Last-Event-ID` header takes precedence)
+	LastEventId *LastEventId `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
 }
 
-// FromSecretGeneratorRandom overwrites any union data inside the SecretGenerator as the provided SecretGeneratorRandom
-func (t *SecretGenerator) FromSecretGeneratorRandom(v SecretGeneratorRandom) error {
-	v.Type = "RANDOM"
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// SetEnvironmentSecretV1JSONBody defines parameters for SetEnvironmentSecretV1.
+type SetEnvironmentSecretV1JSONBody struct {
+	// Value the new value of the secret in this environment
+	Value string `json:"value"`
 }
 
-// MergeSecretGeneratorRandom performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorRandom
-func (t *SecretGenerator) MergeSecretGeneratorRandom(v SecretGeneratorRandom) error {
-	v.Type = "RANDOM"
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// RollbackEnvironmentSecretV1JSONBody defines parameters for RollbackEnvironmentSecretV1.
+type RollbackEnvironmentSecretV1JSONBody struct {
+	// Version the version to restore the value of
+	Version int `json:"version"`
+}
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+// GetEnvironmentValuesV1Params defines parameters for GetEnvironmentValuesV1.
+type GetEnvironmentValuesV1Params struct {
+	// Reveal include the values of sensitive variables
+	Reveal *bool `form:"reveal,omitempty" json:"reveal,omitempty"`
+
+	// Tag only those of variables with every one of these tags (repeatable)
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// ImportEnvironmentV1JSONBody defines parameters for ImportEnvironmentV1.
+type ImportEnvironmentV1JSONBody struct {
+	// Conflict what to do with keys that are already variables of the project
+	Conflict *ImportConflictPolicy `json:"conflict,omitempty"`
+
+	// DryRun only return what would be imported, without importing it
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// ProjectId the project of the environment (the import fails if the environment is not in it)
+	ProjectId ID `json:"project_id"`
+
+	// Sensitive whether the variables that are created are sensitive
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Variables the values to import, by key
+	Variables map[string]string `json:"variables"`
+}
+
+// ExportEnvironmentV1Params defines parameters for ExportEnvironmentV1.
+type ExportEnvironmentV1Params struct {
+	Format ExportFormat `form:"format" json:"format"`
+
+	// Name the name of a Kubernetes manifest (by default, derived from the project and environment)
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Tag only those of variables with every one of these tags (repeatable)
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// GetProjectsV1Params defines parameters for GetProjectsV1.
+type GetProjectsV1Params struct {
+	// Limit maximum number of objects to return (all, if omitted)
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset number of objects to skip (use with `limit` to page through a list)
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Filter only projects whose name starts with this prefix (case-insensitive)
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Sort the field to sort by (prefix with `-` for descending order)
+	Sort *GetProjectsV1ParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetProjectsV1ParamsSort defines parameters for GetProjectsV1.
+type GetProjectsV1ParamsSort string
+
+// CreateProjectV1JSONBody defines parameters for CreateProjectV1.
+type CreateProjectV1JSONBody struct {
+	// Name project name (must be unique)
+	Name string `json:"name"`
+}
+
+// UpdateProjectV1JSONBody defines parameters for UpdateProjectV1.
+type UpdateProjectV1JSONBody struct {
+	// Name project name (must be unique)
+	Name *string `json:"name,omitempty"`
+}
+
+// GetEnvironmentsV1Params defines parameters for GetEnvironmentsV1.
+type GetEnvironmentsV1Params struct {
+	// Limit maximum number of objects to return (all, if omitted)
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset number of objects to skip (use with `limit` to page through a list)
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Filter only environments whose name starts with this prefix (case-insensitive)
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Sort the field to sort by (prefix with `-` for descending order)
+	Sort *GetEnvironmentsV1ParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetEnvironmentsV1ParamsSort defines parameters for GetEnvironmentsV1.
+type GetEnvironmentsV1ParamsSort string
+
+// CreateEnvironmentV1JSONBody defines parameters for CreateEnvironmentV1.
+type CreateEnvironmentV1JSONBody struct {
+	// Name environment name (must be unique)
+	Name string `json:"name"`
+
+	// ParentId an environment of the same project to inherit secrets from (until they are set)
+	ParentId *ID `json:"parent_id,omitempty"`
+}
+
+// GetVariablesV1Params defines parameters for GetVariablesV1.
+type GetVariablesV1Params struct {
+	// Limit maximum number of objects to return (all, if omitted)
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset number of objects to skip (use with `limit` to page through a list)
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Filter only variables whose key starts with this prefix (case-insensitive)
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Sort the field to sort by (prefix with `-` for descending order)
+	Sort *GetVariablesV1ParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Tag only those of variables with every one of these tags (repeatable)
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// GetVariablesV1ParamsSort defines parameters for GetVariablesV1.
+type GetVariablesV1ParamsSort string
+
+// CreateVariableV1JSONBody defines parameters for CreateVariableV1.
+type CreateVariableV1JSONBody struct {
+	// Constraints constraints on the values of a variable (which ones apply depends on its type)
+	Constraints *ValueConstraints `json:"constraints,omitempty"`
+	Generator   SecretGenerator   `json:"generator"`
+
+	// Key the key in the environment
+	Key string `json:"key"`
+
+	// Owner the person or team responsible for a variable
+	Owner *Owner `json:"owner,omitempty"`
+
+	// Sensitive whether the values of the variable are masked unless explicitly revealed
+	Sensitive *bool  `json:"sensitive,omitempty"`
+	Tags      *[]Tag `json:"tags,omitempty"`
+
+	// Type the type the values of a variable must have (checked whenever a value is written)
+	Type *ValueType `json:"type,omitempty"`
+}
+
+// UpdateVariableV1JSONBody defines parameters for UpdateVariableV1.
+type UpdateVariableV1JSONBody struct {
+	// Constraints constraints on the values of a variable (which ones apply depends on its type)
+	Constraints *ValueConstraints `json:"constraints,omitempty"`
+
+	// Description a human-readable description of the variable
+	Description *string          `json:"description,omitempty"`
+	Generator   *SecretGenerator `json:"generator,omitempty"`
+
+	// Key the key in the environment
+	Key *string `json:"key,omitempty"`
+
+	// Owner the person or team responsible for the variable (an empty string removes it)
+	Owner *string `json:"owner,omitempty"`
+
+	// Regenerate regenerate the secret in every environment from the (new) generator
+	Regenerate *bool `json:"regenerate,omitempty"`
+
+	// Sensitive whether the values of the variable are masked unless explicitly revealed
+	Sensitive *bool `json:"sensitive,omitempty"`
+
+	// Tags replaces the tags of the variable
+	Tags *[]Tag `json:"tags,omitempty"`
+
+	// Type the type the values of a variable must have (checked whenever a value is written)
+	Type *ValueType `json:"type,omitempty"`
+}
+
+// CreateAdminKeyV1JSONRequestBody defines body for CreateAdminKeyV1 for application/json ContentType.
+type CreateAdminKeyV1JSONRequestBody CreateAdminKeyV1JSONBody
+
+// CreateClientSecretV1JSONRequestBody defines body for CreateClientSecretV1 for application/json ContentType.
+type CreateClientSecretV1JSONRequestBody CreateClientSecretV1JSONBody
+
+// UpdateEnvironmentV1JSONRequestBody defines body for UpdateEnvironmentV1 for application/json ContentType.
+type UpdateEnvironmentV1JSONRequestBody UpdateEnvironmentV1JSONBody
+
+// CreateClientV1JSONRequestBody defines body for CreateClientV1 for application/json ContentType.
+type CreateClientV1JSONRequestBody CreateClientV1JSONBody
+
+// SetEnvironmentSecretV1JSONRequestBody defines body for SetEnvironmentSecretV1 for application/json ContentType.
+type SetEnvironmentSecretV1JSONRequestBody SetEnvironmentSecretV1JSONBody
+
+// RollbackEnvironmentSecretV1JSONRequestBody defines body for RollbackEnvironmentSecretV1 for application/json ContentType.
+type RollbackEnvironmentSecretV1JSONRequestBody RollbackEnvironmentSecretV1JSONBody
+
+// ImportEnvironmentV1JSONRequestBody defines body for ImportEnvironmentV1 for application/json ContentType.
+type ImportEnvironmentV1JSONRequestBody ImportEnvironmentV1JSONBody
+
+// CreateProjectV1JSONRequestBody defines body for CreateProjectV1 for application/json ContentType.
+type CreateProjectV1JSONRequestBody CreateProjectV1JSONBody
+
+// UpdateProjectV1JSONRequestBody defines body for UpdateProjectV1 for application/json ContentType.
+type UpdateProjectV1JSONRequestBody UpdateProjectV1JSONBody
+
+// CreateEnvironmentV1JSONRequestBody defines body for CreateEnvironmentV1 for application/json ContentType.
+type CreateEnvironmentV1JSONRequestBody CreateEnvironmentV1JSONBody
+
+// CreateVariableV1JSONRequestBody defines body for CreateVariableV1 for application/json ContentType.
+type CreateVariableV1JSONRequestBody CreateVariableV1JSONBody
+
+// UpdateVariableV1JSONRequestBody defines body for UpdateVariableV1 for application/json ContentType.
+type UpdateVariableV1JSONRequestBody UpdateVariableV1JSONBody
+
+// AsSecretGeneratorStatic returns the union data inside the SecretGenerator as a SecretGeneratorStatic
+func (t SecretGenerator) AsSecretGeneratorStatic() (SecretGeneratorStatic, error) {
+	var body SecretGeneratorStatic
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretGeneratorStatic overwrites any union data inside the SecretGenerator as the provided SecretGeneratorStatic
+func (t *SecretGenerator) FromSecretGeneratorStatic(v SecretGeneratorStatic) error {
+	v.Type = "STATIC"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretGeneratorStatic performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorStatic
+func (t *SecretGenerator) MergeSecretGeneratorStatic(v SecretGeneratorStatic) error {
+	v.Type = "STATIC"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretGeneratorRandom returns the union data inside the SecretGenerator as a SecretGeneratorRandom
+func (t SecretGenerator) AsSecretGeneratorRandom() (SecretGeneratorRandom, error) {
+	var body SecretGeneratorRandom
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretGeneratorRandom overwrites any union data inside the SecretGenerator as the provided SecretGeneratorRandom
+func (t *SecretGenerator) FromSecretGeneratorRandom(v SecretGeneratorRandom) error {
+	v.Type = "RANDOM"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretGeneratorRandom performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorRandom
+func (t *SecretGenerator) MergeSecretGeneratorRandom(v SecretGeneratorRandom) error {
+	v.Type = "RANDOM"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretGeneratorUuid returns the union data inside the SecretGenerator as a SecretGeneratorUuid
+func (t SecretGenerator) AsSecretGeneratorUuid() (SecretGeneratorUuid, error) {
+	var body SecretGeneratorUuid
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretGeneratorUuid overwrites any union data inside the SecretGenerator as the provided SecretGeneratorUuid
+func (t *SecretGenerator) FromSecretGeneratorUuid(v SecretGeneratorUuid) error {
+	v.Type = "UUID"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretGeneratorUuid performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorUuid
+func (t *SecretGenerator) MergeSecretGeneratorUuid(v SecretGeneratorUuid) error {
+	v.Type = "UUID"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretGeneratorHex returns the union data inside the SecretGenerator as a SecretGeneratorHex
+func (t SecretGenerator) AsSecretGeneratorHex() (SecretGeneratorHex, error) {
+	var body SecretGeneratorHex
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretGeneratorHex overwrites any union data inside the SecretGenerator as the provided SecretGeneratorHex
+func (t *SecretGenerator) FromSecretGeneratorHex(v SecretGeneratorHex) error {
+	v.Type = "HEX"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretGeneratorHex performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorHex
+func (t *SecretGenerator) MergeSecretGeneratorHex(v SecretGeneratorHex) error {
+	v.Type = "HEX"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretGeneratorBase64 returns the union data inside the SecretGenerator as a SecretGeneratorBase64
+func (t SecretGenerator) AsSecretGeneratorBase64() (SecretGeneratorBase64, error) {
+	var body SecretGeneratorBase64
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretGeneratorBase64 overwrites any union data inside the SecretGenerator as the provided SecretGeneratorBase64
+func (t *SecretGenerator) FromSecretGeneratorBase64(v SecretGeneratorBase64) error {
+	v.Type = "BASE64"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretGeneratorBase64 performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorBase64
+func (t *SecretGenerator) MergeSecretGeneratorBase64(v SecretGeneratorBase64) error {
+	v.Type = "BASE64"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretGeneratorKeypair returns the union data inside the SecretGenerator as a SecretGeneratorKeypair
+func (t SecretGenerator) AsSecretGeneratorKeypair() (SecretGeneratorKeypair, error) {
+	var body SecretGeneratorKeypair
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretGeneratorKeypair overwrites any union data inside the SecretGenerator as the provided SecretGeneratorKeypair
+func (t *SecretGenerator) FromSecretGeneratorKeypair(v SecretGeneratorKeypair) error {
+	v.Type = "KEYPAIR"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretGeneratorKeypair performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorKeypair
+func (t *SecretGenerator) MergeSecretGeneratorKeypair(v SecretGeneratorKeypair) error {
+	v.Type = "KEYPAIR"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretGeneratorCertificate returns the union data inside the SecretGenerator as a SecretGeneratorCertificate
+func (t SecretGenerator) AsSecretGeneratorCertificate() (SecretGeneratorCertificate, error) {
+	var body SecretGeneratorCertificate
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretGeneratorCertificate overwrites any union data inside the SecretGenerator as the provided SecretGeneratorCertificate
+func (t *SecretGenerator) FromSecretGeneratorCertificate(v SecretGeneratorCertificate) error {
+	v.Type = "CERTIFICATE"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretGeneratorCertificate performs a merge with any union data inside the SecretGenerator, using the provided SecretGeneratorCertificate
+func (t *SecretGenerator) MergeSecretGeneratorCertificate(v SecretGeneratorCertificate) error {
+	v.Type = "CERTIFICATE"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
 func (t SecretGenerator) Discriminator() (string, error) {
@@ -352,10 +1281,20 @@ func (t SecretGenerator) ValueByDiscriminator() (interface{}, error) {
 		return nil, err
 	}
 	switch discriminator {
+	case "BASE64":
+		return t.AsSecretGeneratorBase64()
+	case "CERTIFICATE":
+		return t.AsSecretGeneratorCertificate()
+	case "HEX":
+		return t.AsSecretGeneratorHex()
+	case "KEYPAIR":
+		return t.AsSecretGeneratorKeypair()
 	case "RANDOM":
 		return t.AsSecretGeneratorRandom()
 	case "STATIC":
 		return t.AsSecretGeneratorStatic()
+	case "UUID":
+		return t.AsSecretGeneratorUuid()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -584,11 +1523,39 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAdminKeysV1 request
+	GetAdminKeysV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAdminKeyV1WithBody request with any body
+	CreateAdminKeyV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAdminKeyV1(ctx context.Context, body CreateAdminKeyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeAdminKeyV1 request
+	RevokeAdminKeyV1(ctx context.Context, keyId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuditLogV1 request
+	GetAuditLogV1(ctx context.Context, params *GetAuditLogV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetV1ClientsSelf request
 	GetV1ClientsSelf(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetClientSecretsV1 request
-	GetClientSecretsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetClientSecretsV1(ctx context.Context, params *GetClientSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WatchClientSecretsV1 request
+	WatchClientSecretsV1(ctx context.Context, params *WatchClientSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClientSecretsListV1 request
+	GetClientSecretsListV1(ctx context.Context, clientId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateClientSecretV1WithBody request with any body
+	CreateClientSecretV1WithBody(ctx context.Context, clientId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateClientSecretV1(ctx context.Context, clientId ID, body CreateClientSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeClientSecretV1 request
+	RevokeClientSecretV1(ctx context.Context, clientId ID, secretId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteEnvironmentV1 request
 	DeleteEnvironmentV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -596,33 +1563,82 @@ type ClientInterface interface {
 	// GetEnvironmentV1 request
 	GetEnvironmentV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateEnvironmentV1WithBody request with any body
+	UpdateEnvironmentV1WithBody(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateEnvironmentV1(ctx context.Context, environmentId ID, body UpdateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetClientsV1 request
-	GetClientsV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetClientsV1(ctx context.Context, environmentId ID, params *GetClientsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateClientV1WithBody request with any body
 	CreateClientV1WithBody(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateClientV1(ctx context.Context, environmentId ID, body CreateClientV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetEnvironmentSecretsV1 request
-	GetEnvironmentSecretsV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ExportEnvironmentV1 request
+	ExportEnvironmentV1(ctx context.Context, environmentId ID, params *ExportEnvironmentV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetProjectsV1 request
-	GetProjectsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ImportEnvironmentV1WithBody request with any body
+	ImportEnvironmentV1WithBody(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// CreateProjectV1WithBody request with any body
-	CreateProjectV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ImportEnvironmentV1(ctx context.Context, environmentId ID, body ImportEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateProjectV1(ctx context.Context, body CreateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetEnvironmentSecretsV1 request
+	GetEnvironmentSecretsV1(ctx context.Context, environmentId ID, params *GetEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeleteProjectV1 request
-	DeleteProjectV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// RotateEnvironmentSecretsV1 request
+	RotateEnvironmentSecretsV1(ctx context.Context, environmentId ID, params *RotateEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WatchEnvironmentSecretsV1 request
+	WatchEnvironmentSecretsV1(ctx context.Context, environmentId ID, params *WatchEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnsetEnvironmentSecretV1 request
+	UnsetEnvironmentSecretV1(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetEnvironmentSecretV1WithBody request with any body
+	SetEnvironmentSecretV1WithBody(ctx context.Context, environmentId ID, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetEnvironmentSecretV1(ctx context.Context, environmentId ID, variableId ID, body SetEnvironmentSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RollbackEnvironmentSecretV1WithBody request with any body
+	RollbackEnvironmentSecretV1WithBody(ctx context.Context, environmentId ID, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RollbackEnvironmentSecretV1(ctx context.Context, environmentId ID, variableId ID, body RollbackEnvironmentSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateEnvironmentSecretV1 request
+	RotateEnvironmentSecretV1(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEnvironmentSecretVersionsV1 request
+	GetEnvironmentSecretVersionsV1(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateEnvironmentV1 request
+	ValidateEnvironmentV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEnvironmentValuesV1 request
+	GetEnvironmentValuesV1(ctx context.Context, environmentId ID, params *GetEnvironmentValuesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectsV1 request
+	GetProjectsV1(ctx context.Context, params *GetProjectsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectV1WithBody request with any body
+	CreateProjectV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProjectV1(ctx context.Context, body CreateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProjectV1 request
+	DeleteProjectV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetProjectV1 request
 	GetProjectV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateProjectV1WithBody request with any body
+	UpdateProjectV1WithBody(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectV1(ctx context.Context, projectId ID, body UpdateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEnvironmentsV1 request
-	GetEnvironmentsV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetEnvironmentsV1(ctx context.Context, projectId ID, params *GetEnvironmentsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateEnvironmentV1WithBody request with any body
 	CreateEnvironmentV1WithBody(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -630,7 +1646,7 @@ type ClientInterface interface {
 	CreateEnvironmentV1(ctx context.Context, projectId ID, body CreateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetVariablesV1 request
-	GetVariablesV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetVariablesV1(ctx context.Context, projectId ID, params *GetVariablesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateVariableV1WithBody request with any body
 	CreateVariableV1WithBody(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -648,10 +1664,15 @@ type ClientInterface interface {
 
 	// GetVariableV1 request
 	GetVariableV1(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateVariableV1WithBody request with any body
+	UpdateVariableV1WithBody(ctx context.Context, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateVariableV1(ctx context.Context, variableId ID, body UpdateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetV1ClientsSelf(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1ClientsSelfRequest(c.Server)
+func (c *Client) GetAdminKeysV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminKeysV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -662,8 +1683,8 @@ func (c *Client) GetV1ClientsSelf(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetClientSecretsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClientSecretsV1Request(c.Server)
+func (c *Client) CreateAdminKeyV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAdminKeyV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -674,8 +1695,8 @@ func (c *Client) GetClientSecretsV1(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteEnvironmentV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteEnvironmentV1Request(c.Server, environmentId)
+func (c *Client) CreateAdminKeyV1(ctx context.Context, body CreateAdminKeyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAdminKeyV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -686,8 +1707,8 @@ func (c *Client) DeleteEnvironmentV1(ctx context.Context, environmentId ID, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEnvironmentV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEnvironmentV1Request(c.Server, environmentId)
+func (c *Client) RevokeAdminKeyV1(ctx context.Context, keyId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeAdminKeyV1Request(c.Server, keyId)
 	if err != nil {
 		return nil, err
 	}
@@ -698,8 +1719,8 @@ func (c *Client) GetEnvironmentV1(ctx context.Context, environmentId ID, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetClientsV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClientsV1Request(c.Server, environmentId)
+func (c *Client) GetAuditLogV1(ctx context.Context, params *GetAuditLogV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditLogV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -710,8 +1731,8 @@ func (c *Client) GetClientsV1(ctx context.Context, environmentId ID, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClientV1WithBody(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClientV1RequestWithBody(c.Server, environmentId, contentType, body)
+func (c *Client) GetV1ClientsSelf(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ClientsSelfRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -722,8 +1743,8 @@ func (c *Client) CreateClientV1WithBody(ctx context.Context, environmentId ID, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClientV1(ctx context.Context, environmentId ID, body CreateClientV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClientV1Request(c.Server, environmentId, body)
+func (c *Client) GetClientSecretsV1(ctx context.Context, params *GetClientSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientSecretsV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -734,8 +1755,8 @@ func (c *Client) CreateClientV1(ctx context.Context, environmentId ID, body Crea
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEnvironmentSecretsV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEnvironmentSecretsV1Request(c.Server, environmentId)
+func (c *Client) WatchClientSecretsV1(ctx context.Context, params *WatchClientSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWatchClientSecretsV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -746,8 +1767,8 @@ func (c *Client) GetEnvironmentSecretsV1(ctx context.Context, environmentId ID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProjectsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectsV1Request(c.Server)
+func (c *Client) GetClientSecretsListV1(ctx context.Context, clientId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientSecretsListV1Request(c.Server, clientId)
 	if err != nil {
 		return nil, err
 	}
@@ -758,8 +1779,8 @@ func (c *Client) GetProjectsV1(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectV1RequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateClientSecretV1WithBody(ctx context.Context, clientId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientSecretV1RequestWithBody(c.Server, clientId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -770,8 +1791,8 @@ func (c *Client) CreateProjectV1WithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectV1(ctx context.Context, body CreateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectV1Request(c.Server, body)
+func (c *Client) CreateClientSecretV1(ctx context.Context, clientId ID, body CreateClientSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientSecretV1Request(c.Server, clientId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -782,8 +1803,8 @@ func (c *Client) CreateProjectV1(ctx context.Context, body CreateProjectV1JSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteProjectV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteProjectV1Request(c.Server, projectId)
+func (c *Client) RevokeClientSecretV1(ctx context.Context, clientId ID, secretId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeClientSecretV1Request(c.Server, clientId, secretId)
 	if err != nil {
 		return nil, err
 	}
@@ -794,8 +1815,8 @@ func (c *Client) DeleteProjectV1(ctx context.Context, projectId ID, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProjectV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectV1Request(c.Server, projectId)
+func (c *Client) DeleteEnvironmentV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEnvironmentV1Request(c.Server, environmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -806,8 +1827,8 @@ func (c *Client) GetProjectV1(ctx context.Context, projectId ID, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEnvironmentsV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEnvironmentsV1Request(c.Server, projectId)
+func (c *Client) GetEnvironmentV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentV1Request(c.Server, environmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -818,8 +1839,8 @@ func (c *Client) GetEnvironmentsV1(ctx context.Context, projectId ID, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateEnvironmentV1WithBody(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateEnvironmentV1RequestWithBody(c.Server, projectId, contentType, body)
+func (c *Client) UpdateEnvironmentV1WithBody(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEnvironmentV1RequestWithBody(c.Server, environmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -830,8 +1851,8 @@ func (c *Client) CreateEnvironmentV1WithBody(ctx context.Context, projectId ID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateEnvironmentV1(ctx context.Context, projectId ID, body CreateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateEnvironmentV1Request(c.Server, projectId, body)
+func (c *Client) UpdateEnvironmentV1(ctx context.Context, environmentId ID, body UpdateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEnvironmentV1Request(c.Server, environmentId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -842,8 +1863,8 @@ func (c *Client) CreateEnvironmentV1(ctx context.Context, projectId ID, body Cre
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVariablesV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVariablesV1Request(c.Server, projectId)
+func (c *Client) GetClientsV1(ctx context.Context, environmentId ID, params *GetClientsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientsV1Request(c.Server, environmentId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -854,8 +1875,8 @@ func (c *Client) GetVariablesV1(ctx context.Context, projectId ID, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateVariableV1WithBody(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateVariableV1RequestWithBody(c.Server, projectId, contentType, body)
+func (c *Client) CreateClientV1WithBody(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientV1RequestWithBody(c.Server, environmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -866,8 +1887,8 @@ func (c *Client) CreateVariableV1WithBody(ctx context.Context, projectId ID, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateVariableV1(ctx context.Context, projectId ID, body CreateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateVariableV1Request(c.Server, projectId, body)
+func (c *Client) CreateClientV1(ctx context.Context, environmentId ID, body CreateClientV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientV1Request(c.Server, environmentId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -878,8 +1899,8 @@ func (c *Client) CreateVariableV1(ctx context.Context, projectId ID, body Create
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetStatusV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetStatusV1Request(c.Server)
+func (c *Client) ExportEnvironmentV1(ctx context.Context, environmentId ID, params *ExportEnvironmentV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportEnvironmentV1Request(c.Server, environmentId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -890,8 +1911,8 @@ func (c *Client) GetStatusV1(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetStatusReadyV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetStatusReadyV1Request(c.Server)
+func (c *Client) ImportEnvironmentV1WithBody(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportEnvironmentV1RequestWithBody(c.Server, environmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -902,8 +1923,8 @@ func (c *Client) GetStatusReadyV1(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteVariableV1(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteVariableV1Request(c.Server, variableId)
+func (c *Client) ImportEnvironmentV1(ctx context.Context, environmentId ID, body ImportEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportEnvironmentV1Request(c.Server, environmentId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -914,8 +1935,8 @@ func (c *Client) DeleteVariableV1(ctx context.Context, variableId ID, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVariableV1(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVariableV1Request(c.Server, variableId)
+func (c *Client) GetEnvironmentSecretsV1(ctx context.Context, environmentId ID, params *GetEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentSecretsV1Request(c.Server, environmentId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -926,245 +1947,368 @@ func (c *Client) GetVariableV1(ctx context.Context, variableId ID, reqEditors ..
 	return c.Client.Do(req)
 }
 
-// NewGetV1ClientsSelfRequest generates requests for GetV1ClientsSelf
-func NewGetV1ClientsSelfRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RotateEnvironmentSecretsV1(ctx context.Context, environmentId ID, params *RotateEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateEnvironmentSecretsV1Request(c.Server, environmentId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/clients/_self")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WatchEnvironmentSecretsV1(ctx context.Context, environmentId ID, params *WatchEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWatchEnvironmentSecretsV1Request(c.Server, environmentId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetClientSecretsV1Request generates requests for GetClientSecretsV1
-func NewGetClientSecretsV1Request(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UnsetEnvironmentSecretV1(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnsetEnvironmentSecretV1Request(c.Server, environmentId, variableId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/clients/secrets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetEnvironmentSecretV1WithBody(ctx context.Context, environmentId ID, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetEnvironmentSecretV1RequestWithBody(c.Server, environmentId, variableId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteEnvironmentV1Request generates requests for DeleteEnvironmentV1
-func NewDeleteEnvironmentV1Request(server string, environmentId ID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+func (c *Client) SetEnvironmentSecretV1(ctx context.Context, environmentId ID, variableId ID, body SetEnvironmentSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetEnvironmentSecretV1Request(c.Server, environmentId, variableId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RollbackEnvironmentSecretV1WithBody(ctx context.Context, environmentId ID, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackEnvironmentSecretV1RequestWithBody(c.Server, environmentId, variableId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/environments/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RollbackEnvironmentSecretV1(ctx context.Context, environmentId ID, variableId ID, body RollbackEnvironmentSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackEnvironmentSecretV1Request(c.Server, environmentId, variableId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetEnvironmentV1Request generates requests for GetEnvironmentV1
-func NewGetEnvironmentV1Request(server string, environmentId ID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+func (c *Client) RotateEnvironmentSecretV1(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateEnvironmentSecretV1Request(c.Server, environmentId, variableId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetEnvironmentSecretVersionsV1(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentSecretVersionsV1Request(c.Server, environmentId, variableId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/environments/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ValidateEnvironmentV1(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateEnvironmentV1Request(c.Server, environmentId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetClientsV1Request generates requests for GetClientsV1
-func NewGetClientsV1Request(server string, environmentId ID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+func (c *Client) GetEnvironmentValuesV1(ctx context.Context, environmentId ID, params *GetEnvironmentValuesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentValuesV1Request(c.Server, environmentId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetProjectsV1(ctx context.Context, params *GetProjectsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/environments/%s/clients", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateProjectV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateClientV1Request calls the generic CreateClientV1 builder with application/json body
-func NewCreateClientV1Request(server string, environmentId ID, body CreateClientV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateProjectV1(ctx context.Context, body CreateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateClientV1RequestWithBody(server, environmentId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateClientV1RequestWithBody generates requests for CreateClientV1 with any type of body
-func NewCreateClientV1RequestWithBody(server string, environmentId ID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+func (c *Client) DeleteProjectV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectV1Request(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetProjectV1(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectV1Request(c.Server, projectId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/environments/%s/clients", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateProjectV1WithBody(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectV1RequestWithBody(c.Server, projectId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) UpdateProjectV1(ctx context.Context, projectId ID, body UpdateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectV1Request(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetEnvironmentsV1(ctx context.Context, projectId ID, params *GetEnvironmentsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentsV1Request(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetEnvironmentSecretsV1Request generates requests for GetEnvironmentSecretsV1
-func NewGetEnvironmentSecretsV1Request(server string, environmentId ID) (*http.Request, error) {
-	var err error
+func (c *Client) CreateEnvironmentV1WithBody(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnvironmentV1RequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) CreateEnvironmentV1(ctx context.Context, projectId ID, body CreateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnvironmentV1Request(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+func (c *Client) GetVariablesV1(ctx context.Context, projectId ID, params *GetVariablesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVariablesV1Request(c.Server, projectId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateVariableV1WithBody(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVariableV1RequestWithBody(c.Server, projectId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/environments/%s/secrets", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) CreateVariableV1(ctx context.Context, projectId ID, body CreateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVariableV1Request(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetStatusV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetStatusReadyV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusReadyV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DeleteVariableV1(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVariableV1Request(c.Server, variableId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetProjectsV1Request generates requests for GetProjectsV1
-func NewGetProjectsV1Request(server string) (*http.Request, error) {
+func (c *Client) GetVariableV1(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVariableV1Request(c.Server, variableId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateVariableV1WithBody(ctx context.Context, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateVariableV1RequestWithBody(c.Server, variableId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateVariableV1(ctx context.Context, variableId ID, body UpdateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateVariableV1Request(c.Server, variableId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAdminKeysV1Request generates requests for GetAdminKeysV1
+func NewGetAdminKeysV1Request(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1172,7 +2316,7 @@ func NewGetProjectsV1Request(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects")
+	operationPath := fmt.Sprintf("/v1/admin/keys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1190,19 +2334,19 @@ func NewGetProjectsV1Request(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateProjectV1Request calls the generic CreateProjectV1 builder with application/json body
-func NewCreateProjectV1Request(server string, body CreateProjectV1JSONRequestBody) (*http.Request, error) {
+// NewCreateAdminKeyV1Request calls the generic CreateAdminKeyV1 builder with application/json body
+func NewCreateAdminKeyV1Request(server string, body CreateAdminKeyV1JSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectV1RequestWithBody(server, "application/json", bodyReader)
+	return NewCreateAdminKeyV1RequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateProjectV1RequestWithBody generates requests for CreateProjectV1 with any type of body
-func NewCreateProjectV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAdminKeyV1RequestWithBody generates requests for CreateAdminKeyV1 with any type of body
+func NewCreateAdminKeyV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1210,7 +2354,7 @@ func NewCreateProjectV1RequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects")
+	operationPath := fmt.Sprintf("/v1/admin/keys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1230,13 +2374,13 @@ func NewCreateProjectV1RequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewDeleteProjectV1Request generates requests for DeleteProjectV1
-func NewDeleteProjectV1Request(server string, projectId ID) (*http.Request, error) {
+// NewRevokeAdminKeyV1Request generates requests for RevokeAdminKeyV1
+func NewRevokeAdminKeyV1Request(server string, keyId ID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key_id", runtime.ParamLocationPath, keyId)
 	if err != nil {
 		return nil, err
 	}
@@ -1246,7 +2390,7 @@ func NewDeleteProjectV1Request(server string, projectId ID) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/admin/keys/%s/revoke", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1256,7 +2400,7 @@ func NewDeleteProjectV1Request(server string, projectId ID) (*http.Request, erro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1264,23 +2408,16 @@ func NewDeleteProjectV1Request(server string, projectId ID) (*http.Request, erro
 	return req, nil
 }
 
-// NewGetProjectV1Request generates requests for GetProjectV1
-func NewGetProjectV1Request(server string, projectId ID) (*http.Request, error) {
+// NewGetAuditLogV1Request generates requests for GetAuditLogV1
+func NewGetAuditLogV1Request(server string, params *GetAuditLogV1Params) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/audit")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1290,6 +2427,124 @@ func NewGetProjectV1Request(server string, projectId ID) (*http.Request, error)
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EnvironmentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AfterId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after_id", runtime.ParamLocationQuery, *params.AfterId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1298,23 +2553,16 @@ func NewGetProjectV1Request(server string, projectId ID) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetEnvironmentsV1Request generates requests for GetEnvironmentsV1
-func NewGetEnvironmentsV1Request(server string, projectId ID) (*http.Request, error) {
+// NewGetV1ClientsSelfRequest generates requests for GetV1ClientsSelf
+func NewGetV1ClientsSelfRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/environments", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clients/_self")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1332,34 +2580,65 @@ func NewGetEnvironmentsV1Request(server string, projectId ID) (*http.Request, er
 	return req, nil
 }
 
-// NewCreateEnvironmentV1Request calls the generic CreateEnvironmentV1 builder with application/json body
-func NewCreateEnvironmentV1Request(server string, projectId ID, body CreateEnvironmentV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetClientSecretsV1Request generates requests for GetClientSecretsV1
+func NewGetClientSecretsV1Request(server string, params *GetClientSecretsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateEnvironmentV1RequestWithBody(server, projectId, "application/json", bodyReader)
-}
 
-// NewCreateEnvironmentV1RequestWithBody generates requests for CreateEnvironmentV1 with any type of body
-func NewCreateEnvironmentV1RequestWithBody(server string, projectId ID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	operationPath := fmt.Sprintf("/v1/clients/secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	var pathParam0 string
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewWatchClientSecretsV1Request generates requests for WatchClientSecretsV1
+func NewWatchClientSecretsV1Request(server string, params *WatchClientSecretsV1Params) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/environments", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clients/secrets/watch")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1369,23 +2648,43 @@ func NewCreateEnvironmentV1RequestWithBody(server string, projectId ID, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LastEventId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "last_event_id", runtime.ParamLocationQuery, *params.LastEventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetVariablesV1Request generates requests for GetVariablesV1
-func NewGetVariablesV1Request(server string, projectId ID) (*http.Request, error) {
+// NewGetClientSecretsListV1Request generates requests for GetClientSecretsListV1
+func NewGetClientSecretsListV1Request(server string, clientId ID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
 	if err != nil {
 		return nil, err
 	}
@@ -1395,7 +2694,7 @@ func NewGetVariablesV1Request(server string, projectId ID) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/variables", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clients/%s/secrets", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1413,24 +2712,24 @@ func NewGetVariablesV1Request(server string, projectId ID) (*http.Request, error
 	return req, nil
 }
 
-// NewCreateVariableV1Request calls the generic CreateVariableV1 builder with application/json body
-func NewCreateVariableV1Request(server string, projectId ID, body CreateVariableV1JSONRequestBody) (*http.Request, error) {
+// NewCreateClientSecretV1Request calls the generic CreateClientSecretV1 builder with application/json body
+func NewCreateClientSecretV1Request(server string, clientId ID, body CreateClientSecretV1JSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateVariableV1RequestWithBody(server, projectId, "application/json", bodyReader)
+	return NewCreateClientSecretV1RequestWithBody(server, clientId, "application/json", bodyReader)
 }
 
-// NewCreateVariableV1RequestWithBody generates requests for CreateVariableV1 with any type of body
-func NewCreateVariableV1RequestWithBody(server string, projectId ID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateClientSecretV1RequestWithBody generates requests for CreateClientSecretV1 with any type of body
+func NewCreateClientSecretV1RequestWithBody(server string, clientId ID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
 	if err != nil {
 		return nil, err
 	}
@@ -1440,7 +2739,7 @@ func NewCreateVariableV1RequestWithBody(server string, projectId ID, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/variables", pathParam0)
+	operationPath := fmt.Sprintf("/v1/clients/%s/secrets", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1460,16 +2759,30 @@ func NewCreateVariableV1RequestWithBody(server string, projectId ID, contentType
 	return req, nil
 }
 
-// NewGetStatusV1Request generates requests for GetStatusV1
-func NewGetStatusV1Request(server string) (*http.Request, error) {
+// NewRevokeClientSecretV1Request generates requests for RevokeClientSecretV1
+func NewRevokeClientSecretV1Request(server string, clientId ID, secretId ID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "secret_id", runtime.ParamLocationPath, secretId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/status")
+	operationPath := fmt.Sprintf("/v1/clients/%s/secrets/%s/revoke", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1479,7 +2792,7 @@ func NewGetStatusV1Request(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1487,16 +2800,23 @@ func NewGetStatusV1Request(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetStatusReadyV1Request generates requests for GetStatusReadyV1
-func NewGetStatusReadyV1Request(server string) (*http.Request, error) {
+// NewDeleteEnvironmentV1Request generates requests for DeleteEnvironmentV1
+func NewDeleteEnvironmentV1Request(server string, environmentId ID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/status/ready")
+	operationPath := fmt.Sprintf("/v1/environments/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1506,7 +2826,7 @@ func NewGetStatusReadyV1Request(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1514,13 +2834,13 @@ func NewGetStatusReadyV1Request(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeleteVariableV1Request generates requests for DeleteVariableV1
-func NewDeleteVariableV1Request(server string, variableId ID) (*http.Request, error) {
+// NewGetEnvironmentV1Request generates requests for GetEnvironmentV1
+func NewGetEnvironmentV1Request(server string, environmentId ID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -1530,7 +2850,7 @@ func NewDeleteVariableV1Request(server string, variableId ID) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/variables/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/environments/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1540,7 +2860,7 @@ func NewDeleteVariableV1Request(server string, variableId ID) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1548,13 +2868,24 @@ func NewDeleteVariableV1Request(server string, variableId ID) (*http.Request, er
 	return req, nil
 }
 
-// NewGetVariableV1Request generates requests for GetVariableV1
-func NewGetVariableV1Request(server string, variableId ID) (*http.Request, error) {
+// NewUpdateEnvironmentV1Request calls the generic UpdateEnvironmentV1 builder with application/json body
+func NewUpdateEnvironmentV1Request(server string, environmentId ID, body UpdateEnvironmentV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEnvironmentV1RequestWithBody(server, environmentId, "application/json", bodyReader)
+}
+
+// NewUpdateEnvironmentV1RequestWithBody generates requests for UpdateEnvironmentV1 with any type of body
+func NewUpdateEnvironmentV1RequestWithBody(server string, environmentId ID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -1564,7 +2895,7 @@ func NewGetVariableV1Request(server string, variableId ID) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/variables/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/environments/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1574,827 +2905,4836 @@ func NewGetVariableV1Request(server string, variableId ID) (*http.Request, error
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewGetClientsV1Request generates requests for GetClientsV1
+func NewGetClientsV1Request(server string, environmentId ID, params *GetClientsV1Params) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetV1ClientsSelfWithResponse request
-	GetV1ClientsSelfWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ClientsSelfResponse, error)
+	operationPath := fmt.Sprintf("/v1/environments/%s/clients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetClientSecretsV1WithResponse request
-	GetClientSecretsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientSecretsV1Response, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteEnvironmentV1WithResponse request
-	DeleteEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*DeleteEnvironmentV1Response, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetEnvironmentV1WithResponse request
-	GetEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentV1Response, error)
+		if params.Limit != nil {
 
-	// GetClientsV1WithResponse request
-	GetClientsV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*GetClientsV1Response, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateClientV1WithBodyWithResponse request with any body
-	CreateClientV1WithBodyWithResponse(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientV1Response, error)
+		}
 
-	CreateClientV1WithResponse(ctx context.Context, environmentId ID, body CreateClientV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientV1Response, error)
+		if params.Offset != nil {
 
-	// GetEnvironmentSecretsV1WithResponse request
-	GetEnvironmentSecretsV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretsV1Response, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetProjectsV1WithResponse request
-	GetProjectsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProjectsV1Response, error)
+		}
 
-	// CreateProjectV1WithBodyWithResponse request with any body
-	CreateProjectV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectV1Response, error)
+		if params.Filter != nil {
 
-	CreateProjectV1WithResponse(ctx context.Context, body CreateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectV1Response, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// DeleteProjectV1WithResponse request
-	DeleteProjectV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*DeleteProjectV1Response, error)
+		}
 
-	// GetProjectV1WithResponse request
-	GetProjectV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*GetProjectV1Response, error)
+		if params.Sort != nil {
 
-	// GetEnvironmentsV1WithResponse request
-	GetEnvironmentsV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentsV1Response, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateEnvironmentV1WithBodyWithResponse request with any body
-	CreateEnvironmentV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentV1Response, error)
+		}
 
-	CreateEnvironmentV1WithResponse(ctx context.Context, projectId ID, body CreateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentV1Response, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetVariablesV1WithResponse request
-	GetVariablesV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*GetVariablesV1Response, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateVariableV1WithBodyWithResponse request with any body
-	CreateVariableV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVariableV1Response, error)
+	return req, nil
+}
 
-	CreateVariableV1WithResponse(ctx context.Context, projectId ID, body CreateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVariableV1Response, error)
+// NewCreateClientV1Request calls the generic CreateClientV1 builder with application/json body
+func NewCreateClientV1Request(server string, environmentId ID, body CreateClientV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClientV1RequestWithBody(server, environmentId, "application/json", bodyReader)
+}
 
-	// GetStatusV1WithResponse request
-	GetStatusV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusV1Response, error)
+// NewCreateClientV1RequestWithBody generates requests for CreateClientV1 with any type of body
+func NewCreateClientV1RequestWithBody(server string, environmentId ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetStatusReadyV1WithResponse request
-	GetStatusReadyV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusReadyV1Response, error)
+	var pathParam0 string
 
-	// DeleteVariableV1WithResponse request
-	DeleteVariableV1WithResponse(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*DeleteVariableV1Response, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetVariableV1WithResponse request
-	GetVariableV1WithResponse(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*GetVariableV1Response, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type GetV1ClientsSelfResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClientObject
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/v1/environments/%s/clients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetV1ClientsSelfResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1ClientsSelfResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetClientSecretsV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Secrets
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetClientSecretsV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClientSecretsV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewExportEnvironmentV1Request generates requests for ExportEnvironmentV1
+func NewExportEnvironmentV1Request(server string, environmentId ID, params *ExportEnvironmentV1Params) (*http.Request, error) {
+	var err error
 
-type DeleteEnvironmentV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteEnvironmentV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEnvironmentV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetEnvironmentV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *EnvironmentObject
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r GetEnvironmentV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v1/environments/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetClientsV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ClientObject
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetClientsV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClientsV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateClientV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreateClientResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateClientV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewImportEnvironmentV1Request calls the generic ImportEnvironmentV1 builder with application/json body
+func NewImportEnvironmentV1Request(server string, environmentId ID, body ImportEnvironmentV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewImportEnvironmentV1RequestWithBody(server, environmentId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateClientV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewImportEnvironmentV1RequestWithBody generates requests for ImportEnvironmentV1 with any type of body
+func NewImportEnvironmentV1RequestWithBody(server string, environmentId ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetEnvironmentSecretsV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Secrets
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetEnvironmentSecretsV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentSecretsV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetProjectsV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Projects
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/v1/environments/%s/import", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetProjectsV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectsV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateProjectV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IDResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r CreateProjectV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetEnvironmentSecretsV1Request generates requests for GetEnvironmentSecretsV1
+func NewGetEnvironmentSecretsV1Request(server string, environmentId ID, params *GetEnvironmentSecretsV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteProjectV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v1/environments/%s/secrets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetProjectV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectObject
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetProjectV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetEnvironmentsV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Environments
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetEnvironmentsV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentsV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewRotateEnvironmentSecretsV1Request generates requests for RotateEnvironmentSecretsV1
+func NewRotateEnvironmentSecretsV1Request(server string, environmentId ID, params *RotateEnvironmentSecretsV1Params) (*http.Request, error) {
+	var err error
 
-type CreateEnvironmentV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IDResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r CreateEnvironmentV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateEnvironmentV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetVariablesV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Variables
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r GetVariablesV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v1/environments/%s/secrets/rotate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVariablesV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateVariableV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IDResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r CreateVariableV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Generator != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateVariableV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator", runtime.ParamLocationQuery, *params.Generator); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetStatusV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Status
-	JSON500      *InternalServerError
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetStatusV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetStatusV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetStatusReadyV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Ready
-	JSON503      *ServiceUnavailable
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetStatusReadyV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewWatchEnvironmentSecretsV1Request generates requests for WatchEnvironmentSecretsV1
+func NewWatchEnvironmentSecretsV1Request(server string, environmentId ID, params *WatchEnvironmentSecretsV1Params) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetStatusReadyV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteVariableV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteVariableV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v1/environments/%s/secrets/watch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteVariableV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetVariableV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VariableObject
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetVariableV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.LastEventId != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVariableV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "last_event_id", runtime.ParamLocationQuery, *params.LastEventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-// GetV1ClientsSelfWithResponse request returning *GetV1ClientsSelfResponse
-func (c *ClientWithResponses) GetV1ClientsSelfWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ClientsSelfResponse, error) {
-	rsp, err := c.GetV1ClientsSelf(ctx, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetV1ClientsSelfResponse(rsp)
+
+	return req, nil
 }
 
-// GetClientSecretsV1WithResponse request returning *GetClientSecretsV1Response
-func (c *ClientWithResponses) GetClientSecretsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientSecretsV1Response, error) {
-	rsp, err := c.GetClientSecretsV1(ctx, reqEditors...)
+// NewUnsetEnvironmentSecretV1Request generates requests for UnsetEnvironmentSecretV1
+func NewUnsetEnvironmentSecretV1Request(server string, environmentId ID, variableId ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClientSecretsV1Response(rsp)
-}
 
-// DeleteEnvironmentV1WithResponse request returning *DeleteEnvironmentV1Response
-func (c *ClientWithResponses) DeleteEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*DeleteEnvironmentV1Response, error) {
-	rsp, err := c.DeleteEnvironmentV1(ctx, environmentId, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteEnvironmentV1Response(rsp)
-}
 
-// GetEnvironmentV1WithResponse request returning *GetEnvironmentV1Response
-func (c *ClientWithResponses) GetEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentV1Response, error) {
-	rsp, err := c.GetEnvironmentV1(ctx, environmentId, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEnvironmentV1Response(rsp)
-}
 
-// GetClientsV1WithResponse request returning *GetClientsV1Response
-func (c *ClientWithResponses) GetClientsV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*GetClientsV1Response, error) {
-	rsp, err := c.GetClientsV1(ctx, environmentId, reqEditors...)
+	operationPath := fmt.Sprintf("/v1/environments/%s/secrets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClientsV1Response(rsp)
-}
 
-// CreateClientV1WithBodyWithResponse request with arbitrary body returning *CreateClientV1Response
-func (c *ClientWithResponses) CreateClientV1WithBodyWithResponse(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientV1Response, error) {
-	rsp, err := c.CreateClientV1WithBody(ctx, environmentId, contentType, body, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateClientV1Response(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateClientV1WithResponse(ctx context.Context, environmentId ID, body CreateClientV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientV1Response, error) {
-	rsp, err := c.CreateClientV1(ctx, environmentId, body, reqEditors...)
+// NewSetEnvironmentSecretV1Request calls the generic SetEnvironmentSecretV1 builder with application/json body
+func NewSetEnvironmentSecretV1Request(server string, environmentId ID, variableId ID, body SetEnvironmentSecretV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateClientV1Response(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewSetEnvironmentSecretV1RequestWithBody(server, environmentId, variableId, "application/json", bodyReader)
 }
 
-// GetEnvironmentSecretsV1WithResponse request returning *GetEnvironmentSecretsV1Response
-func (c *ClientWithResponses) GetEnvironmentSecretsV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretsV1Response, error) {
-	rsp, err := c.GetEnvironmentSecretsV1(ctx, environmentId, reqEditors...)
+// NewSetEnvironmentSecretV1RequestWithBody generates requests for SetEnvironmentSecretV1 with any type of body
+func NewSetEnvironmentSecretV1RequestWithBody(server string, environmentId ID, variableId ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEnvironmentSecretsV1Response(rsp)
-}
 
-// GetProjectsV1WithResponse request returning *GetProjectsV1Response
-func (c *ClientWithResponses) GetProjectsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProjectsV1Response, error) {
-	rsp, err := c.GetProjectsV1(ctx, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProjectsV1Response(rsp)
-}
 
-// CreateProjectV1WithBodyWithResponse request with arbitrary body returning *CreateProjectV1Response
-func (c *ClientWithResponses) CreateProjectV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectV1Response, error) {
-	rsp, err := c.CreateProjectV1WithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectV1Response(rsp)
-}
 
-func (c *ClientWithResponses) CreateProjectV1WithResponse(ctx context.Context, body CreateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectV1Response, error) {
-	rsp, err := c.CreateProjectV1(ctx, body, reqEditors...)
+	operationPath := fmt.Sprintf("/v1/environments/%s/secrets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectV1Response(rsp)
-}
 
-// DeleteProjectV1WithResponse request returning *DeleteProjectV1Response
-func (c *ClientWithResponses) DeleteProjectV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*DeleteProjectV1Response, error) {
-	rsp, err := c.DeleteProjectV1(ctx, projectId, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectV1Response(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetProjectV1WithResponse request returning *GetProjectV1Response
-func (c *ClientWithResponses) GetProjectV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*GetProjectV1Response, error) {
-	rsp, err := c.GetProjectV1(ctx, projectId, reqEditors...)
+// NewRollbackEnvironmentSecretV1Request calls the generic RollbackEnvironmentSecretV1 builder with application/json body
+func NewRollbackEnvironmentSecretV1Request(server string, environmentId ID, variableId ID, body RollbackEnvironmentSecretV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProjectV1Response(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewRollbackEnvironmentSecretV1RequestWithBody(server, environmentId, variableId, "application/json", bodyReader)
 }
 
-// GetEnvironmentsV1WithResponse request returning *GetEnvironmentsV1Response
-func (c *ClientWithResponses) GetEnvironmentsV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentsV1Response, error) {
-	rsp, err := c.GetEnvironmentsV1(ctx, projectId, reqEditors...)
+// NewRollbackEnvironmentSecretV1RequestWithBody generates requests for RollbackEnvironmentSecretV1 with any type of body
+func NewRollbackEnvironmentSecretV1RequestWithBody(server string, environmentId ID, variableId ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEnvironmentsV1Response(rsp)
-}
 
-// CreateEnvironmentV1WithBodyWithResponse request with arbitrary body returning *CreateEnvironmentV1Response
-func (c *ClientWithResponses) CreateEnvironmentV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentV1Response, error) {
-	rsp, err := c.CreateEnvironmentV1WithBody(ctx, projectId, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateEnvironmentV1Response(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/environments/%s/secrets/%s/rollback", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateEnvironmentV1WithResponse(ctx context.Context, projectId ID, body CreateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentV1Response, error) {
-	rsp, err := c.CreateEnvironmentV1(ctx, projectId, body, reqEditors...)
+// NewRotateEnvironmentSecretV1Request generates requests for RotateEnvironmentSecretV1
+func NewRotateEnvironmentSecretV1Request(server string, environmentId ID, variableId ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateEnvironmentV1Response(rsp)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/environments/%s/secrets/%s/rotate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// GetVariablesV1WithResponse request returning *GetVariablesV1Response
-func (c *ClientWithResponses) GetVariablesV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*GetVariablesV1Response, error) {
-	rsp, err := c.GetVariablesV1(ctx, projectId, reqEditors...)
+// NewGetEnvironmentSecretVersionsV1Request generates requests for GetEnvironmentSecretVersionsV1
+func NewGetEnvironmentSecretVersionsV1Request(server string, environmentId ID, variableId ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVariablesV1Response(rsp)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/environments/%s/secrets/%s/versions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// CreateVariableV1WithBodyWithResponse request with arbitrary body returning *CreateVariableV1Response
-func (c *ClientWithResponses) CreateVariableV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVariableV1Response, error) {
-	rsp, err := c.CreateVariableV1WithBody(ctx, projectId, contentType, body, reqEditors...)
+// NewValidateEnvironmentV1Request generates requests for ValidateEnvironmentV1
+func NewValidateEnvironmentV1Request(server string, environmentId ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateVariableV1Response(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/environments/%s/validate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateVariableV1WithResponse(ctx context.Context, projectId ID, body CreateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVariableV1Response, error) {
-	rsp, err := c.CreateVariableV1(ctx, projectId, body, reqEditors...)
+// NewGetEnvironmentValuesV1Request generates requests for GetEnvironmentValuesV1
+func NewGetEnvironmentValuesV1Request(server string, environmentId ID, params *GetEnvironmentValuesV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environment_id", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateVariableV1Response(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/environments/%s/values", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Reveal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reveal", runtime.ParamLocationQuery, *params.Reveal); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// GetStatusV1WithResponse request returning *GetStatusV1Response
-func (c *ClientWithResponses) GetStatusV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusV1Response, error) {
-	rsp, err := c.GetStatusV1(ctx, reqEditors...)
+// NewGetProjectsV1Request generates requests for GetProjectsV1
+func NewGetProjectsV1Request(server string, params *GetProjectsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetStatusV1Response(rsp)
+
+	operationPath := fmt.Sprintf("/v1/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// GetStatusReadyV1WithResponse request returning *GetStatusReadyV1Response
-func (c *ClientWithResponses) GetStatusReadyV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusReadyV1Response, error) {
-	rsp, err := c.GetStatusReadyV1(ctx, reqEditors...)
+// NewCreateProjectV1Request calls the generic CreateProjectV1 builder with application/json body
+func NewCreateProjectV1Request(server string, body CreateProjectV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetStatusReadyV1Response(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateProjectV1RequestWithBody generates requests for CreateProjectV1 with any type of body
+func NewCreateProjectV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectV1Request generates requests for DeleteProjectV1
+func NewDeleteProjectV1Request(server string, projectId ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectV1Request generates requests for GetProjectV1
+func NewGetProjectV1Request(server string, projectId ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectV1Request calls the generic UpdateProjectV1 builder with application/json body
+func NewUpdateProjectV1Request(server string, projectId ID, body UpdateProjectV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectV1RequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectV1RequestWithBody generates requests for UpdateProjectV1 with any type of body
+func NewUpdateProjectV1RequestWithBody(server string, projectId ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEnvironmentsV1Request generates requests for GetEnvironmentsV1
+func NewGetEnvironmentsV1Request(server string, projectId ID, params *GetEnvironmentsV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/environments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateEnvironmentV1Request calls the generic CreateEnvironmentV1 builder with application/json body
+func NewCreateEnvironmentV1Request(server string, projectId ID, body CreateEnvironmentV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateEnvironmentV1RequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewCreateEnvironmentV1RequestWithBody generates requests for CreateEnvironmentV1 with any type of body
+func NewCreateEnvironmentV1RequestWithBody(server string, projectId ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/environments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetVariablesV1Request generates requests for GetVariablesV1
+func NewGetVariablesV1Request(server string, projectId ID, params *GetVariablesV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/variables", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateVariableV1Request calls the generic CreateVariableV1 builder with application/json body
+func NewCreateVariableV1Request(server string, projectId ID, body CreateVariableV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVariableV1RequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewCreateVariableV1RequestWithBody generates requests for CreateVariableV1 with any type of body
+func NewCreateVariableV1RequestWithBody(server string, projectId ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/variables", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStatusV1Request generates requests for GetStatusV1
+func NewGetStatusV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatusReadyV1Request generates requests for GetStatusReadyV1
+func NewGetStatusReadyV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/status/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteVariableV1Request generates requests for DeleteVariableV1
+func NewDeleteVariableV1Request(server string, variableId ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/variables/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVariableV1Request generates requests for GetVariableV1
+func NewGetVariableV1Request(server string, variableId ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/variables/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateVariableV1Request calls the generic UpdateVariableV1 builder with application/json body
+func NewUpdateVariableV1Request(server string, variableId ID, body UpdateVariableV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateVariableV1RequestWithBody(server, variableId, "application/json", bodyReader)
+}
+
+// NewUpdateVariableV1RequestWithBody generates requests for UpdateVariableV1 with any type of body
+func NewUpdateVariableV1RequestWithBody(server string, variableId ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "variable_id", runtime.ParamLocationPath, variableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/variables/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAdminKeysV1WithResponse request
+	GetAdminKeysV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminKeysV1Response, error)
+
+	// CreateAdminKeyV1WithBodyWithResponse request with any body
+	CreateAdminKeyV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAdminKeyV1Response, error)
+
+	CreateAdminKeyV1WithResponse(ctx context.Context, body CreateAdminKeyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAdminKeyV1Response, error)
+
+	// RevokeAdminKeyV1WithResponse request
+	RevokeAdminKeyV1WithResponse(ctx context.Context, keyId ID, reqEditors ...RequestEditorFn) (*RevokeAdminKeyV1Response, error)
+
+	// GetAuditLogV1WithResponse request
+	GetAuditLogV1WithResponse(ctx context.Context, params *GetAuditLogV1Params, reqEditors ...RequestEditorFn) (*GetAuditLogV1Response, error)
+
+	// GetV1ClientsSelfWithResponse request
+	GetV1ClientsSelfWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ClientsSelfResponse, error)
+
+	// GetClientSecretsV1WithResponse request
+	GetClientSecretsV1WithResponse(ctx context.Context, params *GetClientSecretsV1Params, reqEditors ...RequestEditorFn) (*GetClientSecretsV1Response, error)
+
+	// WatchClientSecretsV1WithResponse request
+	WatchClientSecretsV1WithResponse(ctx context.Context, params *WatchClientSecretsV1Params, reqEditors ...RequestEditorFn) (*WatchClientSecretsV1Response, error)
+
+	// GetClientSecretsListV1WithResponse request
+	GetClientSecretsListV1WithResponse(ctx context.Context, clientId ID, reqEditors ...RequestEditorFn) (*GetClientSecretsListV1Response, error)
+
+	// CreateClientSecretV1WithBodyWithResponse request with any body
+	CreateClientSecretV1WithBodyWithResponse(ctx context.Context, clientId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientSecretV1Response, error)
+
+	CreateClientSecretV1WithResponse(ctx context.Context, clientId ID, body CreateClientSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientSecretV1Response, error)
+
+	// RevokeClientSecretV1WithResponse request
+	RevokeClientSecretV1WithResponse(ctx context.Context, clientId ID, secretId ID, reqEditors ...RequestEditorFn) (*RevokeClientSecretV1Response, error)
+
+	// DeleteEnvironmentV1WithResponse request
+	DeleteEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*DeleteEnvironmentV1Response, error)
+
+	// GetEnvironmentV1WithResponse request
+	GetEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentV1Response, error)
+
+	// UpdateEnvironmentV1WithBodyWithResponse request with any body
+	UpdateEnvironmentV1WithBodyWithResponse(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvironmentV1Response, error)
+
+	UpdateEnvironmentV1WithResponse(ctx context.Context, environmentId ID, body UpdateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvironmentV1Response, error)
+
+	// GetClientsV1WithResponse request
+	GetClientsV1WithResponse(ctx context.Context, environmentId ID, params *GetClientsV1Params, reqEditors ...RequestEditorFn) (*GetClientsV1Response, error)
+
+	// CreateClientV1WithBodyWithResponse request with any body
+	CreateClientV1WithBodyWithResponse(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientV1Response, error)
+
+	CreateClientV1WithResponse(ctx context.Context, environmentId ID, body CreateClientV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientV1Response, error)
+
+	// ExportEnvironmentV1WithResponse request
+	ExportEnvironmentV1WithResponse(ctx context.Context, environmentId ID, params *ExportEnvironmentV1Params, reqEditors ...RequestEditorFn) (*ExportEnvironmentV1Response, error)
+
+	// ImportEnvironmentV1WithBodyWithResponse request with any body
+	ImportEnvironmentV1WithBodyWithResponse(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportEnvironmentV1Response, error)
+
+	ImportEnvironmentV1WithResponse(ctx context.Context, environmentId ID, body ImportEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ImportEnvironmentV1Response, error)
+
+	// GetEnvironmentSecretsV1WithResponse request
+	GetEnvironmentSecretsV1WithResponse(ctx context.Context, environmentId ID, params *GetEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretsV1Response, error)
+
+	// RotateEnvironmentSecretsV1WithResponse request
+	RotateEnvironmentSecretsV1WithResponse(ctx context.Context, environmentId ID, params *RotateEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*RotateEnvironmentSecretsV1Response, error)
+
+	// WatchEnvironmentSecretsV1WithResponse request
+	WatchEnvironmentSecretsV1WithResponse(ctx context.Context, environmentId ID, params *WatchEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*WatchEnvironmentSecretsV1Response, error)
+
+	// UnsetEnvironmentSecretV1WithResponse request
+	UnsetEnvironmentSecretV1WithResponse(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*UnsetEnvironmentSecretV1Response, error)
+
+	// SetEnvironmentSecretV1WithBodyWithResponse request with any body
+	SetEnvironmentSecretV1WithBodyWithResponse(ctx context.Context, environmentId ID, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetEnvironmentSecretV1Response, error)
+
+	SetEnvironmentSecretV1WithResponse(ctx context.Context, environmentId ID, variableId ID, body SetEnvironmentSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SetEnvironmentSecretV1Response, error)
+
+	// RollbackEnvironmentSecretV1WithBodyWithResponse request with any body
+	RollbackEnvironmentSecretV1WithBodyWithResponse(ctx context.Context, environmentId ID, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RollbackEnvironmentSecretV1Response, error)
+
+	RollbackEnvironmentSecretV1WithResponse(ctx context.Context, environmentId ID, variableId ID, body RollbackEnvironmentSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RollbackEnvironmentSecretV1Response, error)
+
+	// RotateEnvironmentSecretV1WithResponse request
+	RotateEnvironmentSecretV1WithResponse(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*RotateEnvironmentSecretV1Response, error)
+
+	// GetEnvironmentSecretVersionsV1WithResponse request
+	GetEnvironmentSecretVersionsV1WithResponse(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretVersionsV1Response, error)
+
+	// ValidateEnvironmentV1WithResponse request
+	ValidateEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*ValidateEnvironmentV1Response, error)
+
+	// GetEnvironmentValuesV1WithResponse request
+	GetEnvironmentValuesV1WithResponse(ctx context.Context, environmentId ID, params *GetEnvironmentValuesV1Params, reqEditors ...RequestEditorFn) (*GetEnvironmentValuesV1Response, error)
+
+	// GetProjectsV1WithResponse request
+	GetProjectsV1WithResponse(ctx context.Context, params *GetProjectsV1Params, reqEditors ...RequestEditorFn) (*GetProjectsV1Response, error)
+
+	// CreateProjectV1WithBodyWithResponse request with any body
+	CreateProjectV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectV1Response, error)
+
+	CreateProjectV1WithResponse(ctx context.Context, body CreateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectV1Response, error)
+
+	// DeleteProjectV1WithResponse request
+	DeleteProjectV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*DeleteProjectV1Response, error)
+
+	// GetProjectV1WithResponse request
+	GetProjectV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*GetProjectV1Response, error)
+
+	// UpdateProjectV1WithBodyWithResponse request with any body
+	UpdateProjectV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectV1Response, error)
+
+	UpdateProjectV1WithResponse(ctx context.Context, projectId ID, body UpdateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectV1Response, error)
+
+	// GetEnvironmentsV1WithResponse request
+	GetEnvironmentsV1WithResponse(ctx context.Context, projectId ID, params *GetEnvironmentsV1Params, reqEditors ...RequestEditorFn) (*GetEnvironmentsV1Response, error)
+
+	// CreateEnvironmentV1WithBodyWithResponse request with any body
+	CreateEnvironmentV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentV1Response, error)
+
+	CreateEnvironmentV1WithResponse(ctx context.Context, projectId ID, body CreateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentV1Response, error)
+
+	// GetVariablesV1WithResponse request
+	GetVariablesV1WithResponse(ctx context.Context, projectId ID, params *GetVariablesV1Params, reqEditors ...RequestEditorFn) (*GetVariablesV1Response, error)
+
+	// CreateVariableV1WithBodyWithResponse request with any body
+	CreateVariableV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVariableV1Response, error)
+
+	CreateVariableV1WithResponse(ctx context.Context, projectId ID, body CreateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVariableV1Response, error)
+
+	// GetStatusV1WithResponse request
+	GetStatusV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusV1Response, error)
+
+	// GetStatusReadyV1WithResponse request
+	GetStatusReadyV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusReadyV1Response, error)
+
+	// DeleteVariableV1WithResponse request
+	DeleteVariableV1WithResponse(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*DeleteVariableV1Response, error)
+
+	// GetVariableV1WithResponse request
+	GetVariableV1WithResponse(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*GetVariableV1Response, error)
+
+	// UpdateVariableV1WithBodyWithResponse request with any body
+	UpdateVariableV1WithBodyWithResponse(ctx context.Context, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateVariableV1Response, error)
+
+	UpdateVariableV1WithResponse(ctx context.Context, variableId ID, body UpdateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVariableV1Response, error)
+}
+
+type GetAdminKeysV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminKeys
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminKeysV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminKeysV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAdminKeyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateAdminKeyResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAdminKeyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAdminKeyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeAdminKeyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminKeyObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeAdminKeyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeAdminKeyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditLogV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditEvents
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditLogV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditLogV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ClientsSelfResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientSelfObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ClientsSelfResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ClientsSelfResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientSecretsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Secrets
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientSecretsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientSecretsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WatchClientSecretsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r WatchClientSecretsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WatchClientSecretsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientSecretsListV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientSecrets
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientSecretsListV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientSecretsListV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClientSecretV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateClientSecretResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClientSecretV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClientSecretV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeClientSecretV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientSecretObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeClientSecretV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeClientSecretV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEnvironmentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEnvironmentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEnvironmentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateEnvironmentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateEnvironmentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateEnvironmentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClientObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClientV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateClientResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClientV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClientV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportEnvironmentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportEnvironmentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportEnvironmentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportEnvironmentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResults
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportEnvironmentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportEnvironmentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentSecretsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Secrets
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentSecretsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentSecretsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateEnvironmentSecretsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Secrets
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateEnvironmentSecretsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateEnvironmentSecretsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WatchEnvironmentSecretsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r WatchEnvironmentSecretsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WatchEnvironmentSecretsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnsetEnvironmentSecretV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UnsetEnvironmentSecretV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnsetEnvironmentSecretV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetEnvironmentSecretV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SetEnvironmentSecretV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetEnvironmentSecretV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RollbackEnvironmentSecretV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RollbackEnvironmentSecretV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RollbackEnvironmentSecretV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateEnvironmentSecretV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateEnvironmentSecretV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateEnvironmentSecretV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentSecretVersionsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretVersions
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentSecretVersionsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentSecretVersionsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateEnvironmentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidationObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateEnvironmentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateEnvironmentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentValuesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Values
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentValuesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentValuesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Projects
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IDResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProjectV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Environments
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEnvironmentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IDResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEnvironmentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEnvironmentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVariablesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Variables
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVariablesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVariablesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVariableV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IDResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVariableV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVariableV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Status
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusReadyV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Ready
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusReadyV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusReadyV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteVariableV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteVariableV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVariableV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVariableV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VariableObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVariableV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVariableV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateVariableV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VariableObject
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateVariableV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateVariableV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAdminKeysV1WithResponse request returning *GetAdminKeysV1Response
+func (c *ClientWithResponses) GetAdminKeysV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminKeysV1Response, error) {
+	rsp, err := c.GetAdminKeysV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminKeysV1Response(rsp)
+}
+
+// CreateAdminKeyV1WithBodyWithResponse request with arbitrary body returning *CreateAdminKeyV1Response
+func (c *ClientWithResponses) CreateAdminKeyV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAdminKeyV1Response, error) {
+	rsp, err := c.CreateAdminKeyV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAdminKeyV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateAdminKeyV1WithResponse(ctx context.Context, body CreateAdminKeyV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAdminKeyV1Response, error) {
+	rsp, err := c.CreateAdminKeyV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAdminKeyV1Response(rsp)
+}
+
+// RevokeAdminKeyV1WithResponse request returning *RevokeAdminKeyV1Response
+func (c *ClientWithResponses) RevokeAdminKeyV1WithResponse(ctx context.Context, keyId ID, reqEditors ...RequestEditorFn) (*RevokeAdminKeyV1Response, error) {
+	rsp, err := c.RevokeAdminKeyV1(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeAdminKeyV1Response(rsp)
+}
+
+// GetAuditLogV1WithResponse request returning *GetAuditLogV1Response
+func (c *ClientWithResponses) GetAuditLogV1WithResponse(ctx context.Context, params *GetAuditLogV1Params, reqEditors ...RequestEditorFn) (*GetAuditLogV1Response, error) {
+	rsp, err := c.GetAuditLogV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditLogV1Response(rsp)
+}
+
+// GetV1ClientsSelfWithResponse request returning *GetV1ClientsSelfResponse
+func (c *ClientWithResponses) GetV1ClientsSelfWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ClientsSelfResponse, error) {
+	rsp, err := c.GetV1ClientsSelf(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ClientsSelfResponse(rsp)
+}
+
+// GetClientSecretsV1WithResponse request returning *GetClientSecretsV1Response
+func (c *ClientWithResponses) GetClientSecretsV1WithResponse(ctx context.Context, params *GetClientSecretsV1Params, reqEditors ...RequestEditorFn) (*GetClientSecretsV1Response, error) {
+	rsp, err := c.GetClientSecretsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientSecretsV1Response(rsp)
+}
+
+// WatchClientSecretsV1WithResponse request returning *WatchClientSecretsV1Response
+func (c *ClientWithResponses) WatchClientSecretsV1WithResponse(ctx context.Context, params *WatchClientSecretsV1Params, reqEditors ...RequestEditorFn) (*WatchClientSecretsV1Response, error) {
+	rsp, err := c.WatchClientSecretsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWatchClientSecretsV1Response(rsp)
+}
+
+// GetClientSecretsListV1WithResponse request returning *GetClientSecretsListV1Response
+func (c *ClientWithResponses) GetClientSecretsListV1WithResponse(ctx context.Context, clientId ID, reqEditors ...RequestEditorFn) (*GetClientSecretsListV1Response, error) {
+	rsp, err := c.GetClientSecretsListV1(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientSecretsListV1Response(rsp)
+}
+
+// CreateClientSecretV1WithBodyWithResponse request with arbitrary body returning *CreateClientSecretV1Response
+func (c *ClientWithResponses) CreateClientSecretV1WithBodyWithResponse(ctx context.Context, clientId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientSecretV1Response, error) {
+	rsp, err := c.CreateClientSecretV1WithBody(ctx, clientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientSecretV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateClientSecretV1WithResponse(ctx context.Context, clientId ID, body CreateClientSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientSecretV1Response, error) {
+	rsp, err := c.CreateClientSecretV1(ctx, clientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientSecretV1Response(rsp)
+}
+
+// RevokeClientSecretV1WithResponse request returning *RevokeClientSecretV1Response
+func (c *ClientWithResponses) RevokeClientSecretV1WithResponse(ctx context.Context, clientId ID, secretId ID, reqEditors ...RequestEditorFn) (*RevokeClientSecretV1Response, error) {
+	rsp, err := c.RevokeClientSecretV1(ctx, clientId, secretId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeClientSecretV1Response(rsp)
+}
+
+// DeleteEnvironmentV1WithResponse request returning *DeleteEnvironmentV1Response
+func (c *ClientWithResponses) DeleteEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*DeleteEnvironmentV1Response, error) {
+	rsp, err := c.DeleteEnvironmentV1(ctx, environmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteEnvironmentV1Response(rsp)
+}
+
+// GetEnvironmentV1WithResponse request returning *GetEnvironmentV1Response
+func (c *ClientWithResponses) GetEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentV1Response, error) {
+	rsp, err := c.GetEnvironmentV1(ctx, environmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentV1Response(rsp)
+}
+
+// UpdateEnvironmentV1WithBodyWithResponse request with arbitrary body returning *UpdateEnvironmentV1Response
+func (c *ClientWithResponses) UpdateEnvironmentV1WithBodyWithResponse(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvironmentV1Response, error) {
+	rsp, err := c.UpdateEnvironmentV1WithBody(ctx, environmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEnvironmentV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateEnvironmentV1WithResponse(ctx context.Context, environmentId ID, body UpdateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvironmentV1Response, error) {
+	rsp, err := c.UpdateEnvironmentV1(ctx, environmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEnvironmentV1Response(rsp)
+}
+
+// GetClientsV1WithResponse request returning *GetClientsV1Response
+func (c *ClientWithResponses) GetClientsV1WithResponse(ctx context.Context, environmentId ID, params *GetClientsV1Params, reqEditors ...RequestEditorFn) (*GetClientsV1Response, error) {
+	rsp, err := c.GetClientsV1(ctx, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientsV1Response(rsp)
+}
+
+// CreateClientV1WithBodyWithResponse request with arbitrary body returning *CreateClientV1Response
+func (c *ClientWithResponses) CreateClientV1WithBodyWithResponse(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientV1Response, error) {
+	rsp, err := c.CreateClientV1WithBody(ctx, environmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateClientV1WithResponse(ctx context.Context, environmentId ID, body CreateClientV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientV1Response, error) {
+	rsp, err := c.CreateClientV1(ctx, environmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientV1Response(rsp)
+}
+
+// ExportEnvironmentV1WithResponse request returning *ExportEnvironmentV1Response
+func (c *ClientWithResponses) ExportEnvironmentV1WithResponse(ctx context.Context, environmentId ID, params *ExportEnvironmentV1Params, reqEditors ...RequestEditorFn) (*ExportEnvironmentV1Response, error) {
+	rsp, err := c.ExportEnvironmentV1(ctx, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportEnvironmentV1Response(rsp)
+}
+
+// ImportEnvironmentV1WithBodyWithResponse request with arbitrary body returning *ImportEnvironmentV1Response
+func (c *ClientWithResponses) ImportEnvironmentV1WithBodyWithResponse(ctx context.Context, environmentId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportEnvironmentV1Response, error) {
+	rsp, err := c.ImportEnvironmentV1WithBody(ctx, environmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportEnvironmentV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ImportEnvironmentV1WithResponse(ctx context.Context, environmentId ID, body ImportEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ImportEnvironmentV1Response, error) {
+	rsp, err := c.ImportEnvironmentV1(ctx, environmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportEnvironmentV1Response(rsp)
+}
+
+// GetEnvironmentSecretsV1WithResponse request returning *GetEnvironmentSecretsV1Response
+func (c *ClientWithResponses) GetEnvironmentSecretsV1WithResponse(ctx context.Context, environmentId ID, params *GetEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretsV1Response, error) {
+	rsp, err := c.GetEnvironmentSecretsV1(ctx, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentSecretsV1Response(rsp)
+}
+
+// RotateEnvironmentSecretsV1WithResponse request returning *RotateEnvironmentSecretsV1Response
+func (c *ClientWithResponses) RotateEnvironmentSecretsV1WithResponse(ctx context.Context, environmentId ID, params *RotateEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*RotateEnvironmentSecretsV1Response, error) {
+	rsp, err := c.RotateEnvironmentSecretsV1(ctx, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateEnvironmentSecretsV1Response(rsp)
+}
+
+// WatchEnvironmentSecretsV1WithResponse request returning *WatchEnvironmentSecretsV1Response
+func (c *ClientWithResponses) WatchEnvironmentSecretsV1WithResponse(ctx context.Context, environmentId ID, params *WatchEnvironmentSecretsV1Params, reqEditors ...RequestEditorFn) (*WatchEnvironmentSecretsV1Response, error) {
+	rsp, err := c.WatchEnvironmentSecretsV1(ctx, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWatchEnvironmentSecretsV1Response(rsp)
+}
+
+// UnsetEnvironmentSecretV1WithResponse request returning *UnsetEnvironmentSecretV1Response
+func (c *ClientWithResponses) UnsetEnvironmentSecretV1WithResponse(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*UnsetEnvironmentSecretV1Response, error) {
+	rsp, err := c.UnsetEnvironmentSecretV1(ctx, environmentId, variableId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnsetEnvironmentSecretV1Response(rsp)
+}
+
+// SetEnvironmentSecretV1WithBodyWithResponse request with arbitrary body returning *SetEnvironmentSecretV1Response
+func (c *ClientWithResponses) SetEnvironmentSecretV1WithBodyWithResponse(ctx context.Context, environmentId ID, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetEnvironmentSecretV1Response, error) {
+	rsp, err := c.SetEnvironmentSecretV1WithBody(ctx, environmentId, variableId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetEnvironmentSecretV1Response(rsp)
+}
+
+func (c *ClientWithResponses) SetEnvironmentSecretV1WithResponse(ctx context.Context, environmentId ID, variableId ID, body SetEnvironmentSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SetEnvironmentSecretV1Response, error) {
+	rsp, err := c.SetEnvironmentSecretV1(ctx, environmentId, variableId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetEnvironmentSecretV1Response(rsp)
+}
+
+// RollbackEnvironmentSecretV1WithBodyWithResponse request with arbitrary body returning *RollbackEnvironmentSecretV1Response
+func (c *ClientWithResponses) RollbackEnvironmentSecretV1WithBodyWithResponse(ctx context.Context, environmentId ID, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RollbackEnvironmentSecretV1Response, error) {
+	rsp, err := c.RollbackEnvironmentSecretV1WithBody(ctx, environmentId, variableId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackEnvironmentSecretV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RollbackEnvironmentSecretV1WithResponse(ctx context.Context, environmentId ID, variableId ID, body RollbackEnvironmentSecretV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RollbackEnvironmentSecretV1Response, error) {
+	rsp, err := c.RollbackEnvironmentSecretV1(ctx, environmentId, variableId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackEnvironmentSecretV1Response(rsp)
+}
+
+// RotateEnvironmentSecretV1WithResponse request returning *RotateEnvironmentSecretV1Response
+func (c *ClientWithResponses) RotateEnvironmentSecretV1WithResponse(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*RotateEnvironmentSecretV1Response, error) {
+	rsp, err := c.RotateEnvironmentSecretV1(ctx, environmentId, variableId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateEnvironmentSecretV1Response(rsp)
+}
+
+// GetEnvironmentSecretVersionsV1WithResponse request returning *GetEnvironmentSecretVersionsV1Response
+func (c *ClientWithResponses) GetEnvironmentSecretVersionsV1WithResponse(ctx context.Context, environmentId ID, variableId ID, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretVersionsV1Response, error) {
+	rsp, err := c.GetEnvironmentSecretVersionsV1(ctx, environmentId, variableId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentSecretVersionsV1Response(rsp)
+}
+
+// ValidateEnvironmentV1WithResponse request returning *ValidateEnvironmentV1Response
+func (c *ClientWithResponses) ValidateEnvironmentV1WithResponse(ctx context.Context, environmentId ID, reqEditors ...RequestEditorFn) (*ValidateEnvironmentV1Response, error) {
+	rsp, err := c.ValidateEnvironmentV1(ctx, environmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateEnvironmentV1Response(rsp)
+}
+
+// GetEnvironmentValuesV1WithResponse request returning *GetEnvironmentValuesV1Response
+func (c *ClientWithResponses) GetEnvironmentValuesV1WithResponse(ctx context.Context, environmentId ID, params *GetEnvironmentValuesV1Params, reqEditors ...RequestEditorFn) (*GetEnvironmentValuesV1Response, error) {
+	rsp, err := c.GetEnvironmentValuesV1(ctx, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentValuesV1Response(rsp)
+}
+
+// GetProjectsV1WithResponse request returning *GetProjectsV1Response
+func (c *ClientWithResponses) GetProjectsV1WithResponse(ctx context.Context, params *GetProjectsV1Params, reqEditors ...RequestEditorFn) (*GetProjectsV1Response, error) {
+	rsp, err := c.GetProjectsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsV1Response(rsp)
+}
+
+// CreateProjectV1WithBodyWithResponse request with arbitrary body returning *CreateProjectV1Response
+func (c *ClientWithResponses) CreateProjectV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectV1Response, error) {
+	rsp, err := c.CreateProjectV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectV1WithResponse(ctx context.Context, body CreateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectV1Response, error) {
+	rsp, err := c.CreateProjectV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectV1Response(rsp)
+}
+
+// DeleteProjectV1WithResponse request returning *DeleteProjectV1Response
+func (c *ClientWithResponses) DeleteProjectV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*DeleteProjectV1Response, error) {
+	rsp, err := c.DeleteProjectV1(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectV1Response(rsp)
+}
+
+// GetProjectV1WithResponse request returning *GetProjectV1Response
+func (c *ClientWithResponses) GetProjectV1WithResponse(ctx context.Context, projectId ID, reqEditors ...RequestEditorFn) (*GetProjectV1Response, error) {
+	rsp, err := c.GetProjectV1(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectV1Response(rsp)
+}
+
+// UpdateProjectV1WithBodyWithResponse request with arbitrary body returning *UpdateProjectV1Response
+func (c *ClientWithResponses) UpdateProjectV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectV1Response, error) {
+	rsp, err := c.UpdateProjectV1WithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectV1WithResponse(ctx context.Context, projectId ID, body UpdateProjectV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectV1Response, error) {
+	rsp, err := c.UpdateProjectV1(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectV1Response(rsp)
+}
+
+// GetEnvironmentsV1WithResponse request returning *GetEnvironmentsV1Response
+func (c *ClientWithResponses) GetEnvironmentsV1WithResponse(ctx context.Context, projectId ID, params *GetEnvironmentsV1Params, reqEditors ...RequestEditorFn) (*GetEnvironmentsV1Response, error) {
+	rsp, err := c.GetEnvironmentsV1(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentsV1Response(rsp)
+}
+
+// CreateEnvironmentV1WithBodyWithResponse request with arbitrary body returning *CreateEnvironmentV1Response
+func (c *ClientWithResponses) CreateEnvironmentV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentV1Response, error) {
+	rsp, err := c.CreateEnvironmentV1WithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvironmentV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateEnvironmentV1WithResponse(ctx context.Context, projectId ID, body CreateEnvironmentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentV1Response, error) {
+	rsp, err := c.CreateEnvironmentV1(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvironmentV1Response(rsp)
+}
+
+// GetVariablesV1WithResponse request returning *GetVariablesV1Response
+func (c *ClientWithResponses) GetVariablesV1WithResponse(ctx context.Context, projectId ID, params *GetVariablesV1Params, reqEditors ...RequestEditorFn) (*GetVariablesV1Response, error) {
+	rsp, err := c.GetVariablesV1(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVariablesV1Response(rsp)
+}
+
+// CreateVariableV1WithBodyWithResponse request with arbitrary body returning *CreateVariableV1Response
+func (c *ClientWithResponses) CreateVariableV1WithBodyWithResponse(ctx context.Context, projectId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVariableV1Response, error) {
+	rsp, err := c.CreateVariableV1WithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVariableV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateVariableV1WithResponse(ctx context.Context, projectId ID, body CreateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVariableV1Response, error) {
+	rsp, err := c.CreateVariableV1(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVariableV1Response(rsp)
+}
+
+// GetStatusV1WithResponse request returning *GetStatusV1Response
+func (c *ClientWithResponses) GetStatusV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusV1Response, error) {
+	rsp, err := c.GetStatusV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusV1Response(rsp)
+}
+
+// GetStatusReadyV1WithResponse request returning *GetStatusReadyV1Response
+func (c *ClientWithResponses) GetStatusReadyV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusReadyV1Response, error) {
+	rsp, err := c.GetStatusReadyV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusReadyV1Response(rsp)
+}
+
+// DeleteVariableV1WithResponse request returning *DeleteVariableV1Response
+func (c *ClientWithResponses) DeleteVariableV1WithResponse(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*DeleteVariableV1Response, error) {
+	rsp, err := c.DeleteVariableV1(ctx, variableId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVariableV1Response(rsp)
+}
+
+// GetVariableV1WithResponse request returning *GetVariableV1Response
+func (c *ClientWithResponses) GetVariableV1WithResponse(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*GetVariableV1Response, error) {
+	rsp, err := c.GetVariableV1(ctx, variableId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVariableV1Response(rsp)
+}
+
+// UpdateVariableV1WithBodyWithResponse request with arbitrary body returning *UpdateVariableV1Response
+func (c *ClientWithResponses) UpdateVariableV1WithBodyWithResponse(ctx context.Context, variableId ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateVariableV1Response, error) {
+	rsp, err := c.UpdateVariableV1WithBody(ctx, variableId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateVariableV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateVariableV1WithResponse(ctx context.Context, variableId ID, body UpdateVariableV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVariableV1Response, error) {
+	rsp, err := c.UpdateVariableV1(ctx, variableId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateVariableV1Response(rsp)
+}
+
+// ParseGetAdminKeysV1Response parses an HTTP response from a GetAdminKeysV1WithResponse call
+func ParseGetAdminKeysV1Response(rsp *http.Response) (*GetAdminKeysV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminKeysV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminKeys
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAdminKeyV1Response parses an HTTP response from a CreateAdminKeyV1WithResponse call
+func ParseCreateAdminKeyV1Response(rsp *http.Response) (*CreateAdminKeyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAdminKeyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateAdminKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeAdminKeyV1Response parses an HTTP response from a RevokeAdminKeyV1WithResponse call
+func ParseRevokeAdminKeyV1Response(rsp *http.Response) (*RevokeAdminKeyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeAdminKeyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminKeyObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditLogV1Response parses an HTTP response from a GetAuditLogV1WithResponse call
+func ParseGetAuditLogV1Response(rsp *http.Response) (*GetAuditLogV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditLogV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditEvents
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ClientsSelfResponse parses an HTTP response from a GetV1ClientsSelfWithResponse call
+func ParseGetV1ClientsSelfResponse(rsp *http.Response) (*GetV1ClientsSelfResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ClientsSelfResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientSelfObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientSecretsV1Response parses an HTTP response from a GetClientSecretsV1WithResponse call
+func ParseGetClientSecretsV1Response(rsp *http.Response) (*GetClientSecretsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientSecretsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Secrets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWatchClientSecretsV1Response parses an HTTP response from a WatchClientSecretsV1WithResponse call
+func ParseWatchClientSecretsV1Response(rsp *http.Response) (*WatchClientSecretsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WatchClientSecretsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientSecretsListV1Response parses an HTTP response from a GetClientSecretsListV1WithResponse call
+func ParseGetClientSecretsListV1Response(rsp *http.Response) (*GetClientSecretsListV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientSecretsListV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientSecrets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClientSecretV1Response parses an HTTP response from a CreateClientSecretV1WithResponse call
+func ParseCreateClientSecretV1Response(rsp *http.Response) (*CreateClientSecretV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClientSecretV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateClientSecretResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeClientSecretV1Response parses an HTTP response from a RevokeClientSecretV1WithResponse call
+func ParseRevokeClientSecretV1Response(rsp *http.Response) (*RevokeClientSecretV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeClientSecretV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientSecretObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteEnvironmentV1Response parses an HTTP response from a DeleteEnvironmentV1WithResponse call
+func ParseDeleteEnvironmentV1Response(rsp *http.Response) (*DeleteEnvironmentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteEnvironmentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvironmentV1Response parses an HTTP response from a GetEnvironmentV1WithResponse call
+func ParseGetEnvironmentV1Response(rsp *http.Response) (*GetEnvironmentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvironmentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateEnvironmentV1Response parses an HTTP response from a UpdateEnvironmentV1WithResponse call
+func ParseUpdateEnvironmentV1Response(rsp *http.Response) (*UpdateEnvironmentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateEnvironmentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientsV1Response parses an HTTP response from a GetClientsV1WithResponse call
+func ParseGetClientsV1Response(rsp *http.Response) (*GetClientsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClientObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClientV1Response parses an HTTP response from a CreateClientV1WithResponse call
+func ParseCreateClientV1Response(rsp *http.Response) (*CreateClientV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClientV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateClientResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportEnvironmentV1Response parses an HTTP response from a ExportEnvironmentV1WithResponse call
+func ParseExportEnvironmentV1Response(rsp *http.Response) (*ExportEnvironmentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportEnvironmentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportEnvironmentV1Response parses an HTTP response from a ImportEnvironmentV1WithResponse call
+func ParseImportEnvironmentV1Response(rsp *http.Response) (*ImportEnvironmentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportEnvironmentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvironmentSecretsV1Response parses an HTTP response from a GetEnvironmentSecretsV1WithResponse call
+func ParseGetEnvironmentSecretsV1Response(rsp *http.Response) (*GetEnvironmentSecretsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvironmentSecretsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Secrets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateEnvironmentSecretsV1Response parses an HTTP response from a RotateEnvironmentSecretsV1WithResponse call
+func ParseRotateEnvironmentSecretsV1Response(rsp *http.Response) (*RotateEnvironmentSecretsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateEnvironmentSecretsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Secrets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWatchEnvironmentSecretsV1Response parses an HTTP response from a WatchEnvironmentSecretsV1WithResponse call
+func ParseWatchEnvironmentSecretsV1Response(rsp *http.Response) (*WatchEnvironmentSecretsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WatchEnvironmentSecretsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnsetEnvironmentSecretV1Response parses an HTTP response from a UnsetEnvironmentSecretV1WithResponse call
+func ParseUnsetEnvironmentSecretV1Response(rsp *http.Response) (*UnsetEnvironmentSecretV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnsetEnvironmentSecretV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetEnvironmentSecretV1Response parses an HTTP response from a SetEnvironmentSecretV1WithResponse call
+func ParseSetEnvironmentSecretV1Response(rsp *http.Response) (*SetEnvironmentSecretV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetEnvironmentSecretV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRollbackEnvironmentSecretV1Response parses an HTTP response from a RollbackEnvironmentSecretV1WithResponse call
+func ParseRollbackEnvironmentSecretV1Response(rsp *http.Response) (*RollbackEnvironmentSecretV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RollbackEnvironmentSecretV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateEnvironmentSecretV1Response parses an HTTP response from a RotateEnvironmentSecretV1WithResponse call
+func ParseRotateEnvironmentSecretV1Response(rsp *http.Response) (*RotateEnvironmentSecretV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateEnvironmentSecretV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvironmentSecretVersionsV1Response parses an HTTP response from a GetEnvironmentSecretVersionsV1WithResponse call
+func ParseGetEnvironmentSecretVersionsV1Response(rsp *http.Response) (*GetEnvironmentSecretVersionsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvironmentSecretVersionsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretVersions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateEnvironmentV1Response parses an HTTP response from a ValidateEnvironmentV1WithResponse call
+func ParseValidateEnvironmentV1Response(rsp *http.Response) (*ValidateEnvironmentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateEnvironmentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValidationObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteVariableV1WithResponse request returning *DeleteVariableV1Response
-func (c *ClientWithResponses) DeleteVariableV1WithResponse(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*DeleteVariableV1Response, error) {
-	rsp, err := c.DeleteVariableV1(ctx, variableId, reqEditors...)
+// ParseGetEnvironmentValuesV1Response parses an HTTP response from a GetEnvironmentValuesV1WithResponse call
+func ParseGetEnvironmentValuesV1Response(rsp *http.Response) (*GetEnvironmentValuesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteVariableV1Response(rsp)
-}
 
-// GetVariableV1WithResponse request returning *GetVariableV1Response
-func (c *ClientWithResponses) GetVariableV1WithResponse(ctx context.Context, variableId ID, reqEditors ...RequestEditorFn) (*GetVariableV1Response, error) {
-	rsp, err := c.GetVariableV1(ctx, variableId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetEnvironmentValuesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetVariableV1Response(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Values
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetV1ClientsSelfResponse parses an HTTP response from a GetV1ClientsSelfWithResponse call
-func ParseGetV1ClientsSelfResponse(rsp *http.Response) (*GetV1ClientsSelfResponse, error) {
+// ParseGetProjectsV1Response parses an HTTP response from a GetProjectsV1WithResponse call
+func ParseGetProjectsV1Response(rsp *http.Response) (*GetProjectsV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetV1ClientsSelfResponse{
+	response := &GetProjectsV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClientObject
+		var dest Projects
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2433,26 +7773,26 @@ func ParseGetV1ClientsSelfResponse(rsp *http.Response) (*GetV1ClientsSelfRespons
 	return response, nil
 }
 
-// ParseGetClientSecretsV1Response parses an HTTP response from a GetClientSecretsV1WithResponse call
-func ParseGetClientSecretsV1Response(rsp *http.Response) (*GetClientSecretsV1Response, error) {
+// ParseCreateProjectV1Response parses an HTTP response from a CreateProjectV1WithResponse call
+func ParseCreateProjectV1Response(rsp *http.Response) (*CreateProjectV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClientSecretsV1Response{
+	response := &CreateProjectV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Secrets
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IDResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -2487,15 +7827,15 @@ func ParseGetClientSecretsV1Response(rsp *http.Response) (*GetClientSecretsV1Res
 	return response, nil
 }
 
-// ParseDeleteEnvironmentV1Response parses an HTTP response from a DeleteEnvironmentV1WithResponse call
-func ParseDeleteEnvironmentV1Response(rsp *http.Response) (*DeleteEnvironmentV1Response, error) {
+// ParseDeleteProjectV1Response parses an HTTP response from a DeleteProjectV1WithResponse call
+func ParseDeleteProjectV1Response(rsp *http.Response) (*DeleteProjectV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEnvironmentV1Response{
+	response := &DeleteProjectV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2534,22 +7874,22 @@ func ParseDeleteEnvironmentV1Response(rsp *http.Response) (*DeleteEnvironmentV1R
 	return response, nil
 }
 
-// ParseGetEnvironmentV1Response parses an HTTP response from a GetEnvironmentV1WithResponse call
-func ParseGetEnvironmentV1Response(rsp *http.Response) (*GetEnvironmentV1Response, error) {
+// ParseGetProjectV1Response parses an HTTP response from a GetProjectV1WithResponse call
+func ParseGetProjectV1Response(rsp *http.Response) (*GetProjectV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentV1Response{
+	response := &GetProjectV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentObject
+		var dest ProjectObject
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2588,22 +7928,22 @@ func ParseGetEnvironmentV1Response(rsp *http.Response) (*GetEnvironmentV1Respons
 	return response, nil
 }
 
-// ParseGetClientsV1Response parses an HTTP response from a GetClientsV1WithResponse call
-func ParseGetClientsV1Response(rsp *http.Response) (*GetClientsV1Response, error) {
+// ParseUpdateProjectV1Response parses an HTTP response from a UpdateProjectV1WithResponse call
+func ParseUpdateProjectV1Response(rsp *http.Response) (*UpdateProjectV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClientsV1Response{
+	response := &UpdateProjectV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ClientObject
+		var dest ProjectObject
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2630,6 +7970,13 @@ func ParseGetClientsV1Response(rsp *http.Response) (*GetClientsV1Response, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2642,26 +7989,26 @@ func ParseGetClientsV1Response(rsp *http.Response) (*GetClientsV1Response, error
 	return response, nil
 }
 
-// ParseCreateClientV1Response parses an HTTP response from a CreateClientV1WithResponse call
-func ParseCreateClientV1Response(rsp *http.Response) (*CreateClientV1Response, error) {
+// ParseGetEnvironmentsV1Response parses an HTTP response from a GetEnvironmentsV1WithResponse call
+func ParseGetEnvironmentsV1Response(rsp *http.Response) (*GetEnvironmentsV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateClientV1Response{
+	response := &GetEnvironmentsV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreateClientResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Environments
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -2696,26 +8043,26 @@ func ParseCreateClientV1Response(rsp *http.Response) (*CreateClientV1Response, e
 	return response, nil
 }
 
-// ParseGetEnvironmentSecretsV1Response parses an HTTP response from a GetEnvironmentSecretsV1WithResponse call
-func ParseGetEnvironmentSecretsV1Response(rsp *http.Response) (*GetEnvironmentSecretsV1Response, error) {
+// ParseCreateEnvironmentV1Response parses an HTTP response from a CreateEnvironmentV1WithResponse call
+func ParseCreateEnvironmentV1Response(rsp *http.Response) (*CreateEnvironmentV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentSecretsV1Response{
+	response := &CreateEnvironmentV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Secrets
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IDResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -2750,22 +8097,22 @@ func ParseGetEnvironmentSecretsV1Response(rsp *http.Response) (*GetEnvironmentSe
 	return response, nil
 }
 
-// ParseGetProjectsV1Response parses an HTTP response from a GetProjectsV1WithResponse call
-func ParseGetProjectsV1Response(rsp *http.Response) (*GetProjectsV1Response, error) {
+// ParseGetVariablesV1Response parses an HTTP response from a GetVariablesV1WithResponse call
+func ParseGetVariablesV1Response(rsp *http.Response) (*GetVariablesV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectsV1Response{
+	response := &GetVariablesV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Projects
+		var dest Variables
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2804,15 +8151,15 @@ func ParseGetProjectsV1Response(rsp *http.Response) (*GetProjectsV1Response, err
 	return response, nil
 }
 
-// ParseCreateProjectV1Response parses an HTTP response from a CreateProjectV1WithResponse call
-func ParseCreateProjectV1Response(rsp *http.Response) (*CreateProjectV1Response, error) {
+// ParseCreateVariableV1Response parses an HTTP response from a CreateVariableV1WithResponse call
+func ParseCreateVariableV1Response(rsp *http.Response) (*CreateVariableV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectV1Response{
+	response := &CreateVariableV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2825,12 +8172,125 @@ func ParseCreateProjectV1Response(rsp *http.Response) (*CreateProjectV1Response,
 		}
 		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatusV1Response parses an HTTP response from a GetStatusV1WithResponse call
+func ParseGetStatusV1Response(rsp *http.Response) (*GetStatusV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatusV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatusReadyV1Response parses an HTTP response from a GetStatusReadyV1WithResponse call
+func ParseGetStatusReadyV1Response(rsp *http.Response) (*GetStatusReadyV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatusReadyV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ready
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteVariableV1Response parses an HTTP response from a DeleteVariableV1WithResponse call
+func ParseDeleteVariableV1Response(rsp *http.Response) (*DeleteVariableV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteVariableV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -2858,27 +8318,34 @@ func ParseCreateProjectV1Response(rsp *http.Response) (*CreateProjectV1Response,
 	return response, nil
 }
 
-// ParseDeleteProjectV1Response parses an HTTP response from a DeleteProjectV1WithResponse call
-func ParseDeleteProjectV1Response(rsp *http.Response) (*DeleteProjectV1Response, error) {
+// ParseGetVariableV1Response parses an HTTP response from a GetVariableV1WithResponse call
+func ParseGetVariableV1Response(rsp *http.Response) (*GetVariableV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectV1Response{
+	response := &GetVariableV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest VariableObject
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2905,22 +8372,22 @@ func ParseDeleteProjectV1Response(rsp *http.Response) (*DeleteProjectV1Response,
 	return response, nil
 }
 
-// ParseGetProjectV1Response parses an HTTP response from a GetProjectV1WithResponse call
-func ParseGetProjectV1Response(rsp *http.Response) (*GetProjectV1Response, error) {
+// ParseUpdateVariableV1Response parses an HTTP response from a UpdateVariableV1WithResponse call
+func ParseUpdateVariableV1Response(rsp *http.Response) (*UpdateVariableV1Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectV1Response{
+	response := &UpdateVariableV1Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectObject
+		var dest VariableObject
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2947,6 +8414,13 @@ func ParseGetProjectV1Response(rsp *http.Response) (*GetProjectV1Response, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2956,464 +8430,779 @@ func ParseGetProjectV1Response(rsp *http.Response) (*GetProjectV1Response, error
 
 	}
 
-	return response, nil
-}
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List admin keys
+	// (GET /v1/admin/keys)
+	GetAdminKeysV1(c *gin.Context)
+	// Create admin key
+	// (POST /v1/admin/keys)
+	CreateAdminKeyV1(c *gin.Context)
+	// Revoke admin key
+	// (POST /v1/admin/keys/{key_id}/revoke)
+	RevokeAdminKeyV1(c *gin.Context, keyId ID)
+	// List audit events
+	// (GET /v1/audit)
+	GetAuditLogV1(c *gin.Context, params GetAuditLogV1Params)
+	// Get self
+	// (GET /v1/clients/_self)
+	GetV1ClientsSelf(c *gin.Context)
+	// Get secrets
+	// (GET /v1/clients/secrets)
+	GetClientSecretsV1(c *gin.Context, params GetClientSecretsV1Params)
+	// Watch secrets
+	// (GET /v1/clients/secrets/watch)
+	WatchClientSecretsV1(c *gin.Context, params WatchClientSecretsV1Params)
+	// List client secrets
+	// (GET /v1/clients/{client_id}/secrets)
+	GetClientSecretsListV1(c *gin.Context, clientId ID)
+	// Create client secret
+	// (POST /v1/clients/{client_id}/secrets)
+	CreateClientSecretV1(c *gin.Context, clientId ID)
+	// Revoke client secret
+	// (POST /v1/clients/{client_id}/secrets/{secret_id}/revoke)
+	RevokeClientSecretV1(c *gin.Context, clientId ID, secretId ID)
+	// Delete Environment
+	// (DELETE /v1/environments/{environment_id})
+	DeleteEnvironmentV1(c *gin.Context, environmentId ID)
+	// Get Environment
+	// (GET /v1/environments/{environment_id})
+	GetEnvironmentV1(c *gin.Context, environmentId ID)
+	// Update Environment
+	// (PATCH /v1/environments/{environment_id})
+	UpdateEnvironmentV1(c *gin.Context, environmentId ID)
+	// Get client
+	// (GET /v1/environments/{environment_id}/clients)
+	GetClientsV1(c *gin.Context, environmentId ID, params GetClientsV1Params)
+	// Create client
+	// (POST /v1/environments/{environment_id}/clients)
+	CreateClientV1(c *gin.Context, environmentId ID)
+	// Export values
+	// (GET /v1/environments/{environment_id}/export)
+	ExportEnvironmentV1(c *gin.Context, environmentId ID, params ExportEnvironmentV1Params)
+	// Import variables
+	// (POST /v1/environments/{environment_id}/import)
+	ImportEnvironmentV1(c *gin.Context, environmentId ID)
+	// List secrets
+	// (GET /v1/environments/{environment_id}/secrets)
+	GetEnvironmentSecretsV1(c *gin.Context, environmentId ID, params GetEnvironmentSecretsV1Params)
+	// Rotate secrets
+	// (POST /v1/environments/{environment_id}/secrets/rotate)
+	RotateEnvironmentSecretsV1(c *gin.Context, environmentId ID, params RotateEnvironmentSecretsV1Params)
+	// Watch secrets
+	// (GET /v1/environments/{environment_id}/secrets/watch)
+	WatchEnvironmentSecretsV1(c *gin.Context, environmentId ID, params WatchEnvironmentSecretsV1Params)
+	// Unset secret
+	// (DELETE /v1/environments/{environment_id}/secrets/{variable_id})
+	UnsetEnvironmentSecretV1(c *gin.Context, environmentId ID, variableId ID)
+	// Set secret
+	// (PUT /v1/environments/{environment_id}/secrets/{variable_id})
+	SetEnvironmentSecretV1(c *gin.Context, environmentId ID, variableId ID)
+	// Roll back secret
+	// (POST /v1/environments/{environment_id}/secrets/{variable_id}/rollback)
+	RollbackEnvironmentSecretV1(c *gin.Context, environmentId ID, variableId ID)
+	// Rotate secret
+	// (POST /v1/environments/{environment_id}/secrets/{variable_id}/rotate)
+	RotateEnvironmentSecretV1(c *gin.Context, environmentId ID, variableId ID)
+	// List secret versions
+	// (GET /v1/environments/{environment_id}/secrets/{variable_id}/versions)
+	GetEnvironmentSecretVersionsV1(c *gin.Context, environmentId ID, variableId ID)
+	// Validate secrets
+	// (GET /v1/environments/{environment_id}/validate)
+	ValidateEnvironmentV1(c *gin.Context, environmentId ID)
+	// Get values
+	// (GET /v1/environments/{environment_id}/values)
+	GetEnvironmentValuesV1(c *gin.Context, environmentId ID, params GetEnvironmentValuesV1Params)
+	// List projects
+	// (GET /v1/projects)
+	GetProjectsV1(c *gin.Context, params GetProjectsV1Params)
+	// Create project
+	// (POST /v1/projects)
+	CreateProjectV1(c *gin.Context)
+	// Delete Project
+	// (DELETE /v1/projects/{project_id})
+	DeleteProjectV1(c *gin.Context, projectId ID)
+	// Get Project
+	// (GET /v1/projects/{project_id})
+	GetProjectV1(c *gin.Context, projectId ID)
+	// Update Project
+	// (PATCH /v1/projects/{project_id})
+	UpdateProjectV1(c *gin.Context, projectId ID)
+	// List environments
+	// (GET /v1/projects/{project_id}/environments)
+	GetEnvironmentsV1(c *gin.Context, projectId ID, params GetEnvironmentsV1Params)
+	// Create environment
+	// (POST /v1/projects/{project_id}/environments)
+	CreateEnvironmentV1(c *gin.Context, projectId ID)
+	// Get variables
+	// (GET /v1/projects/{project_id}/variables)
+	GetVariablesV1(c *gin.Context, projectId ID, params GetVariablesV1Params)
+	// Create variable
+	// (POST /v1/projects/{project_id}/variables)
+	CreateVariableV1(c *gin.Context, projectId ID)
+	// Get status
+	// (GET /v1/status)
+	GetStatusV1(c *gin.Context)
+	// Get readiness status
+	// (GET /v1/status/ready)
+	GetStatusReadyV1(c *gin.Context)
+	// Delete Variable
+	// (DELETE /v1/variables/{variable_id})
+	DeleteVariableV1(c *gin.Context, variableId ID)
+	// Get Variable
+	// (GET /v1/variables/{variable_id})
+	GetVariableV1(c *gin.Context, variableId ID)
+	// Update Variable
+	// (PATCH /v1/variables/{variable_id})
+	UpdateVariableV1(c *gin.Context, variableId ID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetAdminKeysV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminKeysV1(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminKeysV1(c)
+}
+
+// CreateAdminKeyV1 operation middleware
+func (siw *ServerInterfaceWrapper) CreateAdminKeyV1(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateAdminKeyV1(c)
+}
+
+// RevokeAdminKeyV1 operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAdminKeyV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "key_id" -------------
+	var keyId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", c.Param("key_id"), &keyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter key_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeAdminKeyV1(c, keyId)
+}
+
+// GetAuditLogV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditLogV1(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditLogV1Params
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", c.Request.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "environment_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "environment_id", c.Request.URL.Query(), &params.EnvironmentId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "after_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after_id", c.Request.URL.Query(), &params.AfterId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter after_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuditLogV1(c, params)
+}
+
+// GetV1ClientsSelf operation middleware
+func (siw *ServerInterfaceWrapper) GetV1ClientsSelf(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1ClientsSelf(c)
+}
+
+// GetClientSecretsV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetClientSecretsV1(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientSecretsV1Params
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetClientSecretsV1(c, params)
+}
+
+// WatchClientSecretsV1 operation middleware
+func (siw *ServerInterfaceWrapper) WatchClientSecretsV1(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchClientSecretsV1Params
+
+	// ------------- Optional query parameter "last_event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last_event_id", c.Request.URL.Query(), &params.LastEventId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter last_event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.WatchClientSecretsV1(c, params)
+}
+
+// GetClientSecretsListV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetClientSecretsListV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "client_id" -------------
+	var clientId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", c.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter client_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetClientSecretsListV1(c, clientId)
+}
+
+// CreateClientSecretV1 operation middleware
+func (siw *ServerInterfaceWrapper) CreateClientSecretV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "client_id" -------------
+	var clientId ID
 
-// ParseGetEnvironmentsV1Response parses an HTTP response from a GetEnvironmentsV1WithResponse call
-func ParseGetEnvironmentsV1Response(rsp *http.Response) (*GetEnvironmentsV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", c.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
-	}
-
-	response := &GetEnvironmentsV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter client_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Environments
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.CreateClientSecretV1(c, clientId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// RevokeClientSecretV1 operation middleware
+func (siw *ServerInterfaceWrapper) RevokeClientSecretV1(c *gin.Context) {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "client_id" -------------
+	var clientId ID
 
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", c.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter client_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "secret_id" -------------
+	var secretId ID
 
-// ParseCreateEnvironmentV1Response parses an HTTP response from a CreateEnvironmentV1WithResponse call
-func ParseCreateEnvironmentV1Response(rsp *http.Response) (*CreateEnvironmentV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "secret_id", c.Param("secret_id"), &secretId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secret_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &CreateEnvironmentV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest IDResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	siw.Handler.RevokeClientSecretV1(c, clientId, secretId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// DeleteEnvironmentV1 operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnvironmentV1(c *gin.Context) {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.DeleteEnvironmentV1(c, environmentId)
 }
 
-// ParseGetVariablesV1Response parses an HTTP response from a GetVariablesV1WithResponse call
-func ParseGetVariablesV1Response(rsp *http.Response) (*GetVariablesV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetEnvironmentV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetEnvironmentV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetVariablesV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Variables
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	siw.Handler.GetEnvironmentV1(c, environmentId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// UpdateEnvironmentV1 operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEnvironmentV1(c *gin.Context) {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.UpdateEnvironmentV1(c, environmentId)
 }
 
-// ParseCreateVariableV1Response parses an HTTP response from a CreateVariableV1WithResponse call
-func ParseCreateVariableV1Response(rsp *http.Response) (*CreateVariableV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetClientsV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetClientsV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &CreateVariableV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientsV1Params
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest IDResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Optional query parameter "offset" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// ------------- Optional query parameter "filter" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Optional query parameter "sort" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.GetClientsV1(c, environmentId, params)
 }
 
-// ParseGetStatusV1Response parses an HTTP response from a GetStatusV1WithResponse call
-func ParseGetStatusV1Response(rsp *http.Response) (*GetStatusV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// CreateClientV1 operation middleware
+func (siw *ServerInterfaceWrapper) CreateClientV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetStatusV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Status
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	siw.Handler.CreateClientV1(c, environmentId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// ExportEnvironmentV1 operation middleware
+func (siw *ServerInterfaceWrapper) ExportEnvironmentV1(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
 
-// ParseGetStatusReadyV1Response parses an HTTP response from a GetStatusReadyV1WithResponse call
-func ParseGetStatusReadyV1Response(rsp *http.Response) (*GetStatusReadyV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetStatusReadyV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportEnvironmentV1Params
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Ready
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Required query parameter "format" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest ServiceUnavailable
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
+	if paramValue := c.Query("format"); paramValue != "" {
 
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument format is required, but not found"), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	err = runtime.BindQueryParameter("form", true, true, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
 
-// ParseDeleteVariableV1Response parses an HTTP response from a DeleteVariableV1WithResponse call
-func ParseDeleteVariableV1Response(rsp *http.Response) (*DeleteVariableV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &DeleteVariableV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	siw.Handler.ExportEnvironmentV1(c, environmentId, params)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+// ImportEnvironmentV1 operation middleware
+func (siw *ServerInterfaceWrapper) ImportEnvironmentV1(c *gin.Context) {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var err error
+
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.ImportEnvironmentV1(c, environmentId)
 }
 
-// ParseGetVariableV1Response parses an HTTP response from a GetVariableV1WithResponse call
-func ParseGetVariableV1Response(rsp *http.Response) (*GetVariableV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetEnvironmentSecretsV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetEnvironmentSecretsV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetVariableV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnvironmentSecretsV1Params
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VariableObject
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "tag" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON401 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Forbidden
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	siw.Handler.GetEnvironmentSecretsV1(c, environmentId, params)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// RotateEnvironmentSecretsV1 operation middleware
+func (siw *ServerInterfaceWrapper) RotateEnvironmentSecretsV1(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get self
-	// (GET /v1/clients/_self)
-	GetV1ClientsSelf(c *gin.Context)
-	// Get secrets
-	// (GET /v1/clients/secrets)
-	GetClientSecretsV1(c *gin.Context)
-	// Delete Environment
-	// (DELETE /v1/environments/{environment_id})
-	DeleteEnvironmentV1(c *gin.Context, environmentId ID)
-	// Get Environment
-	// (GET /v1/environments/{environment_id})
-	GetEnvironmentV1(c *gin.Context, environmentId ID)
-	// Get client
-	// (GET /v1/environments/{environment_id}/clients)
-	GetClientsV1(c *gin.Context, environmentId ID)
-	// Create client
-	// (POST /v1/environments/{environment_id}/clients)
-	CreateClientV1(c *gin.Context, environmentId ID)
-	// List secrets
-	// (GET /v1/environments/{environment_id}/secrets)
-	GetEnvironmentSecretsV1(c *gin.Context, environmentId ID)
-	// List projects
-	// (GET /v1/projects)
-	GetProjectsV1(c *gin.Context)
-	// Create project
-	// (POST /v1/projects)
-	CreateProjectV1(c *gin.Context)
-	// Delete Project
-	// (DELETE /v1/projects/{project_id})
-	DeleteProjectV1(c *gin.Context, projectId ID)
-	// Get Project
-	// (GET /v1/projects/{project_id})
-	GetProjectV1(c *gin.Context, projectId ID)
-	// List environments
-	// (GET /v1/projects/{project_id}/environments)
-	GetEnvironmentsV1(c *gin.Context, projectId ID)
-	// Create environment
-	// (POST /v1/projects/{project_id}/environments)
-	CreateEnvironmentV1(c *gin.Context, projectId ID)
-	// Get variables
-	// (GET /v1/projects/{project_id}/variables)
-	GetVariablesV1(c *gin.Context, projectId ID)
-	// Create variable
-	// (POST /v1/projects/{project_id}/variables)
-	CreateVariableV1(c *gin.Context, projectId ID)
-	// Get status
-	// (GET /v1/status)
-	GetStatusV1(c *gin.Context)
-	// Get readiness status
-	// (GET /v1/status/ready)
-	GetStatusReadyV1(c *gin.Context)
-	// Delete Variable
-	// (DELETE /v1/variables/{variable_id})
-	DeleteVariableV1(c *gin.Context, variableId ID)
-	// Get Variable
-	// (GET /v1/variables/{variable_id})
-	GetVariableV1(c *gin.Context, variableId ID)
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RotateEnvironmentSecretsV1Params
+
+	// ------------- Optional query parameter "generator" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "generator", c.Request.URL.Query(), &params.Generator)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter generator: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RotateEnvironmentSecretsV1(c, environmentId, params)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+// WatchEnvironmentSecretsV1 operation middleware
+func (siw *ServerInterfaceWrapper) WatchEnvironmentSecretsV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchEnvironmentSecretsV1Params
+
+	// ------------- Optional query parameter "last_event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last_event_id", c.Request.URL.Query(), &params.LastEventId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter last_event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.WatchEnvironmentSecretsV1(c, environmentId, params)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// UnsetEnvironmentSecretV1 operation middleware
+func (siw *ServerInterfaceWrapper) UnsetEnvironmentSecretV1(c *gin.Context) {
 
-// GetV1ClientsSelf operation middleware
-func (siw *ServerInterfaceWrapper) GetV1ClientsSelf(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "variable_id" -------------
+	var variableId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variable_id", c.Param("variable_id"), &variableId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter variable_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3422,11 +9211,31 @@ func (siw *ServerInterfaceWrapper) GetV1ClientsSelf(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV1ClientsSelf(c)
+	siw.Handler.UnsetEnvironmentSecretV1(c, environmentId, variableId)
 }
 
-// GetClientSecretsV1 operation middleware
-func (siw *ServerInterfaceWrapper) GetClientSecretsV1(c *gin.Context) {
+// SetEnvironmentSecretV1 operation middleware
+func (siw *ServerInterfaceWrapper) SetEnvironmentSecretV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "environment_id" -------------
+	var environmentId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "environment_id", c.Param("environment_id"), &environmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter environment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "variable_id" -------------
+	var variableId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variable_id", c.Param("variable_id"), &variableId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter variable_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3435,11 +9244,11 @@ func (siw *ServerInterfaceWrapper) GetClientSecretsV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetClientSecretsV1(c)
+	siw.Handler.SetEnvironmentSecretV1(c, environmentId, variableId)
 }
 
-// DeleteEnvironmentV1 operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEnvironmentV1(c *gin.Context) {
+// RollbackEnvironmentSecretV1 operation middleware
+func (siw *ServerInterfaceWrapper) RollbackEnvironmentSecretV1(c *gin.Context) {
 
 	var err error
 
@@ -3452,6 +9261,15 @@ func (siw *ServerInterfaceWrapper) DeleteEnvironmentV1(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "variable_id" -------------
+	var variableId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variable_id", c.Param("variable_id"), &variableId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter variable_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3459,11 +9277,11 @@ func (siw *ServerInterfaceWrapper) DeleteEnvironmentV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteEnvironmentV1(c, environmentId)
+	siw.Handler.RollbackEnvironmentSecretV1(c, environmentId, variableId)
 }
 
-// GetEnvironmentV1 operation middleware
-func (siw *ServerInterfaceWrapper) GetEnvironmentV1(c *gin.Context) {
+// RotateEnvironmentSecretV1 operation middleware
+func (siw *ServerInterfaceWrapper) RotateEnvironmentSecretV1(c *gin.Context) {
 
 	var err error
 
@@ -3476,6 +9294,15 @@ func (siw *ServerInterfaceWrapper) GetEnvironmentV1(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "variable_id" -------------
+	var variableId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variable_id", c.Param("variable_id"), &variableId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter variable_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3483,11 +9310,11 @@ func (siw *ServerInterfaceWrapper) GetEnvironmentV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetEnvironmentV1(c, environmentId)
+	siw.Handler.RotateEnvironmentSecretV1(c, environmentId, variableId)
 }
 
-// GetClientsV1 operation middleware
-func (siw *ServerInterfaceWrapper) GetClientsV1(c *gin.Context) {
+// GetEnvironmentSecretVersionsV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetEnvironmentSecretVersionsV1(c *gin.Context) {
 
 	var err error
 
@@ -3500,6 +9327,15 @@ func (siw *ServerInterfaceWrapper) GetClientsV1(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "variable_id" -------------
+	var variableId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variable_id", c.Param("variable_id"), &variableId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter variable_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3507,11 +9343,11 @@ func (siw *ServerInterfaceWrapper) GetClientsV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetClientsV1(c, environmentId)
+	siw.Handler.GetEnvironmentSecretVersionsV1(c, environmentId, variableId)
 }
 
-// CreateClientV1 operation middleware
-func (siw *ServerInterfaceWrapper) CreateClientV1(c *gin.Context) {
+// ValidateEnvironmentV1 operation middleware
+func (siw *ServerInterfaceWrapper) ValidateEnvironmentV1(c *gin.Context) {
 
 	var err error
 
@@ -3531,11 +9367,11 @@ func (siw *ServerInterfaceWrapper) CreateClientV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateClientV1(c, environmentId)
+	siw.Handler.ValidateEnvironmentV1(c, environmentId)
 }
 
-// GetEnvironmentSecretsV1 operation middleware
-func (siw *ServerInterfaceWrapper) GetEnvironmentSecretsV1(c *gin.Context) {
+// GetEnvironmentValuesV1 operation middleware
+func (siw *ServerInterfaceWrapper) GetEnvironmentValuesV1(c *gin.Context) {
 
 	var err error
 
@@ -3548,6 +9384,25 @@ func (siw *ServerInterfaceWrapper) GetEnvironmentSecretsV1(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnvironmentValuesV1Params
+
+	// ------------- Optional query parameter "reveal" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reveal", c.Request.URL.Query(), &params.Reveal)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reveal: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3555,12 +9410,49 @@ func (siw *ServerInterfaceWrapper) GetEnvironmentSecretsV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetEnvironmentSecretsV1(c, environmentId)
+	siw.Handler.GetEnvironmentValuesV1(c, environmentId, params)
 }
 
 // GetProjectsV1 operation middleware
 func (siw *ServerInterfaceWrapper) GetProjectsV1(c *gin.Context) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsV1Params
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3568,7 +9460,7 @@ func (siw *ServerInterfaceWrapper) GetProjectsV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetProjectsV1(c)
+	siw.Handler.GetProjectsV1(c, params)
 }
 
 // CreateProjectV1 operation middleware
@@ -3632,6 +9524,30 @@ func (siw *ServerInterfaceWrapper) GetProjectV1(c *gin.Context) {
 	siw.Handler.GetProjectV1(c, projectId)
 }
 
+// UpdateProjectV1 operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "project_id" -------------
+	var projectId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", c.Param("project_id"), &projectId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter project_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateProjectV1(c, projectId)
+}
+
 // GetEnvironmentsV1 operation middleware
 func (siw *ServerInterfaceWrapper) GetEnvironmentsV1(c *gin.Context) {
 
@@ -3646,6 +9562,41 @@ func (siw *ServerInterfaceWrapper) GetEnvironmentsV1(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnvironmentsV1Params
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3653,7 +9604,7 @@ func (siw *ServerInterfaceWrapper) GetEnvironmentsV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetEnvironmentsV1(c, projectId)
+	siw.Handler.GetEnvironmentsV1(c, projectId, params)
 }
 
 // CreateEnvironmentV1 operation middleware
@@ -3694,6 +9645,49 @@ func (siw *ServerInterfaceWrapper) GetVariablesV1(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVariablesV1Params
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3701,7 +9695,7 @@ func (siw *ServerInterfaceWrapper) GetVariablesV1(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetVariablesV1(c, projectId)
+	siw.Handler.GetVariablesV1(c, projectId, params)
 }
 
 // CreateVariableV1 operation middleware
@@ -3802,6 +9796,30 @@ func (siw *ServerInterfaceWrapper) GetVariableV1(c *gin.Context) {
 	siw.Handler.GetVariableV1(c, variableId)
 }
 
+// UpdateVariableV1 operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVariableV1(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "variable_id" -------------
+	var variableId ID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variable_id", c.Param("variable_id"), &variableId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter variable_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateVariableV1(c, variableId)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -3829,17 +9847,38 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/v1/admin/keys", wrapper.GetAdminKeysV1)
+	router.POST(options.BaseURL+"/v1/admin/keys", wrapper.CreateAdminKeyV1)
+	router.POST(options.BaseURL+"/v1/admin/keys/:key_id/revoke", wrapper.RevokeAdminKeyV1)
+	router.GET(options.BaseURL+"/v1/audit", wrapper.GetAuditLogV1)
 	router.GET(options.BaseURL+"/v1/clients/_self", wrapper.GetV1ClientsSelf)
 	router.GET(options.BaseURL+"/v1/clients/secrets", wrapper.GetClientSecretsV1)
+	router.GET(options.BaseURL+"/v1/clients/secrets/watch", wrapper.WatchClientSecretsV1)
+	router.GET(options.BaseURL+"/v1/clients/:client_id/secrets", wrapper.GetClientSecretsListV1)
+	router.POST(options.BaseURL+"/v1/clients/:client_id/secrets", wrapper.CreateClientSecretV1)
+	router.POST(options.BaseURL+"/v1/clients/:client_id/secrets/:secret_id/revoke", wrapper.RevokeClientSecretV1)
 	router.DELETE(options.BaseURL+"/v1/environments/:environment_id", wrapper.DeleteEnvironmentV1)
 	router.GET(options.BaseURL+"/v1/environments/:environment_id", wrapper.GetEnvironmentV1)
+	router.PATCH(options.BaseURL+"/v1/environments/:environment_id", wrapper.UpdateEnvironmentV1)
 	router.GET(options.BaseURL+"/v1/environments/:environment_id/clients", wrapper.GetClientsV1)
 	router.POST(options.BaseURL+"/v1/environments/:environment_id/clients", wrapper.CreateClientV1)
+	router.GET(options.BaseURL+"/v1/environments/:environment_id/export", wrapper.ExportEnvironmentV1)
+	router.POST(options.BaseURL+"/v1/environments/:environment_id/import", wrapper.ImportEnvironmentV1)
 	router.GET(options.BaseURL+"/v1/environments/:environment_id/secrets", wrapper.GetEnvironmentSecretsV1)
+	router.POST(options.BaseURL+"/v1/environments/:environment_id/secrets/rotate", wrapper.RotateEnvironmentSecretsV1)
+	router.GET(options.BaseURL+"/v1/environments/:environment_id/secrets/watch", wrapper.WatchEnvironmentSecretsV1)
+	router.DELETE(options.BaseURL+"/v1/environments/:environment_id/secrets/:variable_id", wrapper.UnsetEnvironmentSecretV1)
+	router.PUT(options.BaseURL+"/v1/environments/:environment_id/secrets/:variable_id", wrapper.SetEnvironmentSecretV1)
+	router.POST(options.BaseURL+"/v1/environments/:environment_id/secrets/:variable_id/rollback", wrapper.RollbackEnvironmentSecretV1)
+	router.POST(options.BaseURL+"/v1/environments/:environment_id/secrets/:variable_id/rotate", wrapper.RotateEnvironmentSecretV1)
+	router.GET(options.BaseURL+"/v1/environments/:environment_id/secrets/:variable_id/versions", wrapper.GetEnvironmentSecretVersionsV1)
+	router.GET(options.BaseURL+"/v1/environments/:environment_id/validate", wrapper.ValidateEnvironmentV1)
+	router.GET(options.BaseURL+"/v1/environments/:environment_id/values", wrapper.GetEnvironmentValuesV1)
 	router.GET(options.BaseURL+"/v1/projects", wrapper.GetProjectsV1)
 	router.POST(options.BaseURL+"/v1/projects", wrapper.CreateProjectV1)
 	router.DELETE(options.BaseURL+"/v1/projects/:project_id", wrapper.DeleteProjectV1)
 	router.GET(options.BaseURL+"/v1/projects/:project_id", wrapper.GetProjectV1)
+	router.PATCH(options.BaseURL+"/v1/projects/:project_id", wrapper.UpdateProjectV1)
 	router.GET(options.BaseURL+"/v1/projects/:project_id/environments", wrapper.GetEnvironmentsV1)
 	router.POST(options.BaseURL+"/v1/projects/:project_id/environments", wrapper.CreateEnvironmentV1)
 	router.GET(options.BaseURL+"/v1/projects/:project_id/variables", wrapper.GetVariablesV1)
@@ -3848,4 +9887,5 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/v1/status/ready", wrapper.GetStatusReadyV1)
 	router.DELETE(options.BaseURL+"/v1/variables/:variable_id", wrapper.DeleteVariableV1)
 	router.GET(options.BaseURL+"/v1/variables/:variable_id", wrapper.GetVariableV1)
+	router.PATCH(options.BaseURL+"/v1/variables/:variable_id", wrapper.UpdateVariableV1)
 }
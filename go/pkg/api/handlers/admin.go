@@ -0,0 +1,141 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+)
+
+// adminKey is a row returned by public.admin_api_keys (the hash is never returned)
+type adminKey struct {
+	Id        uuid.UUID `json:"id"`
+	CreatedAt string    `json:"created_at"`
+	Display   string    `json:"display"`
+	RevokedAt *string   `json:"revoked_at"`
+}
+
+// createdAdminKey is a row returned by public.create_admin_api_key
+type createdAdminKey struct {
+	Id  uuid.UUID `json:"id"`
+	Key string    `json:"key"`
+}
+
+func toAdminKeyObject(key adminKey) api.AdminKeyObject {
+	return api.AdminKeyObject{
+		Id:        key.Id,
+		CreatedAt: key.CreatedAt,
+		Display:   key.Display,
+		RevokedAt: key.RevokedAt,
+	}
+}
+
+func (r RouteHandlers) GetAdminKeysV1(c *gin.Context) {
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcAdminApiKeysWithResponse(context.Background(), &postgrest.PostRpcAdminApiKeysParams{}, postgrest.PostRpcAdminApiKeysJSONRequestBody{}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if keys, err := parse[[]adminKey](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, utils.ForEach(*keys, toAdminKeyObject))
+	}
+}
+
+func (r RouteHandlers) CreateAdminKeyV1(c *gin.Context) {
+	var req api.CreateAdminKeyV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcCreateAdminApiKeyWithResponse(context.Background(), &postgrest.PostRpcCreateAdminApiKeyParams{}, postgrest.PostRpcCreateAdminApiKeyJSONRequestBody{
+		"p_display": req.Name,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusConflict {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "duplicate",
+			Description: "an object with this display-name already exists",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if key, err := parseOne[createdAdminKey](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusCreated, api.CreateAdminKeyResponse{Id: key.Id, Key: key.Key})
+	}
+}
+
+func (r RouteHandlers) RevokeAdminKeyV1(c *gin.Context, keyId api.ID) {
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcRevokeAdminApiKeyWithResponse(context.Background(), &postgrest.PostRpcRevokeAdminApiKeyParams{}, postgrest.PostRpcRevokeAdminApiKeyJSONRequestBody{
+		"p_id": keyId,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("an admin key with id='%s' was not found or was not accessible", keyId.String()),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if key, err := parseOne[adminKey](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, toAdminKeyObject(*key))
+	}
+}
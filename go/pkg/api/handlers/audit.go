@@ -0,0 +1,113 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+)
+
+// auditEvent is a row returned by public.audit_log
+type auditEvent struct {
+	Id            int64                   `json:"id"`
+	CreatedAt     string                  `json:"created_at"`
+	ActorType     string                  `json:"actor_type"`
+	Actor         string                  `json:"actor"`
+	Action        string                  `json:"action"`
+	Resource      string                  `json:"resource"`
+	EnvironmentId *uuid.UUID              `json:"environment_id"`
+	SourceIp      *string                 `json:"source_ip"`
+	Details       *map[string]interface{} `json:"details"`
+}
+
+func toAuditEventObject(event auditEvent) api.AuditEventObject {
+	return api.AuditEventObject{
+		Id:            event.Id,
+		CreatedAt:     event.CreatedAt,
+		ActorType:     api.AuditEventObjectActorType(event.ActorType),
+		Actor:         event.Actor,
+		Action:        event.Action,
+		Resource:      event.Resource,
+		EnvironmentId: event.EnvironmentId,
+		SourceIp:      event.SourceIp,
+		Details:       event.Details,
+	}
+}
+
+func (r RouteHandlers) GetAuditLogV1(c *gin.Context, params api.GetAuditLogV1Params) {
+
+	// only pass the filters that were set (the function defaults the rest)
+	args := postgrest.PostRpcAuditLogJSONRequestBody{}
+	if params.Since != nil {
+		args["p_since"] = *params.Since
+	}
+	if params.Until != nil {
+		args["p_until"] = *params.Until
+	}
+	if params.Actor != nil {
+		args["p_actor"] = *params.Actor
+	}
+	if params.Action != nil {
+		args["p_action"] = *params.Action
+	}
+	if params.EnvironmentId != nil {
+		args["p_environment_id"] = *params.EnvironmentId
+	}
+	if params.Limit != nil {
+		args["p_limit"] = *params.Limit
+	}
+	if params.AfterId != nil {
+		args["p_after"] = *params.AfterId
+	}
+
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcAuditLogWithResponse(context.Background(), &postgrest.PostRpcAuditLogParams{}, args); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid filter",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() == http.StatusNotFound && params.AfterId != nil {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("an audit event with id='%d' was not found", *params.AfterId),
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if events, err := parse[[]auditEvent](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, utils.ForEach(*events, toAuditEventObject))
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAuditLogNotFound(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": "PGRST202", "message": "Could not find the function public.audit_log in the schema cache"}`))
+	}))
+	defer upstream.Close()
+
+	tests := []struct {
+		name    string
+		afterId *int64
+		want    string
+	}{
+		{name: "after an event", afterId: utils.Ptr(int64(42)), want: "an audit event with id='42' was not found"},
+		{name: "without a cursor", want: "Could not find the function public.audit_log in the schema cache"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request = httptest.NewRequest(http.MethodGet, "/v1/audit", nil)
+
+			RouteHandlers{BaseURL: upstream.URL}.GetAuditLogV1(c, api.GetAuditLogV1Params{AfterId: tt.afterId})
+
+			var got api.Error
+			if recorder.Code != http.StatusNotFound {
+				t.Fatalf("status = %d, want %d", recorder.Code, http.StatusNotFound)
+			} else if err := json.Unmarshal(recorder.Body.Bytes(), &got); err != nil {
+				t.Fatalf("unable to parse response: %v", err)
+			} else if got.Description != tt.want {
+				t.Errorf("description = %q, want %q", got.Description, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/train360-corp/projconf/go/internal/utils/certificate"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+)
+
+// pendingCertificate is a row returned by public.pending_certificates
+type pendingCertificate struct {
+	EnvironmentId        api.ID `json:"environment_id"`
+	ProjectId            api.ID `json:"project_id"`
+	VariableId           api.ID `json:"variable_id"`
+	PrivateKeyVariableId api.ID `json:"private_key_variable_id"`
+	CaBundleVariableId   api.ID `json:"ca_bundle_variable_id"`
+	GeneratorData        struct {
+		CommonName string   `json:"common-name"`
+		Sans       []string `json:"sans"`
+		Days       int      `json:"days"`
+	} `json:"generator_data"`
+	CaCertificate *string `json:"ca_certificate"`
+	CaPrivateKey  *string `json:"ca_private_key"`
+}
+
+// certificateAuthority is a row returned by public.set_certificate_authority
+type certificateAuthority struct {
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"private_key"`
+}
+
+// generateCertificates issues and stores every certificate still waiting for it (see
+// public.pending_certificates), creating the ca of a project with its first certificate
+func generateCertificates(c *gin.Context, supabase *postgrest.ClientWithResponses) error {
+	response, err := supabase.PostRpcPendingCertificatesWithResponse(context.Background(), &postgrest.PostRpcPendingCertificatesParams{}, postgrest.PostRpcPendingCertificatesJSONRequestBody{})
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		return err
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return fmt.Errorf("error %d", response.StatusCode())
+	}
+
+	pending, err := parse[[]pendingCertificate](response.Body)
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return err
+	}
+
+	authorities := make(map[api.ID]certificateAuthority)
+	for _, cert := range *pending {
+		leaf, key, authority, err := issueCertificate(c, supabase, cert, authorities)
+		if err != nil {
+			return err
+		}
+
+		response, err := supabase.PostRpcSetCertificateWithResponse(context.Background(), &postgrest.PostRpcSetCertificateParams{}, postgrest.PostRpcSetCertificateJSONRequestBody{
+			"p_environment_id": cert.EnvironmentId,
+			"p_variable_id":    cert.VariableId,
+			"p_certificate":    leaf,
+			"p_private_key":    key,
+			"p_ca_bundle":      authority.Certificate,
+		})
+		if err != nil {
+			state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+			return err
+		} else if response.StatusCode() != http.StatusOK && response.StatusCode() != http.StatusNoContent {
+			state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+			return fmt.Errorf("error %d", response.StatusCode())
+		}
+	}
+	return nil
+}
+
+// rotatedCertificates issues every certificate a rotation re-issues (see
+// public.certificates_to_rotate), by variable id, for the rotation to write with everything else
+func rotatedCertificates(c *gin.Context, supabase *postgrest.ClientWithResponses, rotation postgrest.PostRpcCertificatesToRotateJSONRequestBody) (map[string]string, error) {
+	response, err := supabase.PostRpcCertificatesToRotateWithResponse(context.Background(), &postgrest.PostRpcCertificatesToRotateParams{}, rotation)
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		return nil, err
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return nil, fmt.Errorf("error %d", response.StatusCode())
+	}
+
+	certs, err := parse[[]pendingCertificate](response.Body)
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return nil, err
+	}
+
+	authorities := make(map[api.ID]certificateAuthority)
+	values := make(map[string]string, 3*len(*certs))
+	for _, cert := range *certs {
+		leaf, key, authority, err := issueCertificate(c, supabase, cert, authorities)
+		if err != nil {
+			return nil, err
+		}
+		values[cert.VariableId.String()] = leaf
+		values[cert.PrivateKeyVariableId.String()] = key
+		values[cert.CaBundleVariableId.String()] = authority.Certificate
+	}
+	return values, nil
+}
+
+// issueCertificate issues a certificate with the ca of its project (remembered in authorities),
+// creating the ca with the first certificate of the project
+func issueCertificate(c *gin.Context, supabase *postgrest.ClientWithResponses, cert pendingCertificate, authorities map[api.ID]certificateAuthority) (string, string, certificateAuthority, error) {
+	authority, ok := authorities[cert.ProjectId]
+	if !ok {
+		if cert.CaCertificate != nil && cert.CaPrivateKey != nil {
+			authority = certificateAuthority{*cert.CaCertificate, *cert.CaPrivateKey}
+		} else if created, err := setCertificateAuthority(c, supabase, cert.ProjectId); err != nil {
+			return "", "", certificateAuthority{}, err
+		} else {
+			authority = created
+		}
+		authorities[cert.ProjectId] = authority
+	}
+
+	leaf, key, err := certificate.Issue(authority.Certificate, authority.PrivateKey, cert.GeneratorData.CommonName, cert.GeneratorData.Sans, cert.GeneratorData.Days)
+	if err != nil {
+		return "", "", certificateAuthority{}, err
+	}
+	return leaf, key, authority, nil
+}
+
+// setCertificateAuthority creates the ca of a project; if another request created one first, that
+// one is returned instead
+func setCertificateAuthority(c *gin.Context, supabase *postgrest.ClientWithResponses, projectId api.ID) (certificateAuthority, error) {
+	cert, key, err := certificate.NewAuthority(fmt.Sprintf("ProjConf CA (project %s)", projectId))
+	if err != nil {
+		return certificateAuthority{}, err
+	}
+
+	response, err := supabase.PostRpcSetCertificateAuthorityWithResponse(context.Background(), &postgrest.PostRpcSetCertificateAuthorityParams{}, postgrest.PostRpcSetCertificateAuthorityJSONRequestBody{
+		"p_project_id":  projectId,
+		"p_certificate": cert,
+		"p_private_key": key,
+	})
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		return certificateAuthority{}, err
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return certificateAuthority{}, fmt.Errorf("error %d", response.StatusCode())
+	}
+
+	authority, err := parseOne[certificateAuthority](response.Body)
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return certificateAuthority{}, err
+	}
+	return *authority, nil
+}
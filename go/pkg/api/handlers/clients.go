@@ -8,26 +8,301 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/internal/utils"
 	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/consts"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+	"time"
 )
 
+// createdClient is a row returned by public.create_client
+type createdClient struct {
+	ClientId uuid.UUID `json:"client_id"`
+	SecretId uuid.UUID `json:"secret_id"`
+	Secret   string    `json:"secret"`
+}
+
+// clientSecretWithClient is a clients_secrets row with its client, environment
+// and project embedded (see selectClientSelf)
+type clientSecretWithClient struct {
+	Client struct {
+		postgrest.Clients
+		Environment struct {
+			postgrest.Environments
+			Project postgrest.Projects `json:"project"`
+		} `json:"environment"`
+	} `json:"client"`
+}
+
+// createdClientSecret is a row returned by public.create_client_secret
+type createdClientSecret struct {
+	SecretId  uuid.UUID  `json:"secret_id"`
+	Secret    string     `json:"secret"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// selectClientSecret never includes the hash of a client secret
+const selectClientSecret = "id,created_at,client_id,expires_at,revoked_at"
+
+func toClientSecretObject(secret postgrest.ClientsSecrets) api.ClientSecretObject {
+	return api.ClientSecretObject{
+		Id:        secret.Id,
+		CreatedAt: secret.CreatedAt,
+		ClientId:  secret.ClientId,
+		ExpiresAt: secret.ExpiresAt,
+		RevokedAt: secret.RevokedAt,
+	}
+}
+
+const selectClientSelf = "client:clients(*,environment:environments(*,project:projects(*)))"
+
 func (r RouteHandlers) GetV1ClientsSelf(c *gin.Context) {
-	//TODO implement me
-	panic("implement me")
+	secretId, err := uuid.Parse(c.GetHeader(consts.X_CLIENT_SECRET_ID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "not a client",
+			Description: "this endpoint is only available when authenticated as a client",
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.GetClientsSecretsWithResponse(context.Background(), &postgrest.GetClientsSecretsParams{
+		Id:     equals(secretId),
+		Select: utils.Ptr(selectClientSelf),
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if secret, err := parseOne[clientSecretWithClient](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, api.ClientSelfObject{
+			Id:        secret.Client.Id,
+			CreatedAt: secret.Client.CreatedAt,
+			Display:   secret.Client.Display,
+			Environment: api.EnvironmentObject{
+				Id:        secret.Client.Environment.Id,
+				Display:   secret.Client.Environment.Display,
+				ProjectId: secret.Client.Environment.Project.Id,
+			},
+			Project: api.ProjectObject{
+				Id:      secret.Client.Environment.Project.Id,
+				Display: secret.Client.Environment.Project.Display,
+			},
+		})
+	}
 }
 
-func (r RouteHandlers) GetClientsV1(c *gin.Context, environmentId api.ID) {
-	//TODO implement me
-	panic("implement me")
+func (r RouteHandlers) GetClientsV1(c *gin.Context, environmentId api.ID, params api.GetClientsV1Params) {
+	list := newListing(params.Limit, params.Offset, params.Filter, params.Sort, api.GetClientsV1ParamsSortDisplay)
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.GetClientsWithResponse(context.Background(), &postgrest.GetClientsParams{
+		EnvironmentId: equals(environmentId),
+		Display:       list.filter,
+		Order:         list.order,
+		Offset:        list.offset,
+		Limit:         list.limit,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if clients, err := parse[[]postgrest.Clients](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, utils.ForEach(*clients, func(client postgrest.Clients) api.ClientObject {
+			return api.ClientObject{
+				Id:            client.Id,
+				CreatedAt:     client.CreatedAt,
+				Display:       client.Display,
+				EnvironmentId: client.EnvironmentId,
+			}
+		}))
+	}
 }
 
 func (r RouteHandlers) CreateClientV1(c *gin.Context, environmentId api.ID) {
-	//TODO implement me
-	panic("implement me")
+	var req api.CreateClientV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcCreateClientWithResponse(context.Background(), &postgrest.PostRpcCreateClientParams{}, postgrest.PostRpcCreateClientJSONRequestBody{
+		"p_display":        req.Name,
+		"p_environment_id": environmentId,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusConflict {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "duplicate",
+			Description: "an object with this display-name already exists",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if client, err := parseOne[createdClient](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		resp := api.CreateClientResponse{Id: client.ClientId}
+		resp.Secret.Id = client.SecretId
+		resp.Secret.Key = client.Secret
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+func (r RouteHandlers) GetClientSecretsV1(c *gin.Context, params api.GetClientSecretsV1Params) {
+	r.resolveSecrets(c, nil, params.Tag)
+}
+
+func (r RouteHandlers) GetClientSecretsListV1(c *gin.Context, clientId api.ID) {
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.GetClientsSecretsWithResponse(context.Background(), &postgrest.GetClientsSecretsParams{
+		ClientId: equals(clientId),
+		Select:   utils.Ptr(selectClientSecret),
+		Order:    utils.Ptr("created_at.asc"),
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if secrets, err := parse[[]postgrest.ClientsSecrets](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, utils.ForEach(*secrets, toClientSecretObject))
+	}
+}
+
+func (r RouteHandlers) CreateClientSecretV1(c *gin.Context, clientId api.ID) {
+	var req api.CreateClientSecretV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcCreateClientSecretWithResponse(context.Background(), &postgrest.PostRpcCreateClientSecretParams{}, postgrest.PostRpcCreateClientSecretJSONRequestBody{
+		"p_client_id":  clientId,
+		"p_expires_at": req.ExpiresAt, // null never expires
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a client with id='%s' was not found or was not accessible", clientId.String()),
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if secret, err := parseOne[createdClientSecret](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusCreated, api.CreateClientSecretResponse{
+			Id:        secret.SecretId,
+			Key:       secret.Secret,
+			ExpiresAt: secret.ExpiresAt,
+		})
+	}
 }
 
-func (r RouteHandlers) GetClientSecretsV1(c *gin.Context) {
-	//TODO implement me
-	panic("implement me")
+func (r RouteHandlers) RevokeClientSecretV1(c *gin.Context, clientId api.ID, secretId api.ID) {
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcRevokeClientSecretWithResponse(context.Background(), &postgrest.PostRpcRevokeClientSecretParams{}, postgrest.PostRpcRevokeClientSecretJSONRequestBody{
+		"p_client_id": clientId,
+		"p_secret_id": secretId,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a client secret with id='%s' was not found or was not accessible", secretId.String()),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if secret, err := parseOne[postgrest.ClientsSecrets](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, toClientSecretObject(*secret))
+	}
 }
@@ -9,6 +9,8 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -19,6 +21,23 @@ import (
 	"net/http"
 )
 
+func toEnvironmentObject(environment postgrest.Environments) api.EnvironmentObject {
+	return api.EnvironmentObject{
+		Id:        environment.Id,
+		Display:   environment.Display,
+		ParentId:  environment.ParentId,
+		ProjectId: environment.ProjectId,
+	}
+}
+
+// parentColumn is the parent_id patched by an update: left out if it was not sent, null if it was sent as null
+func parentColumn(fields map[string]json.RawMessage, parentId *api.ID) interface{} {
+	if _, ok := fields["parent_id"]; ok && parentId == nil {
+		return json.RawMessage("null")
+	}
+	return parentId
+}
+
 func (r RouteHandlers) DeleteEnvironmentV1(c *gin.Context, id api.ID) {
 	if supabase, err := r.postgrest(c); err != nil {
 		c.JSON(http.StatusInternalServerError, err)
@@ -28,6 +47,11 @@ func (r RouteHandlers) DeleteEnvironmentV1(c *gin.Context, id api.ID) {
 			Error:       "request failed",
 			Description: "a pre-flight error occurred while processing the upstream request",
 		})
+	} else if response.StatusCode() == http.StatusConflict {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "has children",
+			Description: fmt.Sprintf("other environments inherit from the environment with id='%s' (delete or re-parent them first)", id.String()),
+		})
 	} else if response.StatusCode() != http.StatusOK {
 		c.JSON(http.StatusInternalServerError, &api.Error{
 			Error:       "request failed",
@@ -64,17 +88,21 @@ func (r RouteHandlers) GetEnvironmentV1(c *gin.Context, id api.ID) {
 			Description: fmt.Sprintf("an environment with id='%s' was not found or was not accessible", id.String()),
 		})
 	} else {
-		c.JSON(http.StatusOK, api.EnvironmentObject{
-			Id:      (*environments)[0].Id,
-			Display: (*environments)[0].Display,
-		})
+		c.JSON(http.StatusOK, toEnvironmentObject((*environments)[0]))
 	}
 }
 
-func (r RouteHandlers) GetEnvironmentsV1(c *gin.Context, projectId api.ID) {
+func (r RouteHandlers) GetEnvironmentsV1(c *gin.Context, projectId api.ID, params api.GetEnvironmentsV1Params) {
+	list := newListing(params.Limit, params.Offset, params.Filter, params.Sort, api.GetEnvironmentsV1ParamsSortDisplay)
 	if supabase, err := r.postgrest(c); err != nil {
 		c.JSON(http.StatusInternalServerError, err)
-	} else if response, err := supabase.GetEnvironmentsWithResponse(context.Background(), &postgrest.GetEnvironmentsParams{ProjectId: equals(projectId)}); err != nil {
+	} else if response, err := supabase.GetEnvironmentsWithResponse(context.Background(), &postgrest.GetEnvironmentsParams{
+		ProjectId: equals(projectId),
+		Display:   list.filter,
+		Order:     list.order,
+		Offset:    list.offset,
+		Limit:     list.limit,
+	}); err != nil {
 		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
 		c.JSON(http.StatusInternalServerError, &api.Error{
 			Error:       "request failed",
@@ -92,12 +120,7 @@ func (r RouteHandlers) GetEnvironmentsV1(c *gin.Context, projectId api.ID) {
 			Description: "an error occurred while processing the upstream response",
 		})
 	} else {
-		c.JSON(http.StatusOK, utils.ForEach(*environments, func(environment postgrest.Environments) api.EnvironmentObject {
-			return api.EnvironmentObject{
-				Id:      environment.Id,
-				Display: environment.Display,
-			}
-		}))
+		c.JSON(http.StatusOK, utils.ForEach(*environments, toEnvironmentObject))
 	}
 }
 
@@ -110,7 +133,7 @@ func (r RouteHandlers) CreateEnvironmentV1(c *gin.Context, projectId api.ID) {
 		})
 	} else if supabase, err := r.postgrest(c); err != nil {
 		c.JSON(http.StatusInternalServerError, err)
-	} else if response, err := supabase.PostEnvironmentsWithResponse(context.Background(), &postgrest.PostEnvironmentsParams{Prefer: preferFull[postgrest.PostEnvironmentsParamsPrefer]()}, postgrest.PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody{Display: req.Name, Id: uuid.New(), ProjectId: projectId}); err != nil {
+	} else if response, err := supabase.PostEnvironmentsWithResponse(context.Background(), &postgrest.PostEnvironmentsParams{Prefer: preferFull[postgrest.PostEnvironmentsParamsPrefer]()}, postgrest.PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody{Display: req.Name, Id: uuid.New(), ProjectId: projectId, ParentId: req.ParentId}); err != nil {
 		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
 		c.JSON(http.StatusInternalServerError, &api.Error{
 			Error:       "request failed",
@@ -121,6 +144,11 @@ func (r RouteHandlers) CreateEnvironmentV1(c *gin.Context, projectId api.ID) {
 			Error:       "duplicate",
 			Description: "an object with this display-name already exists",
 		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid parent",
+			Description: upstreamMessage(response.Body),
+		})
 	} else if response.StatusCode() != http.StatusCreated {
 		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
 		c.JSON(http.StatusInternalServerError, &api.Error{
@@ -133,12 +161,74 @@ func (r RouteHandlers) CreateEnvironmentV1(c *gin.Context, projectId api.ID) {
 			Error:       "unable to parse response",
 			Description: "an error occurred while processing the upstream response",
 		})
+	} else if err := generateSecrets(c, supabase); err != nil {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "the environment was created, but its keys and certificates could not be generated",
+		})
 	} else {
 		c.JSON(http.StatusCreated, api.IDResponse{Id: environment.Id})
 	}
 }
 
-func (r RouteHandlers) GetEnvironmentSecretsV1(c *gin.Context, environmentId api.ID) {
-	//TODO implement me
-	panic("implement me")
+func (r RouteHandlers) GetEnvironmentSecretsV1(c *gin.Context, environmentId api.ID, params api.GetEnvironmentSecretsV1Params) {
+	r.resolveSecrets(c, &environmentId, params.Tag)
+}
+
+func (r RouteHandlers) UpdateEnvironmentV1(c *gin.Context, id api.ID) {
+	var req api.UpdateEnvironmentV1JSONRequestBody
+	var fields map[string]json.RawMessage
+	if data, err := c.GetRawData(); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if err := errors.Join(json.Unmarshal(data, &req), json.Unmarshal(data, &fields)); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if body, err := patch(map[string]interface{}{"display": req.Name, "parent_id": parentColumn(fields, req.ParentId)}); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PatchEnvironmentsWithBodyWithResponse(context.Background(), &postgrest.PatchEnvironmentsParams{Id: equals(id), Prefer: preferFull[postgrest.PatchEnvironmentsParamsPrefer]()}, "application/json", body); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusConflict {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "duplicate",
+			Description: "an object with this display-name already exists",
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid parent",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if environments, err := parse[[]postgrest.Environments](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if len(*environments) == 0 {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("an environment with id='%s' was not found or was not accessible", id.String()),
+		})
+	} else {
+		c.JSON(http.StatusOK, toEnvironmentObject((*environments)[0]))
+	}
 }
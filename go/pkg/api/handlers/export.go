@@ -0,0 +1,77 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/internal/utils/envfile"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// ExportEnvironmentV1 writes the resolved values of an environment as a file;
+// unlike GetEnvironmentValuesV1 nothing is masked, so exporting the values of
+// sensitive variables is recorded as revealing them. A ConfigMap is not meant to
+// hold secrets, so sensitive values are never exported to one
+func (r RouteHandlers) ExportEnvironmentV1(c *gin.Context, environmentId api.ID, params api.ExportEnvironmentV1Params) {
+	secrets, metadata, ok := r.resolvedSecrets(c, &environmentId, params.Tag)
+	if !ok {
+		return
+	}
+
+	values := make(map[string]string, len(secrets))
+	revealed := make([]uuid.UUID, 0)
+	sensitive := make([]string, 0)
+	for _, secret := range secrets {
+		variable, ok := metadata[secret.VariableId]
+		if !ok {
+			variable.Sensitive = true // unknown variables are treated as sensitive
+		}
+		values[secret.VariableKey] = secret.Value
+		if variable.Sensitive {
+			revealed = append(revealed, secret.VariableId)
+			sensitive = append(sensitive, secret.VariableKey)
+		}
+	}
+
+	if envfile.Format(params.Format) == envfile.ConfigMap && len(sensitive) > 0 {
+		slices.Sort(sensitive)
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "unable to export",
+			Description: fmt.Sprintf("a ConfigMap cannot hold sensitive values (%s); export them as a k8s-secret, or filter them out by tag", strings.Join(sensitive, ", ")),
+		})
+		return
+	}
+
+	name := "projconf"
+	if params.Name != nil {
+		name = *params.Name
+	} else if len(secrets) > 0 {
+		if derived := envfile.Name(secrets[0].ProjectDisplay + "-" + secrets[0].EnvironmentDisplay); derived != "" {
+			name = derived
+		}
+	}
+
+	data, err := envfile.Write(values, envfile.Format(params.Format), name)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "unable to export",
+			Description: err.Error(),
+		})
+		return
+	}
+
+	if len(revealed) > 0 && !r.revealSecrets(c, environmentId, revealed) {
+		return
+	}
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", data)
+}
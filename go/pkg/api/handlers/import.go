@@ -0,0 +1,93 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+)
+
+// importResult is a row returned by public.import_variables
+type importResult struct {
+	Key        string     `json:"key"`
+	Action     string     `json:"action"`
+	VariableId *uuid.UUID `json:"variable_id"`
+	Violation  *string    `json:"violation"`
+}
+
+func toImportResultObject(result importResult) api.ImportResultObject {
+	return api.ImportResultObject{
+		Key:        result.Key,
+		Action:     api.ImportResultObjectAction(result.Action),
+		VariableId: result.VariableId,
+		Violation:  result.Violation,
+	}
+}
+
+// ImportEnvironmentV1 imports variables (and their values in the environment) in
+// a single rpc, so that a failure part way through rolls the whole import back
+func (r RouteHandlers) ImportEnvironmentV1(c *gin.Context, environmentId api.ID) {
+	var req api.ImportEnvironmentV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcImportVariablesWithResponse(context.Background(), &postgrest.PostRpcImportVariablesParams{}, postgrest.PostRpcImportVariablesJSONRequestBody{
+		"p_project_id":     req.ProjectId,
+		"p_environment_id": environmentId,
+		"p_entries":        req.Variables,
+		"p_conflict":       utils.Deref(req.Conflict, api.ImportConflictPolicyFail),
+		"p_sensitive":      utils.Deref(req.Sensitive, true),
+		"p_dry_run":        utils.Deref(req.DryRun, false),
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("environment id='%s' was not found in project id='%s' or was not accessible", environmentId.String(), req.ProjectId.String()),
+		})
+	} else if response.StatusCode() == http.StatusConflict {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "conflict",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid import",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if results, err := parse[[]importResult](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, utils.ForEach(*results, toImportResultObject))
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/train360-corp/projconf/go/internal/utils/keypair"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+)
+
+// pendingKeypair is a row returned by public.pending_keypairs
+type pendingKeypair struct {
+	EnvironmentId    api.ID `json:"environment_id"`
+	VariableId       api.ID `json:"variable_id"`
+	PublicVariableId api.ID `json:"public_variable_id"`
+	GeneratorData    struct {
+		Alg    string `json:"alg"`
+		Bits   int    `json:"bits"`
+		Format string `json:"format"`
+	} `json:"generator_data"`
+}
+
+// generateKeypair generates both keys of a keypair
+func generateKeypair(pair pendingKeypair) (string, string, error) {
+	return keypair.Generate(pair.GeneratorData.Alg, pair.GeneratorData.Bits, pair.GeneratorData.Format)
+}
+
+// generateKeypairs generates and stores the keys of every keypair still waiting for them (see
+// public.pending_keypairs); the database cannot generate keys itself, so this follows every request
+// that can create or regenerate a keypair.
+func generateKeypairs(c *gin.Context, supabase *postgrest.ClientWithResponses) error {
+	response, err := supabase.PostRpcPendingKeypairsWithResponse(context.Background(), &postgrest.PostRpcPendingKeypairsParams{}, postgrest.PostRpcPendingKeypairsJSONRequestBody{})
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		return err
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return fmt.Errorf("error %d", response.StatusCode())
+	}
+
+	pending, err := parse[[]pendingKeypair](response.Body)
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return err
+	}
+
+	for _, pair := range *pending {
+		private, public, err := generateKeypair(pair)
+		if err != nil {
+			return err
+		}
+
+		response, err := supabase.PostRpcSetKeypairWithResponse(context.Background(), &postgrest.PostRpcSetKeypairParams{}, postgrest.PostRpcSetKeypairJSONRequestBody{
+			"p_environment_id": pair.EnvironmentId,
+			"p_variable_id":    pair.VariableId,
+			"p_private_key":    private,
+			"p_public_key":     public,
+		})
+		if err != nil {
+			state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+			return err
+		} else if response.StatusCode() != http.StatusOK && response.StatusCode() != http.StatusNoContent {
+			state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+			return fmt.Errorf("error %d", response.StatusCode())
+		}
+	}
+	return nil
+}
+
+// rotatedKeypairs generates the keys of every keypair a rotation re-generates (see
+// public.keypairs_to_rotate), by variable id, for the rotation to write with everything else
+func rotatedKeypairs(c *gin.Context, supabase *postgrest.ClientWithResponses, rotation postgrest.PostRpcKeypairsToRotateJSONRequestBody) (map[string]string, error) {
+	response, err := supabase.PostRpcKeypairsToRotateWithResponse(context.Background(), &postgrest.PostRpcKeypairsToRotateParams{}, rotation)
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		return nil, err
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return nil, fmt.Errorf("error %d", response.StatusCode())
+	}
+
+	pairs, err := parse[[]pendingKeypair](response.Body)
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return nil, err
+	}
+
+	values := make(map[string]string, 2*len(*pairs))
+	for _, pair := range *pairs {
+		private, public, err := generateKeypair(pair)
+		if err != nil {
+			return nil, err
+		}
+		values[pair.VariableId.String()] = private
+		values[pair.PublicVariableId.String()] = public
+	}
+	return values, nil
+}
@@ -19,10 +19,16 @@ import (
 	"net/http"
 )
 
-func (r RouteHandlers) GetProjectsV1(c *gin.Context) {
+func (r RouteHandlers) GetProjectsV1(c *gin.Context, params api.GetProjectsV1Params) {
+	list := newListing(params.Limit, params.Offset, params.Filter, params.Sort, api.GetProjectsV1ParamsSortDisplay)
 	if supabase, err := r.postgrest(c); err != nil {
 		c.JSON(http.StatusInternalServerError, err)
-	} else if response, err := supabase.GetProjectsWithResponse(context.Background(), &postgrest.GetProjectsParams{}); err != nil {
+	} else if response, err := supabase.GetProjectsWithResponse(context.Background(), &postgrest.GetProjectsParams{
+		Display: list.filter,
+		Order:   list.order,
+		Offset:  list.offset,
+		Limit:   list.limit,
+	}); err != nil {
 		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
 		c.JSON(http.StatusInternalServerError, &api.Error{
 			Error:       "request failed",
@@ -137,3 +143,53 @@ func (r RouteHandlers) DeleteProjectV1(c *gin.Context, projectId api.ID) {
 		c.JSON(http.StatusOK, success)
 	}
 }
+
+func (r RouteHandlers) UpdateProjectV1(c *gin.Context, projectId api.ID) {
+	var req api.UpdateProjectV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if body, err := patch(map[string]interface{}{"display": req.Name}); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PatchProjectsWithBodyWithResponse(context.Background(), &postgrest.PatchProjectsParams{Id: equals(projectId), Prefer: preferFull[postgrest.PatchProjectsParamsPrefer]()}, "application/json", body); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusConflict {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "duplicate",
+			Description: "an object with this display-name already exists",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if projects, err := parse[[]postgrest.Projects](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if len(*projects) == 0 {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a project with id='%s' was not found or was not accessible", projectId.String()),
+		})
+	} else {
+		c.JSON(http.StatusOK, api.ProjectObject{
+			Id:      (*projects)[0].Id,
+			Display: (*projects)[0].Display,
+		})
+	}
+}
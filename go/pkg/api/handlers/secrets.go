@@ -0,0 +1,601 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/internal/utils/interpolate"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// resolvedSecret is a row returned by public.resolve_secrets
+type resolvedSecret struct {
+	Id                 uuid.UUID `json:"id"`
+	Value              string    `json:"value"`
+	VariableId         uuid.UUID `json:"variable_id"`
+	VariableKey        string    `json:"variable_key"`
+	EnvironmentId      uuid.UUID `json:"environment_id"`
+	EnvironmentDisplay string    `json:"environment_display"`
+	ProjectId          uuid.UUID `json:"project_id"`
+	ProjectDisplay     string    `json:"project_display"`
+
+	// the environment the value came from (differs from the environment if it is inherited)
+	SourceEnvironmentId      uuid.UUID `json:"source_environment_id"`
+	SourceEnvironmentDisplay string    `json:"source_environment_display"`
+}
+
+func toSecretObject(secret resolvedSecret) api.SecretObject {
+	var obj api.SecretObject
+	obj.Id = secret.Id
+	obj.Value = secret.Value
+	obj.Variable.Id = secret.VariableId
+	obj.Variable.Key = secret.VariableKey
+	obj.Environment.Id = secret.EnvironmentId
+	obj.Environment.Display = secret.EnvironmentDisplay
+	obj.Environment.Project.Id = secret.ProjectId
+	obj.Environment.Project.Display = secret.ProjectDisplay
+	obj.Source.Id = secret.SourceEnvironmentId
+	obj.Source.Display = secret.SourceEnvironmentDisplay
+	return obj
+}
+
+// resolveSecrets writes the decrypted secrets visible to the caller (optionally
+// limited to a single environment, and to the variables with every given tag)
+// as a list of api.SecretObject
+func (r RouteHandlers) resolveSecrets(c *gin.Context, environmentId *api.ID, tags *api.TagFilter) {
+	if secrets, _, ok := r.resolvedSecrets(c, environmentId, tags); ok {
+		c.JSON(http.StatusOK, utils.ForEach(secrets, toSecretObject))
+	}
+}
+
+// variableMetadata is a row returned by public.variable_metadata
+type variableMetadata struct {
+	VariableId   uuid.UUID `json:"variable_id"`
+	VariableKey  string    `json:"variable_key"`
+	Tags         []string  `json:"tags"`
+	Sensitive    bool      `json:"sensitive"`
+	Interpolated bool      `json:"interpolated"`
+	Pending      bool      `json:"pending"`
+}
+
+// variablesMetadata returns the tags and sensitivity of the variables (of the
+// environment, or the caller's own), whether their values are interpolated and
+// whether they are still waiting for their keys, keyed by their id. On failure, the error response has already been written
+func (r RouteHandlers) variablesMetadata(c *gin.Context, environmentId *api.ID) (map[uuid.UUID]variableMetadata, bool) {
+	args := postgrest.PostRpcVariableMetadataJSONRequestBody{}
+	if environmentId != nil {
+		args["p_environment_id"] = *environmentId
+	}
+
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcVariableMetadataWithResponse(context.Background(), &postgrest.PostRpcVariableMetadataParams{}, args); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if variables, err := parse[[]variableMetadata](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		metadata := make(map[uuid.UUID]variableMetadata, len(*variables))
+		for _, variable := range *variables {
+			metadata[variable.VariableId] = variable
+		}
+		return metadata, true
+	}
+	return nil, false
+}
+
+// hasTags reports whether every one of the wanted tags is among the tags
+func hasTags(tags []string, wanted []api.Tag) bool {
+	for _, tag := range wanted {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// resolvedSecrets returns the decrypted and interpolated secrets of an environment
+// (or the caller's own), limited to the variables with every given tag, along with
+// the metadata of its variables; on failure, the error response has already been written
+func (r RouteHandlers) resolvedSecrets(c *gin.Context, environmentId *api.ID, tags *api.TagFilter) ([]resolvedSecret, map[uuid.UUID]variableMetadata, bool) {
+	args := postgrest.PostRpcResolveSecretsJSONRequestBody{}
+	if environmentId != nil {
+		args["p_environment_id"] = *environmentId
+	}
+
+	metadata, ok := r.variablesMetadata(c, environmentId)
+	if !ok {
+		return nil, nil, false
+	}
+
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcResolveSecretsWithResponse(context.Background(), &postgrest.PostRpcResolveSecretsParams{}, args); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if secrets, err := parse[[]resolvedSecret](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if resolved, err := interpolateSecrets(*secrets, metadata, tags); errors.As(err, new(pendingError)) {
+		c.JSON(http.StatusServiceUnavailable, &api.Error{
+			Error:       "not generated yet",
+			Description: err.Error(),
+		})
+	} else if err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid reference",
+			Description: err.Error(),
+		})
+	} else {
+		return resolved, metadata, true
+	}
+	return nil, nil, false
+}
+
+// interpolateSecrets resolves the ${KEY} references of the secrets of an environment with every
+// given tag, returning those; only values written by hand (and those of STATIC variables) are
+// interpolated, generated ones are taken as they are. The values the wanted ones reference are
+// resolved too, whatever their tags, but no others: a reference that cannot be resolved only
+// fails the keys that need it (see interpolate.ResolveKeys). A value that references a sensitive
+// one becomes sensitive itself (see taintSensitive), and one that is (or references) a value still
+// waiting for its keys is not served empty, but fails with a pendingError
+func interpolateSecrets(secrets []resolvedSecret, metadata map[uuid.UUID]variableMetadata, tags *api.TagFilter) ([]resolvedSecret, error) {
+	templates := make(map[string]string, len(secrets))
+	literals := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		if metadata[secret.VariableId].Interpolated {
+			templates[secret.VariableKey] = secret.Value
+		} else {
+			literals[secret.VariableKey] = secret.Value
+		}
+	}
+	taintSensitive(secrets, templates, metadata)
+
+	wanted := make([]resolvedSecret, 0, len(secrets))
+	keys := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		if tags == nil || hasTags(metadata[secret.VariableId].Tags, *tags) {
+			wanted = append(wanted, secret)
+			keys = append(keys, secret.VariableKey)
+		}
+	}
+
+	if pending := pendingKeys(secrets, templates, metadata, keys); len(pending) > 0 {
+		return nil, pending
+	}
+
+	resolved, errs := interpolate.ResolveKeys(templates, literals, keys)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	for i := range wanted {
+		wanted[i].Value = resolved[wanted[i].VariableKey]
+	}
+	return wanted, nil
+}
+
+// pendingError lists the keys that have no value yet, waiting for keys or certificates the server
+// has not generated (see generateSecrets)
+type pendingError []string
+
+func (e pendingError) Error() string {
+	return fmt.Sprintf("%s: waiting for keys or certificates the server has not generated yet (they are generated when the server starts, and with every change that needs them)", strings.Join(e, ", "))
+}
+
+// pendingKeys returns the keys that are, or reference (directly or through other references), a
+// value still waiting for its keys, sorted
+func pendingKeys(secrets []resolvedSecret, templates map[string]string, metadata map[uuid.UUID]variableMetadata, keys []string) pendingError {
+	pending := make(map[string]bool, len(secrets))
+	for _, secret := range secrets {
+		pending[secret.VariableKey] = metadata[secret.VariableId].Pending
+	}
+
+	var waiting func(key string, visited map[string]bool) bool
+	waiting = func(key string, visited map[string]bool) bool {
+		if pending[key] {
+			return true
+		} else if template, ok := templates[key]; !ok || visited[key] {
+			return false
+		} else {
+			visited[key] = true
+			return slices.ContainsFunc(interpolate.References(template), func(ref string) bool { return waiting(ref, visited) })
+		}
+	}
+
+	var found pendingError
+	for _, key := range keys {
+		if waiting(key, map[string]bool{}) {
+			found = append(found, key)
+		}
+	}
+	slices.Sort(found)
+	return slices.Compact(found)
+}
+
+// taintSensitive marks the variables whose templates reference a sensitive variable, directly or
+// through other references, as sensitive in the metadata, so their resolved values are masked
+// (and revealed) like the values they contain. Unknown variables are treated as sensitive
+func taintSensitive(secrets []resolvedSecret, templates map[string]string, metadata map[uuid.UUID]variableMetadata) {
+	ids := make(map[string]uuid.UUID, len(secrets))
+	sensitive := make(map[string]bool, len(secrets))
+	for _, secret := range secrets {
+		variable, ok := metadata[secret.VariableId]
+		ids[secret.VariableKey] = secret.VariableId
+		sensitive[secret.VariableKey] = !ok || variable.Sensitive
+	}
+
+	// until no template is newly tainted, as references can be nested
+	for tainted := true; tainted; {
+		tainted = false
+		for key, template := range templates {
+			if sensitive[key] {
+				continue
+			}
+			for _, ref := range interpolate.References(template) {
+				if sensitive[ref] {
+					sensitive[key] = true
+					tainted = true
+					break
+				}
+			}
+		}
+	}
+
+	for key, id := range ids {
+		if variable, ok := metadata[id]; ok && sensitive[key] && !variable.Sensitive {
+			variable.Sensitive = true
+			metadata[id] = variable
+		}
+	}
+}
+
+func (r RouteHandlers) SetEnvironmentSecretV1(c *gin.Context, environmentId api.ID, variableId api.ID) {
+	var req api.SetEnvironmentSecretV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcSetSecretWithResponse(context.Background(), &postgrest.PostRpcSetSecretParams{}, postgrest.PostRpcSetSecretJSONRequestBody{
+		"p_environment_id": environmentId,
+		"p_variable_id":    variableId,
+		"p_value":          req.Value,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a secret for variable id='%s' in environment id='%s' was not found or was not accessible", variableId.String(), environmentId.String()),
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid value",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if secret, err := parseOne[resolvedSecret](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, toSecretObject(*secret))
+	}
+}
+
+func (r RouteHandlers) UnsetEnvironmentSecretV1(c *gin.Context, environmentId api.ID, variableId api.ID) {
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcUnsetSecretWithResponse(context.Background(), &postgrest.PostRpcUnsetSecretParams{}, postgrest.PostRpcUnsetSecretJSONRequestBody{
+		"p_environment_id": environmentId,
+		"p_variable_id":    variableId,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a secret for variable id='%s' in environment id='%s' was not found or was not accessible", variableId.String(), environmentId.String()),
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "not inheritable",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if secret, err := parseOne[resolvedSecret](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, toSecretObject(*secret))
+	}
+}
+
+// rotateSecrets re-runs the generator of a generated (non-STATIC) variable in an environment
+// or, without one, of the variables with one of the generators (by default, RANDOM only),
+// returning the rotated secrets. Keys and certificates are generated first and written by the
+// rotation itself, so a rotation either writes every value or none.
+func (r RouteHandlers) rotateSecrets(c *gin.Context, environmentId api.ID, variableId *api.ID, generators *[]api.GeneratorType) (*[]resolvedSecret, bool) {
+	args := postgrest.PostRpcRotateSecretsJSONRequestBody{"p_environment_id": environmentId}
+	if variableId != nil {
+		args["p_variable_id"] = *variableId
+	}
+	if generators != nil && len(*generators) > 0 {
+		args["p_generators"] = *generators
+	}
+
+	supabase, err := r.postgrest(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	if values, err := rotatedValues(c, supabase, args); err == nil {
+		args["p_values"] = values
+	} else {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "the keys and certificates to rotate could not be generated",
+		})
+		return nil, false
+	}
+
+	if response, err := supabase.PostRpcRotateSecretsWithResponse(context.Background(), &postgrest.PostRpcRotateSecretsParams{}, args); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound && variableId != nil {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a secret for variable id='%s' in environment id='%s' was not found or was not accessible", variableId.String(), environmentId.String()),
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "not rotatable",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if secrets, err := parse[[]resolvedSecret](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		return secrets, true
+	}
+	return nil, false
+}
+
+// rotatedValues generates the keys and certificates a rotation writes, by variable id (see
+// rotatedKeypairs and rotatedCertificates)
+func rotatedValues(c *gin.Context, supabase *postgrest.ClientWithResponses, rotation map[string]interface{}) (map[string]string, error) {
+	values, err := rotatedKeypairs(c, supabase, rotation)
+	if err != nil {
+		return nil, err
+	}
+	certificates, err := rotatedCertificates(c, supabase, rotation)
+	if err != nil {
+		return nil, err
+	}
+	for variableId, value := range certificates {
+		values[variableId] = value
+	}
+	return values, nil
+}
+
+// generateSecrets generates the values the database cannot for the secrets waiting for them:
+// keypairs (see generateKeypairs) and certificates (see generateCertificates)
+func generateSecrets(c *gin.Context, supabase *postgrest.ClientWithResponses) error {
+	if err := generateKeypairs(c, supabase); err != nil {
+		return err
+	}
+	return generateCertificates(c, supabase)
+}
+
+// GeneratePendingSecrets generates the keys and certificates of every secret still waiting for
+// them (see generateSecrets) as the bootstrap admin. The server runs it when it starts, for those
+// left behind by a request that failed to generate them, or by a restart before it could
+func GeneratePendingSecrets(ctx context.Context, baseUrl string, apiKey string, adminApiKey string) error {
+	supabase, err := postgrest.GetAdminClient(baseUrl, apiKey, adminApiKey)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "/startup/generate-secrets", nil)
+	if err != nil {
+		return err
+	}
+	// only the request is read (for logging), there is no response to write
+	return generateSecrets(&gin.Context{Request: request}, supabase)
+}
+
+func (r RouteHandlers) RotateEnvironmentSecretsV1(c *gin.Context, environmentId api.ID, params api.RotateEnvironmentSecretsV1Params) {
+	if secrets, ok := r.rotateSecrets(c, environmentId, nil, params.Generator); ok {
+		c.JSON(http.StatusOK, utils.ForEach(*secrets, toSecretObject))
+	}
+}
+
+func (r RouteHandlers) RotateEnvironmentSecretV1(c *gin.Context, environmentId api.ID, variableId api.ID) {
+	if secrets, ok := r.rotateSecrets(c, environmentId, &variableId, nil); !ok {
+		return
+	} else if len(*secrets) != 1 {
+		state.Get().GetLogger().Debugf("[%s] expected 1 rotated secret, got %d", c.Request.URL.Path, len(*secrets))
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, toSecretObject((*secrets)[0]))
+	}
+}
+
+// secretVersion is a row returned by public.secret_versions
+type secretVersion struct {
+	Version        int    `json:"version"`
+	CreatedAt      string `json:"created_at"`
+	ActorType      string `json:"actor_type"`
+	Actor          string `json:"actor"`
+	RolledBackFrom *int   `json:"rolled_back_from"`
+	Current        bool   `json:"current"`
+}
+
+func toSecretVersionObject(version secretVersion) api.SecretVersionObject {
+	return api.SecretVersionObject{
+		Version:        version.Version,
+		CreatedAt:      version.CreatedAt,
+		ActorType:      version.ActorType,
+		Actor:          version.Actor,
+		RolledBackFrom: version.RolledBackFrom,
+		Current:        version.Current,
+	}
+}
+
+func (r RouteHandlers) GetEnvironmentSecretVersionsV1(c *gin.Context, environmentId api.ID, variableId api.ID) {
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcSecretVersionsWithResponse(context.Background(), &postgrest.PostRpcSecretVersionsParams{}, postgrest.PostRpcSecretVersionsJSONRequestBody{
+		"p_environment_id": environmentId,
+		"p_variable_id":    variableId,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a secret for variable id='%s' in environment id='%s' was not found or was not accessible", variableId.String(), environmentId.String()),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if versions, err := parse[[]secretVersion](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, utils.ForEach(*versions, toSecretVersionObject))
+	}
+}
+
+func (r RouteHandlers) RollbackEnvironmentSecretV1(c *gin.Context, environmentId api.ID, variableId api.ID) {
+	var req api.RollbackEnvironmentSecretV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcRollbackSecretWithResponse(context.Background(), &postgrest.PostRpcRollbackSecretParams{}, postgrest.PostRpcRollbackSecretJSONRequestBody{
+		"p_environment_id": environmentId,
+		"p_variable_id":    variableId,
+		"p_version":        req.Version,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid value",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if secret, err := parseOne[resolvedSecret](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, toSecretObject(*secret))
+	}
+}
@@ -0,0 +1,211 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestInterpolateSecretsTaintsSensitive(t *testing.T) {
+	type variable struct {
+		value        string
+		sensitive    bool
+		interpolated bool
+	}
+	tests := []struct {
+		name      string
+		variables map[string]variable
+		want      map[string]bool
+	}{
+		{
+			name: "direct reference",
+			variables: map[string]variable{
+				"DB_PASSWORD":  {value: "s3cret", sensitive: true},
+				"DB_HOST":      {value: "db.internal"},
+				"DATABASE_URL": {value: "postgres://app:${DB_PASSWORD}@${DB_HOST}/app", interpolated: true},
+			},
+			want: map[string]bool{"DB_PASSWORD": true, "DB_HOST": false, "DATABASE_URL": true},
+		},
+		{
+			name: "transitive reference",
+			variables: map[string]variable{
+				"TOKEN":  {value: "t0ken", sensitive: true},
+				"HEADER": {value: "Bearer ${TOKEN}", interpolated: true},
+				"CURL":   {value: "curl -H '${HEADER}'", interpolated: true},
+				"URL":    {value: "https://api.internal", interpolated: true},
+			},
+			want: map[string]bool{"TOKEN": true, "HEADER": true, "CURL": true, "URL": false},
+		},
+		{
+			name: "escaped references are not references",
+			variables: map[string]variable{
+				"TOKEN": {value: "t0ken", sensitive: true},
+				"DOCS":  {value: "set $${TOKEN}", interpolated: true},
+			},
+			want: map[string]bool{"TOKEN": true, "DOCS": false},
+		},
+		{
+			name: "generated values are not interpolated",
+			variables: map[string]variable{
+				"TOKEN":  {value: "t0ken", sensitive: true},
+				"RANDOM": {value: "x${TOKEN}"},
+			},
+			want: map[string]bool{"TOKEN": true, "RANDOM": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secrets := make([]resolvedSecret, 0, len(tt.variables))
+			metadata := make(map[uuid.UUID]variableMetadata, len(tt.variables))
+			for key, v := range tt.variables {
+				id := uuid.New()
+				secrets = append(secrets, resolvedSecret{VariableId: id, VariableKey: key, Value: v.value})
+				metadata[id] = variableMetadata{VariableId: id, VariableKey: key, Sensitive: v.sensitive, Interpolated: v.interpolated}
+			}
+
+			if _, err := interpolateSecrets(secrets, metadata, nil); err != nil {
+				t.Fatalf("interpolateSecrets() error = %v", err)
+			}
+			for _, secret := range secrets {
+				if got := metadata[secret.VariableId].Sensitive; got != tt.want[secret.VariableKey] {
+					t.Errorf("%s sensitive = %v, want %v", secret.VariableKey, got, tt.want[secret.VariableKey])
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolateSecretsResolvesWantedOnly(t *testing.T) {
+	type variable struct {
+		value string
+		tags  []string
+	}
+	variables := map[string]variable{
+		"DB_HOST":      {value: "db.internal"},
+		"DATABASE_URL": {value: "postgres://${DB_HOST}/app", tags: []string{"api"}},
+		"LEGACY":       {value: "echo ${not a reference}", tags: []string{"legacy"}},
+		"BROKEN":       {value: "${MISSING}", tags: []string{"worker"}},
+		"USES_BROKEN":  {value: "[${BROKEN}]", tags: []string{"worker"}},
+	}
+
+	tests := []struct {
+		name string
+		tags *api.TagFilter
+		want map[string]string
+		errs []string
+	}{
+		{
+			name: "values that cannot be resolved are left alone",
+			tags: &api.TagFilter{"api"},
+			want: map[string]string{"DATABASE_URL": "postgres://db.internal/app"},
+		},
+		{
+			name: "an error for every wanted value that cannot be resolved",
+			tags: &api.TagFilter{"worker"},
+			errs: []string{`"BROKEN" references "MISSING"`, `"USES_BROKEN" cannot be resolved: "BROKEN" references "MISSING"`},
+		},
+		{
+			name: "every value",
+			errs: []string{`"BROKEN" references "MISSING"`, `"LEGACY" has an invalid reference`, `"USES_BROKEN" cannot be resolved`},
+		},
+		{
+			name: "no value",
+			tags: &api.TagFilter{"none"},
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secrets := make([]resolvedSecret, 0, len(variables))
+			metadata := make(map[uuid.UUID]variableMetadata, len(variables))
+			for key, v := range variables {
+				id := uuid.New()
+				secrets = append(secrets, resolvedSecret{VariableId: id, VariableKey: key, Value: v.value})
+				metadata[id] = variableMetadata{VariableId: id, VariableKey: key, Tags: v.tags, Interpolated: true}
+			}
+
+			resolved, err := interpolateSecrets(secrets, metadata, tt.tags)
+			if len(tt.errs) > 0 {
+				if err == nil {
+					t.Fatalf("interpolateSecrets() error = nil, want %q", tt.errs)
+				}
+				for _, want := range tt.errs {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("interpolateSecrets() error = %v, want one containing %q", err, want)
+					}
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("interpolateSecrets() error = %v", err)
+			}
+
+			got := make(map[string]string, len(resolved))
+			for _, secret := range resolved {
+				got[secret.VariableKey] = secret.Value
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("interpolateSecrets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterpolateSecretsRefusesPending(t *testing.T) {
+	type variable struct {
+		value        string
+		pending      bool
+		interpolated bool
+		tags         []string
+	}
+	variables := map[string]variable{
+		"JWT_PRIVATE_KEY": {pending: true, tags: []string{"auth"}},
+		"JWT_PUBLIC_KEY":  {pending: true},
+		"JWKS":            {value: `{"keys": [${JWT_PUBLIC_KEY}]}`, interpolated: true, tags: []string{"api"}},
+		"HEADER":          {value: "x-jwks: ${JWKS}", interpolated: true, tags: []string{"api"}},
+		"PORT":            {value: "8080", interpolated: true, tags: []string{"api", "web"}},
+	}
+
+	tests := []struct {
+		name string
+		tags *api.TagFilter
+		want string
+	}{
+		{name: "pending values", tags: &api.TagFilter{"auth"}, want: "JWT_PRIVATE_KEY: waiting"},
+		{name: "values referencing pending ones", tags: &api.TagFilter{"api"}, want: "HEADER, JWKS: waiting"},
+		{name: "every value", want: "HEADER, JWKS, JWT_PRIVATE_KEY, JWT_PUBLIC_KEY: waiting"},
+		{name: "values that are not pending", tags: &api.TagFilter{"web"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secrets := make([]resolvedSecret, 0, len(variables))
+			metadata := make(map[uuid.UUID]variableMetadata, len(variables))
+			for key, v := range variables {
+				id := uuid.New()
+				secrets = append(secrets, resolvedSecret{VariableId: id, VariableKey: key, Value: v.value})
+				metadata[id] = variableMetadata{VariableId: id, VariableKey: key, Tags: v.tags, Interpolated: v.interpolated, Pending: v.pending}
+			}
+
+			_, err := interpolateSecrets(secrets, metadata, tt.tags)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("interpolateSecrets() error = %v", err)
+				}
+			} else if !errors.As(err, new(pendingError)) || !strings.HasPrefix(err.Error(), tt.want) {
+				t.Errorf("interpolateSecrets() error = %v, want a pendingError starting with %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -8,11 +8,17 @@
 package handlers
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/train360-corp/projconf/go/internal/utils"
 	"github.com/train360-corp/projconf/go/pkg/api"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
 func preferFull[T ~string]() *T {
@@ -46,3 +52,73 @@ func parseOne[T any](data []byte) (*T, error) {
 	}
 	return &(*objs)[0], nil
 }
+
+// patch encodes the columns of a partial update as a JSON request body,
+// skipping nil values (fails if there is nothing left to update)
+func patch(columns map[string]interface{}) (io.Reader, error) {
+	for column, value := range columns {
+		if v := reflect.ValueOf(value); !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+			delete(columns, column)
+		}
+	}
+	if len(columns) == 0 {
+		return nil, errors.New("at least one field must be provided")
+	}
+	data, err := json.Marshal(columns)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// upstreamError is the error body returned by postgrest
+type upstreamError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// upstreamMessage extracts the message of a postgrest error body (if any)
+func upstreamMessage(data []byte) string {
+	if e, err := parse[upstreamError](data); err == nil {
+		return e.Message
+	}
+	return ""
+}
+
+// listing is the pagination, filter and sort of a list endpoint, as postgrest query parameters
+type listing struct {
+	limit  *string
+	offset *string
+	order  *string
+	filter *string
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// newListing maps the query parameters of a list endpoint onto postgrest's limit/offset,
+// order (sort is a field name, prefixed with "-" for descending) and a case-insensitive prefix filter
+func newListing[S ~string](limit *api.Limit, offset *api.Offset, filter *string, sort *S, fallback S) listing {
+	var l listing
+	if limit != nil {
+		l.limit = utils.Ptr(strconv.Itoa(*limit))
+	}
+	if offset != nil {
+		l.offset = utils.Ptr(strconv.Itoa(*offset))
+	}
+	if filter != nil && *filter != "" {
+		l.filter = utils.Ptr(fmt.Sprintf("ilike.%s*", likeEscaper.Replace(*filter)))
+	}
+
+	column, direction := string(fallback), "asc"
+	if sort != nil {
+		column = string(*sort)
+	}
+	if strings.HasPrefix(column, "-") {
+		column, direction = column[1:], "desc"
+	}
+
+	// ties are broken by id, so pages are stable
+	l.order = utils.Ptr(fmt.Sprintf("%s.%s,id.asc", column, direction))
+	return l
+}
@@ -0,0 +1,80 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+)
+
+// violation is a row returned by public.validate_secrets
+type violation struct {
+	VariableId  api.ID `json:"variable_id"`
+	VariableKey string `json:"variable_key"`
+	ValueType   string `json:"value_type"`
+	Message     string `json:"message"`
+}
+
+func toViolationObject(v violation) api.ViolationObject {
+	return api.ViolationObject{
+		VariableId:  v.VariableId,
+		VariableKey: v.VariableKey,
+		Type:        api.ValueType(v.ValueType),
+		Message:     v.Message,
+	}
+}
+
+// ValidateEnvironmentV1 checks the resolved values of an environment (references
+// are only known once interpolated, so they cannot all be checked when written)
+func (r RouteHandlers) ValidateEnvironmentV1(c *gin.Context, environmentId api.ID) {
+	secrets, _, ok := r.resolvedSecrets(c, &environmentId, nil)
+	if !ok {
+		return
+	}
+
+	values := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		values[secret.VariableId.String()] = secret.Value
+	}
+
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcValidateSecretsWithResponse(context.Background(), &postgrest.PostRpcValidateSecretsParams{}, postgrest.PostRpcValidateSecretsJSONRequestBody{
+		"p_environment_id": environmentId,
+		"p_values":         values,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if violations, err := parse[[]violation](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, api.ValidationObject{
+			Valid:      len(*violations) == 0,
+			Violations: utils.ForEach(*violations, toViolationObject),
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+)
+
+// GetEnvironmentValuesV1 writes the resolved values of an environment for display,
+// masking those of sensitive variables unless they are (auditably) revealed
+func (r RouteHandlers) GetEnvironmentValuesV1(c *gin.Context, environmentId api.ID, params api.GetEnvironmentValuesV1Params) {
+	reveal := utils.Deref(params.Reveal, false)
+
+	secrets, metadata, ok := r.resolvedSecrets(c, &environmentId, params.Tag)
+	if !ok {
+		return
+	}
+
+	values := make([]api.ValueObject, 0, len(secrets))
+	revealed := make([]uuid.UUID, 0)
+	for _, secret := range secrets {
+		variable, ok := metadata[secret.VariableId]
+		if !ok {
+			variable.Sensitive = true // unknown variables are treated as sensitive
+		}
+		value := api.ValueObject{
+			VariableId:  secret.VariableId,
+			VariableKey: secret.VariableKey,
+			Sensitive:   variable.Sensitive,
+			Masked:      variable.Sensitive && !reveal,
+		}
+		if !value.Masked {
+			value.Value = utils.Ptr(secret.Value)
+		}
+		if variable.Sensitive && reveal {
+			revealed = append(revealed, secret.VariableId)
+		}
+		values = append(values, value)
+	}
+
+	if len(revealed) > 0 && !r.revealSecrets(c, environmentId, revealed) {
+		return
+	}
+	c.JSON(http.StatusOK, values)
+}
+
+// revealSecrets records in the audit log that the values of the variables (sensitive,
+// or interpolating a sensitive one) were revealed. On failure, the error response has
+// already been written
+func (r RouteHandlers) revealSecrets(c *gin.Context, environmentId api.ID, variableIds []uuid.UUID) bool {
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostRpcRevealSecretsWithResponse(context.Background(), &postgrest.PostRpcRevealSecretsParams{}, postgrest.PostRpcRevealSecretsJSONRequestBody{
+		"p_environment_id": environmentId,
+		"p_variable_ids":   variableIds,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK && response.StatusCode() != http.StatusNoContent {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else {
+		return true
+	}
+	return false
+}
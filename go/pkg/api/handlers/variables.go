@@ -8,26 +8,445 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/internal/utils/certificate"
+	"github.com/train360-corp/projconf/go/internal/utils/keypair"
 	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+	"strings"
 )
 
-func (r RouteHandlers) GetVariablesV1(c *gin.Context, projectId api.ID) {
-	//TODO implement me
-	panic("implement me")
+// toVariableObject maps a postgrest variable row onto its api representation
+func toVariableObject(variable postgrest.Variables) api.VariableObject {
+	obj := api.VariableObject{
+		Id:            variable.Id,
+		Key:           variable.Key,
+		Description:   variable.Description,
+		GeneratorType: api.GeneratorType(variable.GeneratorType),
+		ProjectId:     variable.ProjectId,
+		Type:          api.ValueType(variable.ValueType),
+		Tags:          variable.Tags,
+		Owner:         variable.Owner,
+		Sensitive:     variable.Sensitive,
+	}
+	_ = obj.GeneratorData.FromVariableObjectGeneratorData0(variable.GeneratorData)
+	if data, err := json.Marshal(variable.ValueConstraints); err == nil {
+		_ = json.Unmarshal(data, &obj.Constraints)
+	}
+	return obj
+}
+
+// containsTags is the postgrest filter for variables with every one of the tags
+// (tags cannot contain the commas, quotes or braces of an array literal)
+func containsTags(tags *api.TagFilter) *string {
+	if tags == nil || len(*tags) == 0 {
+		return nil
+	}
+	return utils.Ptr(fmt.Sprintf("cs.{%s}", strings.Join(*tags, ",")))
+}
+
+// valueType is the type stored on a variable (values are untyped strings unless
+// a type is given)
+func valueType(t *api.ValueType) postgrest.VariablesValueType {
+	if t == nil {
+		return postgrest.STRING
+	}
+	return postgrest.VariablesValueType(*t)
+}
+
+// valueConstraints converts api constraints into the payload stored on the
+// variable (validated against its type by private.is_valid_value_constraints)
+func valueConstraints(constraints *api.ValueConstraints) (map[string]interface{}, error) {
+	columns := map[string]interface{}{}
+	if constraints == nil {
+		return columns, nil
+	}
+	data, err := json.Marshal(constraints)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &columns); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// generatorData converts an api generator into the type and payload stored on
+// the variable; static values are sent as {"secret": ...} and swapped for a
+// vault-backed {"secret-id": ...} by private.variables_before_actions, which
+// likewise creates the variable named by a keypair's {"public_key": ...} (and
+// those named by a certificate's {"private_key": ..., "ca_bundle": ...})
+func generatorData(generator api.SecretGenerator) (postgrest.VariablesGeneratorType, map[string]interface{}, error) {
+	value, err := generator.ValueByDiscriminator()
+	if err != nil {
+		return "", nil, err
+	}
+	switch g := value.(type) {
+	case api.SecretGeneratorStatic:
+		return postgrest.STATIC, map[string]interface{}{
+			"secret": g.Data,
+		}, nil
+	case api.SecretGeneratorRandom:
+		data := map[string]interface{}{
+			"length":  int(g.Data.Length),
+			"letters": g.Data.Letters,
+			"numbers": g.Data.Numbers,
+			"symbols": g.Data.Symbols,
+		}
+		// the policy is optional (and validated by private.is_valid_random_generator_data)
+		if g.Data.Alphabet != nil {
+			data["alphabet"] = *g.Data.Alphabet
+		}
+		if g.Data.Exclude != nil {
+			data["exclude"] = *g.Data.Exclude
+		}
+		if g.Data.ExcludeAmbiguous != nil {
+			data["exclude_ambiguous"] = *g.Data.ExcludeAmbiguous
+		}
+		if g.Data.MinLowercase != nil {
+			data["min_lowercase"] = *g.Data.MinLowercase
+		}
+		if g.Data.MinUppercase != nil {
+			data["min_uppercase"] = *g.Data.MinUppercase
+		}
+		if g.Data.MinNumbers != nil {
+			data["min_numbers"] = *g.Data.MinNumbers
+		}
+		if g.Data.MinSymbols != nil {
+			data["min_symbols"] = *g.Data.MinSymbols
+		}
+		return postgrest.RANDOM, data, nil
+	case api.SecretGeneratorUuid:
+		return postgrest.UUID, map[string]interface{}{
+			"version": int(g.Data.Version),
+		}, nil
+	case api.SecretGeneratorHex:
+		return postgrest.HEX, map[string]interface{}{
+			"bytes": g.Data.Bytes,
+		}, nil
+	case api.SecretGeneratorBase64:
+		return postgrest.BASE64, map[string]interface{}{
+			"bytes": g.Data.Bytes,
+			"url":   g.Data.Url,
+		}, nil
+	case api.SecretGeneratorKeypair:
+		data := map[string]interface{}{
+			"alg":        string(g.Data.Alg),
+			"format":     string(g.Data.Format),
+			"public_key": g.Data.PublicKey,
+		}
+		bits := keypair.DefaultBits[string(g.Data.Alg)]
+		if g.Data.Bits != nil {
+			bits = *g.Data.Bits
+		}
+		if err := keypair.Validate(string(g.Data.Alg), bits, string(g.Data.Format)); err != nil {
+			return "", nil, err
+		}
+		if bits != 0 {
+			data["bits"] = bits
+		}
+		return postgrest.KEYPAIR, data, nil
+	case api.SecretGeneratorCertificate:
+		days := certificate.DefaultDays
+		if g.Data.Days != nil {
+			days = *g.Data.Days
+		}
+		commonName := ""
+		if g.Data.CommonName != nil {
+			commonName = *g.Data.CommonName
+		}
+		if err := certificate.Validate(commonName, g.Data.Sans, days); err != nil {
+			return "", nil, err
+		}
+		data := map[string]interface{}{
+			"sans":        g.Data.Sans,
+			"days":        days,
+			"private_key": g.Data.PrivateKey,
+			"ca_bundle":   g.Data.CaBundle,
+		}
+		if commonName != "" {
+			data["common-name"] = commonName
+		}
+		return postgrest.CERTIFICATE, data, nil
+	default:
+		return "", nil, fmt.Errorf("unhandled generator type (%T)", value)
+	}
+}
+
+func (r RouteHandlers) GetVariablesV1(c *gin.Context, projectId api.ID, params api.GetVariablesV1Params) {
+	list := newListing(params.Limit, params.Offset, params.Filter, params.Sort, api.GetVariablesV1ParamsSortKey)
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.GetVariablesWithResponse(context.Background(), &postgrest.GetVariablesParams{
+		ProjectId: equals(projectId),
+		Tags:      containsTags(params.Tag),
+		Key:       list.filter,
+		Order:     list.order,
+		Offset:    list.offset,
+		Limit:     list.limit,
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if variables, err := parse[[]postgrest.Variables](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else {
+		c.JSON(http.StatusOK, utils.ForEach(*variables, toVariableObject))
+	}
 }
 
 func (r RouteHandlers) CreateVariableV1(c *gin.Context, projectId api.ID) {
-	//TODO implement me
-	panic("implement me")
+	var req api.CreateVariableV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if generatorType, data, err := generatorData(req.Generator); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid generator",
+			Description: err.Error(),
+		})
+	} else if constraints, err := valueConstraints(req.Constraints); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid constraints",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PostVariablesWithResponse(context.Background(), &postgrest.PostVariablesParams{Prefer: preferFull[postgrest.PostVariablesParamsPrefer]()}, postgrest.PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody{
+		Id:               uuid.New(),
+		Key:              req.Key,
+		ProjectId:        projectId,
+		GeneratorType:    generatorType,
+		GeneratorData:    data,
+		ValueType:        valueType(req.Type),
+		ValueConstraints: constraints,
+		Tags:             utils.Deref(req.Tags, []string{}),
+		Owner:            req.Owner,
+		Sensitive:        utils.Deref(req.Sensitive, true),
+	}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusConflict {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "duplicate",
+			Description: fmt.Sprintf("a variable with key '%s' already exists in this project", req.Key),
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid variable",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusCreated {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if variable, err := parseOne[postgrest.Variables](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if err := generateSecrets(c, supabase); err != nil {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "the variable was created, but its keys and certificates could not be generated",
+		})
+	} else {
+		c.JSON(http.StatusCreated, api.IDResponse{Id: variable.Id})
+	}
 }
 
 func (r RouteHandlers) DeleteVariableV1(c *gin.Context, id api.ID) {
-	//TODO implement me
-	panic("implement me")
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.DeleteVariablesWithResponse(context.Background(), &postgrest.DeleteVariablesParams{Id: equals(id)}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK && response.StatusCode() != http.StatusNoContent {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else {
+		c.JSON(http.StatusOK, success)
+	}
 }
 
 func (r RouteHandlers) GetVariableV1(c *gin.Context, id api.ID) {
-	//TODO implement me
-	panic("implement me")
+	if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.GetVariablesWithResponse(context.Background(), &postgrest.GetVariablesParams{Id: equals(id)}); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: fmt.Sprintf("error %d", response.StatusCode()),
+		})
+	} else if variables, err := parse[[]postgrest.Variables](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if len(*variables) == 0 {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a variable with id='%s' was not found or was not accessible", id.String()),
+		})
+	} else {
+		c.JSON(http.StatusOK, toVariableObject((*variables)[0]))
+	}
+}
+
+// variableColumns converts an update request into the (partial) set of columns
+// to patch on the variable
+func variableColumns(req api.UpdateVariableV1JSONRequestBody) (map[string]interface{}, error) {
+	columns := map[string]interface{}{
+		"key":         req.Key,
+		"description": req.Description,
+		"tags":        req.Tags,
+		"owner":       req.Owner,
+		"sensitive":   req.Sensitive,
+	}
+	// an empty owner removes it (patch would skip a nil one)
+	if req.Owner != nil && *req.Owner == "" {
+		columns["owner"] = json.RawMessage("null")
+	}
+	if req.Generator != nil {
+		generatorType, data, err := generatorData(*req.Generator)
+		if err != nil {
+			return nil, err
+		}
+		columns["generator_type"] = generatorType
+		columns["generator_data"] = data
+	}
+	// constraints only make sense for the type they were written for, so a
+	// new type without constraints starts without any
+	if req.Type != nil {
+		columns["value_type"] = *req.Type
+		columns["value_constraints"] = map[string]interface{}{}
+	}
+	if req.Constraints != nil {
+		constraints, err := valueConstraints(req.Constraints)
+		if err != nil {
+			return nil, err
+		}
+		columns["value_constraints"] = constraints
+	}
+	return columns, nil
+}
+
+// regenerateSecrets replaces the secret of a variable in every environment with
+// a freshly generated value (see public.regenerate_secrets)
+func regenerateSecrets(c *gin.Context, supabase *postgrest.ClientWithResponses, variableId api.ID) error {
+	response, err := supabase.PostRpcRegenerateSecretsWithResponse(context.Background(), &postgrest.PostRpcRegenerateSecretsParams{}, postgrest.PostRpcRegenerateSecretsJSONRequestBody{
+		"p_variable_id": variableId,
+	})
+	if err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		return err
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		return fmt.Errorf("error %d", response.StatusCode())
+	}
+	return nil
+}
+
+func (r RouteHandlers) UpdateVariableV1(c *gin.Context, id api.ID) {
+	var req api.UpdateVariableV1JSONRequestBody
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if columns, err := variableColumns(req); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid generator",
+			Description: err.Error(),
+		})
+	} else if body, err := patch(columns); err != nil {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid request body",
+			Description: err.Error(),
+		})
+	} else if supabase, err := r.postgrest(c); err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+	} else if response, err := supabase.PatchVariablesWithBodyWithResponse(context.Background(), &postgrest.PatchVariablesParams{Id: equals(id), Prefer: preferFull[postgrest.PatchVariablesParamsPrefer]()}, "application/json", body); err != nil {
+		state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "a pre-flight error occurred while processing the upstream request",
+		})
+	} else if response.StatusCode() == http.StatusConflict {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "duplicate",
+			Description: "a variable with this key already exists in this project",
+		})
+	} else if response.StatusCode() == http.StatusBadRequest {
+		c.JSON(http.StatusBadRequest, &api.Error{
+			Error:       "invalid variable",
+			Description: upstreamMessage(response.Body),
+		})
+	} else if response.StatusCode() != http.StatusOK {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "bad response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if variables, err := parse[[]postgrest.Variables](response.Body); err != nil {
+		state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "unable to parse response",
+			Description: "an error occurred while processing the upstream response",
+		})
+	} else if len(*variables) == 0 {
+		c.JSON(http.StatusNotFound, &api.Error{
+			Error:       "not found",
+			Description: fmt.Sprintf("a variable with id='%s' was not found or was not accessible", id.String()),
+		})
+	} else if req.Regenerate != nil && *req.Regenerate && regenerateSecrets(c, supabase, id) != nil {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "the variable was updated, but its secrets could not be regenerated",
+		})
+	} else if err := generateSecrets(c, supabase); err != nil {
+		c.JSON(http.StatusInternalServerError, &api.Error{
+			Error:       "request failed",
+			Description: "the variable was updated, but its keys and certificates could not be generated",
+		})
+	} else {
+		c.JSON(http.StatusOK, toVariableObject((*variables)[0]))
+	}
 }
@@ -0,0 +1,188 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/pkg/api"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/events"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// watchFallbackInterval is how often the event log is checked without being woken up (events held
+	// back behind a long transaction, or no listener); otherwise it is checked when an event is committed
+	watchFallbackInterval = 10 * time.Second
+
+	// watchKeepAlive is how long a stream may be silent before a comment is sent to keep it open
+	watchKeepAlive = 15 * time.Second
+)
+
+// secretEvent is a row returned by public.secret_events
+type secretEvent struct {
+	Id            int64  `json:"id"`
+	CreatedAt     string `json:"created_at"`
+	Action        string `json:"action"`
+	EnvironmentId api.ID `json:"environment_id"`
+	VariableId    api.ID `json:"variable_id"`
+	VariableKey   string `json:"variable_key"`
+}
+
+func toSecretEventObject(event secretEvent) api.SecretEventObject {
+	return api.SecretEventObject{
+		Id:            event.Id,
+		CreatedAt:     event.CreatedAt,
+		Action:        api.SecretEventObjectAction(event.Action),
+		EnvironmentId: event.EnvironmentId,
+		VariableId:    event.VariableId,
+		VariableKey:   event.VariableKey,
+	}
+}
+
+func (r RouteHandlers) WatchClientSecretsV1(c *gin.Context, params api.WatchClientSecretsV1Params) {
+	r.watchSecrets(c, nil, params.LastEventId)
+}
+
+func (r RouteHandlers) WatchEnvironmentSecretsV1(c *gin.Context, environmentId api.ID, params api.WatchEnvironmentSecretsV1Params) {
+	r.watchSecrets(c, &environmentId, params.LastEventId)
+}
+
+// watchSecrets streams the secret events of an environment (or, for a client, its own environment)
+// as server-sent events; the server's listener wakes the stream up when events are committed, and
+// every read is made with the caller's credentials, so a stream ends as soon as they are revoked
+// or expire
+func (r RouteHandlers) watchSecrets(c *gin.Context, environmentId *api.ID, lastEventId *api.LastEventId) {
+
+	// the header is what EventSource sends when it reconnects
+	after := lastEventId
+	if header := strings.TrimSpace(c.GetHeader("Last-Event-ID")); header != "" {
+		id, err := strconv.ParseInt(header, 10, 64)
+		if err != nil || id < 0 {
+			c.JSON(http.StatusBadRequest, &api.Error{
+				Error:       "invalid Last-Event-ID",
+				Description: fmt.Sprintf("\"%s\" is not an event id", header),
+			})
+			return
+		}
+		after = &id
+	}
+
+	args := postgrest.PostRpcSecretEventsJSONRequestBody{}
+	if environmentId != nil {
+		args["p_environment_id"] = *environmentId
+	}
+
+	supabase, err := r.postgrest(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, err)
+		return
+	}
+
+	// a new watcher starts from the most recent event
+	if after == nil {
+		if response, err := supabase.PostRpcSecretEventsCursorWithResponse(context.Background(), &postgrest.PostRpcSecretEventsCursorParams{}, postgrest.PostRpcSecretEventsCursorJSONRequestBody(args)); err != nil {
+			state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+			c.JSON(http.StatusInternalServerError, &api.Error{
+				Error:       "request failed",
+				Description: "a pre-flight error occurred while processing the upstream request",
+			})
+			return
+		} else if response.StatusCode() == http.StatusBadRequest {
+			c.JSON(http.StatusBadRequest, &api.Error{
+				Error:       "unable to watch secrets",
+				Description: upstreamMessage(response.Body),
+			})
+			return
+		} else if response.StatusCode() != http.StatusOK {
+			state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+			c.JSON(http.StatusInternalServerError, &api.Error{
+				Error:       "request failed",
+				Description: fmt.Sprintf("error %d", response.StatusCode()),
+			})
+			return
+		} else if cursor, err := parse[int64](response.Body); err != nil {
+			state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+			c.JSON(http.StatusInternalServerError, &api.Error{
+				Error:       "unable to parse response",
+				Description: "an error occurred while processing the upstream response",
+			})
+			return
+		} else {
+			after = cursor
+		}
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	wake, unsubscribe := events.Subscribe(environmentId)
+	defer unsubscribe()
+	ticker := time.NewTicker(watchFallbackInterval)
+	defer ticker.Stop()
+	lastWrite := time.Now()
+
+	for first := true; ; first = false {
+		if !first {
+			select {
+			case <-c.Request.Context().Done():
+				return
+			case <-wake:
+			case <-ticker.C:
+			}
+		}
+
+		args["p_after"] = *after
+		response, err := supabase.PostRpcSecretEventsWithResponse(c.Request.Context(), &postgrest.PostRpcSecretEventsParams{}, args)
+		if err != nil {
+			if c.Request.Context().Err() == nil {
+				state.Get().GetLogger().Debugf("[%s] request failed: %v", c.Request.URL.Path, err)
+			}
+			return
+		} else if response.StatusCode() != http.StatusOK {
+			state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+			fmt.Fprintf(c.Writer, "event: error\ndata: %s\n\n", upstreamMessage(response.Body))
+			c.Writer.Flush()
+			return
+		}
+
+		events, err := parse[[]secretEvent](response.Body)
+		if err != nil {
+			state.Get().GetLogger().Debugf("[%d] %s", response.StatusCode(), response.Body)
+			return
+		}
+
+		for _, event := range utils.ForEach(*events, toSecretEventObject) {
+			data, err := json.Marshal(event)
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(c.Writer, "id: %d\nevent: secret\ndata: %s\n\n", event.Id, data)
+			after = &event.Id
+			lastWrite = time.Now()
+		}
+
+		if time.Since(lastWrite) >= watchKeepAlive {
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+			lastWrite = time.Now()
+		}
+		c.Writer.Flush()
+	}
+}
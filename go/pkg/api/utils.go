@@ -9,6 +9,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"github.com/train360-corp/projconf/go/internal/flags"
 	"github.com/train360-corp/projconf/go/pkg/consts"
 	"net/http"
@@ -35,3 +36,46 @@ func From(hostname string, adminApiKey string, clientSecretId string, clientSecr
 		return nil
 	}))
 }
+
+// PageSize is the number of rows requested at a time by Paginate
+const PageSize = 100
+
+// Paginate requests consecutive pages from fetch until a page comes back short, and returns all rows
+func Paginate[T any](fetch func(limit Limit, offset Offset) ([]T, error)) ([]T, error) {
+	var rows []T
+	for offset := 0; ; offset += PageSize {
+		page, err := fetch(PageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, page...)
+		if len(page) < PageSize {
+			return rows, nil
+		}
+	}
+}
+
+// Page returns the rows of a successful list response, or the API error of a failed one
+func Page[T any](rows *[]T, resp any) ([]T, error) {
+	if rows == nil {
+		return nil, errors.New(GetAPIError(resp))
+	}
+	return *rows, nil
+}
+
+// Optional returns a pointer to v, or nil if v is the zero value (i.e. an unset flag)
+func Optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// OptionalSlice returns a pointer to v, or nil if v is empty (i.e. an unset flag)
+func OptionalSlice[T any](v []T) *[]T {
+	if len(v) == 0 {
+		return nil
+	}
+	return &v
+}
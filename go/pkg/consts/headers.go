@@ -10,3 +10,4 @@ package consts
 const X_ADMIN_API_KEY = "x-admin-api-key"
 const X_CLIENT_SECRET_ID = "x-client-secret-id"
 const X_CLIENT_SECRET = "x-client-secret"
+const X_PROJCONF_SOURCE_IP = "x-projconf-source-ip"
@@ -19,8 +19,24 @@ import (
 
 // Defines values for VariablesGeneratorType.
 const (
-	RANDOM VariablesGeneratorType = "RANDOM"
-	STATIC VariablesGeneratorType = "STATIC"
+	BASE64      VariablesGeneratorType = "BASE64"
+	CERTIFICATE VariablesGeneratorType = "CERTIFICATE"
+	HEX         VariablesGeneratorType = "HEX"
+	KEYPAIR     VariablesGeneratorType = "KEYPAIR"
+	RANDOM      VariablesGeneratorType = "RANDOM"
+	STATIC      VariablesGeneratorType = "STATIC"
+	UUID        VariablesGeneratorType = "UUID"
+)
+
+// Defines values for VariablesValueType.
+const (
+	BOOL   VariablesValueType = "BOOL"
+	ENUM   VariablesValueType = "ENUM"
+	INT    VariablesValueType = "INT"
+	JSON   VariablesValueType = "JSON"
+	REGEX  VariablesValueType = "REGEX"
+	STRING VariablesValueType = "STRING"
+	URL    VariablesValueType = "URL"
 )
 
 // Defines values for DeleteClientsParamsPrefer.
@@ -135,11 +151,156 @@ const (
 	PostProjectsParamsPreferReturnRepresentation       PostProjectsParamsPrefer = "return=representation"
 )
 
+// Defines values for PostRpcCreateClientParamsPrefer.
+const (
+	PostRpcCreateClientParamsPreferParamsSingleObject PostRpcCreateClientParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcResolveSecretsParamsPrefer.
+const (
+	PostRpcResolveSecretsParamsPreferParamsSingleObject PostRpcResolveSecretsParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcRegenerateSecretsParamsPrefer.
+const (
+	PostRpcRegenerateSecretsParamsPreferParamsSingleObject PostRpcRegenerateSecretsParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcRotateSecretsParamsPrefer.
+const (
+	PostRpcRotateSecretsParamsPreferParamsSingleObject PostRpcRotateSecretsParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcCreateClientSecretParamsPrefer.
+const (
+	PostRpcCreateClientSecretParamsPreferParamsSingleObject PostRpcCreateClientSecretParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcRevokeClientSecretParamsPrefer.
+const (
+	PostRpcRevokeClientSecretParamsPreferParamsSingleObject PostRpcRevokeClientSecretParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcIsAdminParamsPrefer.
+const (
+	PostRpcIsAdminParamsPreferParamsSingleObject PostRpcIsAdminParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcAdminApiKeysParamsPrefer.
+const (
+	PostRpcAdminApiKeysParamsPreferParamsSingleObject PostRpcAdminApiKeysParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcCreateAdminApiKeyParamsPrefer.
+const (
+	PostRpcCreateAdminApiKeyParamsPreferParamsSingleObject PostRpcCreateAdminApiKeyParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcRevokeAdminApiKeyParamsPrefer.
+const (
+	PostRpcRevokeAdminApiKeyParamsPreferParamsSingleObject PostRpcRevokeAdminApiKeyParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcAuditRequestParamsPrefer.
+const (
+	PostRpcAuditRequestParamsPreferParamsSingleObject PostRpcAuditRequestParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcAuditLogParamsPrefer.
+const (
+	PostRpcAuditLogParamsPreferParamsSingleObject PostRpcAuditLogParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcSecretVersionsParamsPrefer.
+const (
+	PostRpcSecretVersionsParamsPreferParamsSingleObject PostRpcSecretVersionsParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcRollbackSecretParamsPrefer.
+const (
+	PostRpcRollbackSecretParamsPreferParamsSingleObject PostRpcRollbackSecretParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcSecretEventsParamsPrefer.
+const (
+	PostRpcSecretEventsParamsPreferParamsSingleObject PostRpcSecretEventsParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcSecretEventsCursorParamsPrefer.
+const (
+	PostRpcSecretEventsCursorParamsPreferParamsSingleObject PostRpcSecretEventsCursorParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcPendingKeypairsParamsPrefer.
+const (
+	PostRpcPendingKeypairsParamsPreferParamsSingleObject PostRpcPendingKeypairsParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcPendingCertificatesParamsPrefer.
+const (
+	PostRpcPendingCertificatesParamsPreferParamsSingleObject PostRpcPendingCertificatesParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcRevealSecretsParamsPrefer.
+const (
+	PostRpcRevealSecretsParamsPreferParamsSingleObject PostRpcRevealSecretsParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcImportVariablesParamsPrefer.
+const (
+	PostRpcImportVariablesParamsPreferParamsSingleObject PostRpcImportVariablesParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcCertificatesToRotateParamsPrefer.
+const (
+	PostRpcCertificatesToRotateParamsPreferParamsSingleObject PostRpcCertificatesToRotateParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcKeypairsToRotateParamsPrefer.
+const (
+	PostRpcKeypairsToRotateParamsPreferParamsSingleObject PostRpcKeypairsToRotateParamsPrefer = "params=single-object"
+)
+
 // Defines values for PostRpcSecretsParamsPrefer.
 const (
 	ParamsSingleObject PostRpcSecretsParamsPrefer = "params=single-object"
 )
 
+// Defines values for PostRpcVariableMetadataParamsPrefer.
+const (
+	PostRpcVariableMetadataParamsPreferParamsSingleObject PostRpcVariableMetadataParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcValidateSecretsParamsPrefer.
+const (
+	PostRpcValidateSecretsParamsPreferParamsSingleObject PostRpcValidateSecretsParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcSetCertificateParamsPrefer.
+const (
+	PostRpcSetCertificateParamsPreferParamsSingleObject PostRpcSetCertificateParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcSetCertificateAuthorityParamsPrefer.
+const (
+	PostRpcSetCertificateAuthorityParamsPreferParamsSingleObject PostRpcSetCertificateAuthorityParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcSetKeypairParamsPrefer.
+const (
+	PostRpcSetKeypairParamsPreferParamsSingleObject PostRpcSetKeypairParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcUnsetSecretParamsPrefer.
+const (
+	PostRpcUnsetSecretParamsPreferParamsSingleObject PostRpcUnsetSecretParamsPrefer = "params=single-object"
+)
+
+// Defines values for PostRpcSetSecretParamsPrefer.
+const (
+	PostRpcSetSecretParamsPreferParamsSingleObject PostRpcSetSecretParamsPrefer = "params=single-object"
+)
+
 // Defines values for DeleteSecretsParamsPrefer.
 const (
 	DeleteSecretsParamsPreferReturnMinimal        DeleteSecretsParamsPrefer = "return=minimal"
@@ -216,11 +377,13 @@ type ClientsSecrets struct {
 	// This is a Foreign Key to `clients.id`.<fk table='clients' column='id'/>
 	ClientId  openapi_types.UUID `json:"client_id"`
 	CreatedAt string             `json:"created_at"`
+	ExpiresAt *string            `json:"expires_at,omitempty"`
 	Hash      string             `json:"hash"`
 
 	// Id Note:
 	// This is a Primary Key.<pk/>
-	Id openapi_types.UUID `json:"id"`
+	Id        openapi_types.UUID `json:"id"`
+	RevokedAt *string            `json:"revoked_at,omitempty"`
 }
 
 // Environments defines model for environments.
@@ -232,6 +395,10 @@ type Environments struct {
 	// This is a Primary Key.<pk/>
 	Id openapi_types.UUID `json:"id"`
 
+	// ParentId Note:
+	// This is a Foreign Key to `environments.id`.<fk table='environments' column='id'/>
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+
 	// ProjectId Note:
 	// This is a Foreign Key to `projects.id`.<fk table='projects' column='id'/>
 	ProjectId openapi_types.UUID `json:"project_id"`
@@ -258,6 +425,9 @@ type Secrets struct {
 	// This is a Primary Key.<pk/>
 	Id openapi_types.UUID `json:"id"`
 
+	Inherited  bool `json:"inherited"`
+	Overridden bool `json:"overridden"`
+	Pending    bool `json:"pending"`
 	// VariableId Note:
 	// This is a Foreign Key to `variables.id`.<fk table='variables' column='id'/>
 	VariableId openapi_types.UUID `json:"variable_id"`
@@ -274,14 +444,22 @@ type Variables struct {
 	Id  openapi_types.UUID `json:"id"`
 	Key string             `json:"key"`
 
+	Owner *string `json:"owner,omitempty"`
 	// ProjectId Note:
 	// This is a Foreign Key to `projects.id`.<fk table='projects' column='id'/>
-	ProjectId openapi_types.UUID `json:"project_id"`
+	ProjectId        openapi_types.UUID     `json:"project_id"`
+	Sensitive        bool                   `json:"sensitive"`
+	Tags             []string               `json:"tags"`
+	ValueConstraints map[string]interface{} `json:"value_constraints"`
+	ValueType        VariablesValueType     `json:"value_type"`
 }
 
 // VariablesGeneratorType defines model for Variables.GeneratorType.
 type VariablesGeneratorType string
 
+// VariablesValueType defines model for Variables.ValueType.
+type VariablesValueType string
+
 // DeleteClientsParams defines parameters for DeleteClients.
 type DeleteClientsParams struct {
 	Id            *string `form:"id,omitempty" json:"id,omitempty"`
@@ -360,6 +538,8 @@ type DeleteClientsSecretsParams struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty"`
 	Hash      *string `form:"hash,omitempty" json:"hash,omitempty"`
 	ClientId  *string `form:"client_id,omitempty" json:"client_id,omitempty"`
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty"`
+	RevokedAt *string `form:"revoked_at,omitempty" json:"revoked_at,omitempty"`
 
 	// Prefer Preference
 	Prefer *DeleteClientsSecretsParamsPrefer `json:"Prefer,omitempty"`
@@ -374,6 +554,8 @@ type GetClientsSecretsParams struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty"`
 	Hash      *string `form:"hash,omitempty" json:"hash,omitempty"`
 	ClientId  *string `form:"client_id,omitempty" json:"client_id,omitempty"`
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty"`
+	RevokedAt *string `form:"revoked_at,omitempty" json:"revoked_at,omitempty"`
 
 	// Select Filtering Columns
 	Select *string `form:"select,omitempty" json:"select,omitempty"`
@@ -406,6 +588,8 @@ type PatchClientsSecretsParams struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty"`
 	Hash      *string `form:"hash,omitempty" json:"hash,omitempty"`
 	ClientId  *string `form:"client_id,omitempty" json:"client_id,omitempty"`
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty"`
+	RevokedAt *string `form:"revoked_at,omitempty" json:"revoked_at,omitempty"`
 
 	// Prefer Preference
 	Prefer *PatchClientsSecretsParamsPrefer `json:"Prefer,omitempty"`
@@ -432,6 +616,7 @@ type DeleteEnvironmentsParams struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty"`
 	Display   *string `form:"display,omitempty" json:"display,omitempty"`
 	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+	ParentId  *string `form:"parent_id,omitempty" json:"parent_id,omitempty"`
 
 	// Prefer Preference
 	Prefer *DeleteEnvironmentsParamsPrefer `json:"Prefer,omitempty"`
@@ -446,6 +631,7 @@ type GetEnvironmentsParams struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty"`
 	Display   *string `form:"display,omitempty" json:"display,omitempty"`
 	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+	ParentId  *string `form:"parent_id,omitempty" json:"parent_id,omitempty"`
 
 	// Select Filtering Columns
 	Select *string `form:"select,omitempty" json:"select,omitempty"`
@@ -478,6 +664,7 @@ type PatchEnvironmentsParams struct {
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty"`
 	Display   *string `form:"display,omitempty" json:"display,omitempty"`
 	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+	ParentId  *string `form:"parent_id,omitempty" json:"parent_id,omitempty"`
 
 	// Prefer Preference
 	Prefer *PatchEnvironmentsParamsPrefer `json:"Prefer,omitempty"`
@@ -564,1318 +751,7771 @@ type PostProjectsParams struct {
 // PostProjectsParamsPrefer defines parameters for PostProjects.
 type PostProjectsParamsPrefer string
 
-// PostRpcSecretsJSONBody defines parameters for PostRpcSecrets.
-type PostRpcSecretsJSONBody = map[string]interface{}
+// PostRpcAdminApiKeysJSONBody defines parameters for PostRpcAdminApiKeys.
+type PostRpcAdminApiKeysJSONBody = map[string]interface{}
 
-// PostRpcSecretsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSecrets.
-type PostRpcSecretsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
+// PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcAdminApiKeys.
+type PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSecrets.
-type PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
+// PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcAdminApiKeys.
+type PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PostRpcSecretsParams defines parameters for PostRpcSecrets.
-type PostRpcSecretsParams struct {
+// PostRpcAdminApiKeysParams defines parameters for PostRpcAdminApiKeys.
+type PostRpcAdminApiKeysParams struct {
 	// Prefer Preference
-	Prefer *PostRpcSecretsParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcAdminApiKeysParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// PostRpcSecretsParamsPrefer defines parameters for PostRpcSecrets.
-type PostRpcSecretsParamsPrefer string
+// PostRpcAdminApiKeysParamsPrefer defines parameters for PostRpcAdminApiKeys.
+type PostRpcAdminApiKeysParamsPrefer string
 
-// DeleteSecretsParams defines parameters for DeleteSecrets.
-type DeleteSecretsParams struct {
-	Id            *string `form:"id,omitempty" json:"id,omitempty"`
-	CreatedAt     *string `form:"created_at,omitempty" json:"created_at,omitempty"`
-	VariableId    *string `form:"variable_id,omitempty" json:"variable_id,omitempty"`
-	EnvironmentId *string `form:"environment_id,omitempty" json:"environment_id,omitempty"`
+// PostRpcAuditLogJSONBody defines parameters for PostRpcAuditLog.
+type PostRpcAuditLogJSONBody = map[string]interface{}
 
+// PostRpcAuditLogApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcAuditLog.
+type PostRpcAuditLogApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
+
+// PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcAuditLog.
+type PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
+
+// PostRpcAuditLogParams defines parameters for PostRpcAuditLog.
+type PostRpcAuditLogParams struct {
 	// Prefer Preference
-	Prefer *DeleteSecretsParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcAuditLogParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// DeleteSecretsParamsPrefer defines parameters for DeleteSecrets.
-type DeleteSecretsParamsPrefer string
+// PostRpcAuditLogParamsPrefer defines parameters for PostRpcAuditLog.
+type PostRpcAuditLogParamsPrefer string
 
-// GetSecretsParams defines parameters for GetSecrets.
-type GetSecretsParams struct {
-	Id            *string `form:"id,omitempty" json:"id,omitempty"`
-	CreatedAt     *string `form:"created_at,omitempty" json:"created_at,omitempty"`
-	VariableId    *string `form:"variable_id,omitempty" json:"variable_id,omitempty"`
-	EnvironmentId *string `form:"environment_id,omitempty" json:"environment_id,omitempty"`
+// PostRpcAuditRequestJSONBody defines parameters for PostRpcAuditRequest.
+type PostRpcAuditRequestJSONBody = map[string]interface{}
 
-	// Select Filtering Columns
-	Select *string `form:"select,omitempty" json:"select,omitempty"`
+// PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcAuditRequest.
+type PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	// Order Ordering
-	Order *string `form:"order,omitempty" json:"order,omitempty"`
+// PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcAuditRequest.
+type PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	// Offset Limiting and Pagination
-	Offset *string `form:"offset,omitempty" json:"offset,omitempty"`
+// PostRpcAuditRequestParams defines parameters for PostRpcAuditRequest.
+type PostRpcAuditRequestParams struct {
+	// Prefer Preference
+	Prefer *PostRpcAuditRequestParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	// Limit Limiting and Pagination
-	Limit *string `form:"limit,omitempty" json:"limit,omitempty"`
+// PostRpcAuditRequestParamsPrefer defines parameters for PostRpcAuditRequest.
+type PostRpcAuditRequestParamsPrefer string
 
-	// Range Limiting and Pagination
-	Range *string `json:"Range,omitempty"`
+// PostRpcCertificatesToRotateJSONBody defines parameters for PostRpcCertificatesToRotate.
+type PostRpcCertificatesToRotateJSONBody = map[string]interface{}
 
-	// RangeUnit Limiting and Pagination
-	RangeUnit *string `json:"Range-Unit,omitempty"`
+// PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcCertificatesToRotate.
+type PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
+
+// PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcCertificatesToRotate.
+type PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
+// PostRpcCertificatesToRotateParams defines parameters for PostRpcCertificatesToRotate.
+type PostRpcCertificatesToRotateParams struct {
 	// Prefer Preference
-	Prefer *GetSecretsParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcCertificatesToRotateParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// GetSecretsParamsPrefer defines parameters for GetSecrets.
-type GetSecretsParamsPrefer string
+// PostRpcCertificatesToRotateParamsPrefer defines parameters for PostRpcCertificatesToRotate.
+type PostRpcCertificatesToRotateParamsPrefer string
 
-// PatchSecretsParams defines parameters for PatchSecrets.
-type PatchSecretsParams struct {
-	Id            *string `form:"id,omitempty" json:"id,omitempty"`
-	CreatedAt     *string `form:"created_at,omitempty" json:"created_at,omitempty"`
-	VariableId    *string `form:"variable_id,omitempty" json:"variable_id,omitempty"`
-	EnvironmentId *string `form:"environment_id,omitempty" json:"environment_id,omitempty"`
+// PostRpcCreateAdminApiKeyJSONBody defines parameters for PostRpcCreateAdminApiKey.
+type PostRpcCreateAdminApiKeyJSONBody = map[string]interface{}
+
+// PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcCreateAdminApiKey.
+type PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
+// PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcCreateAdminApiKey.
+type PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
+
+// PostRpcCreateAdminApiKeyParams defines parameters for PostRpcCreateAdminApiKey.
+type PostRpcCreateAdminApiKeyParams struct {
 	// Prefer Preference
-	Prefer *PatchSecretsParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcCreateAdminApiKeyParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// PatchSecretsParamsPrefer defines parameters for PatchSecrets.
-type PatchSecretsParamsPrefer string
+// PostRpcCreateAdminApiKeyParamsPrefer defines parameters for PostRpcCreateAdminApiKey.
+type PostRpcCreateAdminApiKeyParamsPrefer string
 
-// PostSecretsParams defines parameters for PostSecrets.
-type PostSecretsParams struct {
-	// Select Filtering Columns
-	Select *string `form:"select,omitempty" json:"select,omitempty"`
+// PostRpcCreateClientJSONBody defines parameters for PostRpcCreateClient.
+type PostRpcCreateClientJSONBody = map[string]interface{}
 
+// PostRpcCreateClientApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcCreateClient.
+type PostRpcCreateClientApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
+
+// PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcCreateClient.
+type PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
+
+// PostRpcCreateClientParams defines parameters for PostRpcCreateClient.
+type PostRpcCreateClientParams struct {
 	// Prefer Preference
-	Prefer *PostSecretsParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcCreateClientParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// PostSecretsParamsPrefer defines parameters for PostSecrets.
-type PostSecretsParamsPrefer string
+// PostRpcCreateClientParamsPrefer defines parameters for PostRpcCreateClient.
+type PostRpcCreateClientParamsPrefer string
 
-// DeleteVariablesParams defines parameters for DeleteVariables.
-type DeleteVariablesParams struct {
-	Id            *string `form:"id,omitempty" json:"id,omitempty"`
-	Description   *string `form:"description,omitempty" json:"description,omitempty"`
-	Key           *string `form:"key,omitempty" json:"key,omitempty"`
-	GeneratorData *string `form:"generator_data,omitempty" json:"generator_data,omitempty"`
-	GeneratorType *string `form:"generator_type,omitempty" json:"generator_type,omitempty"`
-	ProjectId     *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+// PostRpcCreateClientSecretJSONBody defines parameters for PostRpcCreateClientSecret.
+type PostRpcCreateClientSecretJSONBody = map[string]interface{}
 
+// PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcCreateClientSecret.
+type PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
+
+// PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcCreateClientSecret.
+type PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
+
+// PostRpcCreateClientSecretParams defines parameters for PostRpcCreateClientSecret.
+type PostRpcCreateClientSecretParams struct {
 	// Prefer Preference
-	Prefer *DeleteVariablesParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcCreateClientSecretParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// DeleteVariablesParamsPrefer defines parameters for DeleteVariables.
-type DeleteVariablesParamsPrefer string
+// PostRpcCreateClientSecretParamsPrefer defines parameters for PostRpcCreateClientSecret.
+type PostRpcCreateClientSecretParamsPrefer string
 
-// GetVariablesParams defines parameters for GetVariables.
-type GetVariablesParams struct {
-	Id            *string `form:"id,omitempty" json:"id,omitempty"`
-	Description   *string `form:"description,omitempty" json:"description,omitempty"`
-	Key           *string `form:"key,omitempty" json:"key,omitempty"`
-	GeneratorData *string `form:"generator_data,omitempty" json:"generator_data,omitempty"`
-	GeneratorType *string `form:"generator_type,omitempty" json:"generator_type,omitempty"`
-	ProjectId     *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+// PostRpcImportVariablesJSONBody defines parameters for PostRpcImportVariables.
+type PostRpcImportVariablesJSONBody = map[string]interface{}
 
-	// Select Filtering Columns
-	Select *string `form:"select,omitempty" json:"select,omitempty"`
+// PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcImportVariables.
+type PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	// Order Ordering
-	Order *string `form:"order,omitempty" json:"order,omitempty"`
+// PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcImportVariables.
+type PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	// Offset Limiting and Pagination
-	Offset *string `form:"offset,omitempty" json:"offset,omitempty"`
+// PostRpcImportVariablesParams defines parameters for PostRpcImportVariables.
+type PostRpcImportVariablesParams struct {
+	// Prefer Preference
+	Prefer *PostRpcImportVariablesParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	// Limit Limiting and Pagination
-	Limit *string `form:"limit,omitempty" json:"limit,omitempty"`
+// PostRpcImportVariablesParamsPrefer defines parameters for PostRpcImportVariables.
+type PostRpcImportVariablesParamsPrefer string
 
-	// Range Limiting and Pagination
-	Range *string `json:"Range,omitempty"`
+// PostRpcIsAdminJSONBody defines parameters for PostRpcIsAdmin.
+type PostRpcIsAdminJSONBody = map[string]interface{}
 
-	// RangeUnit Limiting and Pagination
-	RangeUnit *string `json:"Range-Unit,omitempty"`
+// PostRpcIsAdminApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcIsAdmin.
+type PostRpcIsAdminApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
+// PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcIsAdmin.
+type PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
+
+// PostRpcIsAdminParams defines parameters for PostRpcIsAdmin.
+type PostRpcIsAdminParams struct {
 	// Prefer Preference
-	Prefer *GetVariablesParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcIsAdminParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// GetVariablesParamsPrefer defines parameters for GetVariables.
-type GetVariablesParamsPrefer string
+// PostRpcIsAdminParamsPrefer defines parameters for PostRpcIsAdmin.
+type PostRpcIsAdminParamsPrefer string
 
-// PatchVariablesParams defines parameters for PatchVariables.
-type PatchVariablesParams struct {
-	Id            *string `form:"id,omitempty" json:"id,omitempty"`
-	Description   *string `form:"description,omitempty" json:"description,omitempty"`
-	Key           *string `form:"key,omitempty" json:"key,omitempty"`
-	GeneratorData *string `form:"generator_data,omitempty" json:"generator_data,omitempty"`
-	GeneratorType *string `form:"generator_type,omitempty" json:"generator_type,omitempty"`
-	ProjectId     *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+// PostRpcKeypairsToRotateJSONBody defines parameters for PostRpcKeypairsToRotate.
+type PostRpcKeypairsToRotateJSONBody = map[string]interface{}
+
+// PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcKeypairsToRotate.
+type PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
+// PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcKeypairsToRotate.
+type PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
+
+// PostRpcKeypairsToRotateParams defines parameters for PostRpcKeypairsToRotate.
+type PostRpcKeypairsToRotateParams struct {
 	// Prefer Preference
-	Prefer *PatchVariablesParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcKeypairsToRotateParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// PatchVariablesParamsPrefer defines parameters for PatchVariables.
-type PatchVariablesParamsPrefer string
+// PostRpcKeypairsToRotateParamsPrefer defines parameters for PostRpcKeypairsToRotate.
+type PostRpcKeypairsToRotateParamsPrefer string
 
-// PostVariablesParams defines parameters for PostVariables.
-type PostVariablesParams struct {
-	// Select Filtering Columns
-	Select *string `form:"select,omitempty" json:"select,omitempty"`
+// PostRpcPendingCertificatesJSONBody defines parameters for PostRpcPendingCertificates.
+type PostRpcPendingCertificatesJSONBody = map[string]interface{}
+
+// PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcPendingCertificates.
+type PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
+
+// PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcPendingCertificates.
+type PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
+// PostRpcPendingCertificatesParams defines parameters for PostRpcPendingCertificates.
+type PostRpcPendingCertificatesParams struct {
 	// Prefer Preference
-	Prefer *PostVariablesParamsPrefer `json:"Prefer,omitempty"`
+	Prefer *PostRpcPendingCertificatesParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// PostVariablesParamsPrefer defines parameters for PostVariables.
-type PostVariablesParamsPrefer string
+// PostRpcPendingCertificatesParamsPrefer defines parameters for PostRpcPendingCertificates.
+type PostRpcPendingCertificatesParamsPrefer string
 
-// PatchClientsJSONRequestBody defines body for PatchClients for application/json ContentType.
-type PatchClientsJSONRequestBody = Clients
+// PostRpcPendingKeypairsJSONBody defines parameters for PostRpcPendingKeypairs.
+type PostRpcPendingKeypairsJSONBody = map[string]interface{}
 
-// PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchClients for application/vnd.pgrst.object+json ContentType.
-type PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody = Clients
+// PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcPendingKeypairs.
+type PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchClients for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Clients
+// PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcPendingKeypairs.
+type PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PostClientsJSONRequestBody defines body for PostClients for application/json ContentType.
-type PostClientsJSONRequestBody = Clients
+// PostRpcPendingKeypairsParams defines parameters for PostRpcPendingKeypairs.
+type PostRpcPendingKeypairsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcPendingKeypairsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-// PostClientsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostClients for application/vnd.pgrst.object+json ContentType.
-type PostClientsApplicationVndPgrstObjectPlusJSONRequestBody = Clients
+// PostRpcPendingKeypairsParamsPrefer defines parameters for PostRpcPendingKeypairs.
+type PostRpcPendingKeypairsParamsPrefer string
 
-// PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostClients for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Clients
+// PostRpcRegenerateSecretsJSONBody defines parameters for PostRpcRegenerateSecrets.
+type PostRpcRegenerateSecretsJSONBody = map[string]interface{}
 
-// PatchClientsSecretsJSONRequestBody defines body for PatchClientsSecrets for application/json ContentType.
-type PatchClientsSecretsJSONRequestBody = ClientsSecrets
+// PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcRegenerateSecrets.
+type PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchClientsSecrets for application/vnd.pgrst.object+json ContentType.
-type PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody = ClientsSecrets
+// PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcRegenerateSecrets.
+type PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchClientsSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = ClientsSecrets
+// PostRpcRegenerateSecretsParams defines parameters for PostRpcRegenerateSecrets.
+type PostRpcRegenerateSecretsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcRegenerateSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-// PostClientsSecretsJSONRequestBody defines body for PostClientsSecrets for application/json ContentType.
-type PostClientsSecretsJSONRequestBody = ClientsSecrets
+// PostRpcRegenerateSecretsParamsPrefer defines parameters for PostRpcRegenerateSecrets.
+type PostRpcRegenerateSecretsParamsPrefer string
 
-// PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostClientsSecrets for application/vnd.pgrst.object+json ContentType.
-type PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody = ClientsSecrets
+// PostRpcResolveSecretsJSONBody defines parameters for PostRpcResolveSecrets.
+type PostRpcResolveSecretsJSONBody = map[string]interface{}
 
-// PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostClientsSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = ClientsSecrets
+// PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcResolveSecrets.
+type PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PatchEnvironmentsJSONRequestBody defines body for PatchEnvironments for application/json ContentType.
-type PatchEnvironmentsJSONRequestBody = Environments
+// PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcResolveSecrets.
+type PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchEnvironments for application/vnd.pgrst.object+json ContentType.
-type PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody = Environments
+// PostRpcResolveSecretsParams defines parameters for PostRpcResolveSecrets.
+type PostRpcResolveSecretsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcResolveSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-// PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchEnvironments for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Environments
+// PostRpcResolveSecretsParamsPrefer defines parameters for PostRpcResolveSecrets.
+type PostRpcResolveSecretsParamsPrefer string
 
-// PostEnvironmentsJSONRequestBody defines body for PostEnvironments for application/json ContentType.
-type PostEnvironmentsJSONRequestBody = Environments
+// PostRpcRevealSecretsJSONBody defines parameters for PostRpcRevealSecrets.
+type PostRpcRevealSecretsJSONBody = map[string]interface{}
 
-// PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostEnvironments for application/vnd.pgrst.object+json ContentType.
-type PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody = Environments
+// PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcRevealSecrets.
+type PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostEnvironments for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Environments
+// PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcRevealSecrets.
+type PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PatchProjectsJSONRequestBody defines body for PatchProjects for application/json ContentType.
-type PatchProjectsJSONRequestBody = Projects
+// PostRpcRevealSecretsParams defines parameters for PostRpcRevealSecrets.
+type PostRpcRevealSecretsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcRevealSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-// PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchProjects for application/vnd.pgrst.object+json ContentType.
-type PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody = Projects
+// PostRpcRevealSecretsParamsPrefer defines parameters for PostRpcRevealSecrets.
+type PostRpcRevealSecretsParamsPrefer string
 
-// PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchProjects for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Projects
+// PostRpcRevokeAdminApiKeyJSONBody defines parameters for PostRpcRevokeAdminApiKey.
+type PostRpcRevokeAdminApiKeyJSONBody = map[string]interface{}
 
-// PostProjectsJSONRequestBody defines body for PostProjects for application/json ContentType.
-type PostProjectsJSONRequestBody = Projects
+// PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcRevokeAdminApiKey.
+type PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostProjects for application/vnd.pgrst.object+json ContentType.
-type PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody = Projects
+// PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcRevokeAdminApiKey.
+type PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostProjects for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Projects
+// PostRpcRevokeAdminApiKeyParams defines parameters for PostRpcRevokeAdminApiKey.
+type PostRpcRevokeAdminApiKeyParams struct {
+	// Prefer Preference
+	Prefer *PostRpcRevokeAdminApiKeyParamsPrefer `json:"Prefer,omitempty"`
+}
 
-// PostRpcSecretsJSONRequestBody defines body for PostRpcSecrets for application/json ContentType.
-type PostRpcSecretsJSONRequestBody = PostRpcSecretsJSONBody
+// PostRpcRevokeAdminApiKeyParamsPrefer defines parameters for PostRpcRevokeAdminApiKey.
+type PostRpcRevokeAdminApiKeyParamsPrefer string
 
-// PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSecrets for application/vnd.pgrst.object+json ContentType.
-type PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSecretsApplicationVndPgrstObjectPlusJSONBody
+// PostRpcRevokeClientSecretJSONBody defines parameters for PostRpcRevokeClientSecret.
+type PostRpcRevokeClientSecretJSONBody = map[string]interface{}
 
-// PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+// PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcRevokeClientSecret.
+type PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PatchSecretsJSONRequestBody defines body for PatchSecrets for application/json ContentType.
-type PatchSecretsJSONRequestBody = Secrets
+// PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcRevokeClientSecret.
+type PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchSecrets for application/vnd.pgrst.object+json ContentType.
-type PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody = Secrets
+// PostRpcRevokeClientSecretParams defines parameters for PostRpcRevokeClientSecret.
+type PostRpcRevokeClientSecretParams struct {
+	// Prefer Preference
+	Prefer *PostRpcRevokeClientSecretParamsPrefer `json:"Prefer,omitempty"`
+}
 
-// PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Secrets
+// PostRpcRevokeClientSecretParamsPrefer defines parameters for PostRpcRevokeClientSecret.
+type PostRpcRevokeClientSecretParamsPrefer string
 
-// PostSecretsJSONRequestBody defines body for PostSecrets for application/json ContentType.
-type PostSecretsJSONRequestBody = Secrets
+// PostRpcRollbackSecretJSONBody defines parameters for PostRpcRollbackSecret.
+type PostRpcRollbackSecretJSONBody = map[string]interface{}
 
-// PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostSecrets for application/vnd.pgrst.object+json ContentType.
-type PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody = Secrets
+// PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcRollbackSecret.
+type PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Secrets
+// PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcRollbackSecret.
+type PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PatchVariablesJSONRequestBody defines body for PatchVariables for application/json ContentType.
-type PatchVariablesJSONRequestBody = Variables
+// PostRpcRollbackSecretParams defines parameters for PostRpcRollbackSecret.
+type PostRpcRollbackSecretParams struct {
+	// Prefer Preference
+	Prefer *PostRpcRollbackSecretParamsPrefer `json:"Prefer,omitempty"`
+}
 
-// PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchVariables for application/vnd.pgrst.object+json ContentType.
-type PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody = Variables
+// PostRpcRollbackSecretParamsPrefer defines parameters for PostRpcRollbackSecret.
+type PostRpcRollbackSecretParamsPrefer string
 
-// PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchVariables for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Variables
+// PostRpcRotateSecretsJSONBody defines parameters for PostRpcRotateSecrets.
+type PostRpcRotateSecretsJSONBody = map[string]interface{}
 
-// PostVariablesJSONRequestBody defines body for PostVariables for application/json ContentType.
-type PostVariablesJSONRequestBody = Variables
+// PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcRotateSecrets.
+type PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostVariables for application/vnd.pgrst.object+json ContentType.
-type PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody = Variables
+// PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcRotateSecrets.
+type PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-// PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostVariables for application/vnd.pgrst.object+json;nulls=stripped ContentType.
-type PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Variables
+// PostRpcRotateSecretsParams defines parameters for PostRpcRotateSecrets.
+type PostRpcRotateSecretsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcRotateSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// PostRpcRotateSecretsParamsPrefer defines parameters for PostRpcRotateSecrets.
+type PostRpcRotateSecretsParamsPrefer string
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
-}
+// PostRpcSecretEventsJSONBody defines parameters for PostRpcSecretEvents.
+type PostRpcSecretEventsJSONBody = map[string]interface{}
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSecretEvents.
+type PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+// PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSecretEvents.
+type PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+// PostRpcSecretEventsParams defines parameters for PostRpcSecretEvents.
+type PostRpcSecretEventsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcSecretEventsParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// PostRpcSecretEventsParamsPrefer defines parameters for PostRpcSecretEvents.
+type PostRpcSecretEventsParamsPrefer string
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
-}
+// PostRpcSecretEventsCursorJSONBody defines parameters for PostRpcSecretEventsCursor.
+type PostRpcSecretEventsCursorJSONBody = map[string]interface{}
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
-}
+// PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSecretEventsCursor.
+type PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSecretEventsCursor.
+type PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
+
+// PostRpcSecretEventsCursorParams defines parameters for PostRpcSecretEventsCursor.
+type PostRpcSecretEventsCursorParams struct {
+	// Prefer Preference
+	Prefer *PostRpcSecretEventsCursorParamsPrefer `json:"Prefer,omitempty"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// Get request
-	Get(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretEventsCursorParamsPrefer defines parameters for PostRpcSecretEventsCursor.
+type PostRpcSecretEventsCursorParamsPrefer string
 
-	// DeleteClients request
-	DeleteClients(ctx context.Context, params *DeleteClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretVersionsJSONBody defines parameters for PostRpcSecretVersions.
+type PostRpcSecretVersionsJSONBody = map[string]interface{}
 
-	// GetClients request
-	GetClients(ctx context.Context, params *GetClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSecretVersions.
+type PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	// PatchClientsWithBody request with any body
-	PatchClientsWithBody(ctx context.Context, params *PatchClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSecretVersions.
+type PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PatchClients(ctx context.Context, params *PatchClientsParams, body PatchClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretVersionsParams defines parameters for PostRpcSecretVersions.
+type PostRpcSecretVersionsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcSecretVersionsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PatchClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretVersionsParamsPrefer defines parameters for PostRpcSecretVersions.
+type PostRpcSecretVersionsParamsPrefer string
 
-	PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretsJSONBody defines parameters for PostRpcSecrets.
+type PostRpcSecretsJSONBody = map[string]interface{}
 
-	// PostClientsWithBody request with any body
-	PostClientsWithBody(ctx context.Context, params *PostClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSecrets.
+type PostRpcSecretsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	PostClients(ctx context.Context, params *PostClientsParams, body PostClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSecrets.
+type PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PostClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretsParams defines parameters for PostRpcSecrets.
+type PostRpcSecretsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSecretsParamsPrefer defines parameters for PostRpcSecrets.
+type PostRpcSecretsParamsPrefer string
 
-	// DeleteClientsSecrets request
-	DeleteClientsSecrets(ctx context.Context, params *DeleteClientsSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateJSONBody defines parameters for PostRpcSetCertificate.
+type PostRpcSetCertificateJSONBody = map[string]interface{}
 
-	// GetClientsSecrets request
-	GetClientsSecrets(ctx context.Context, params *GetClientsSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSetCertificate.
+type PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	// PatchClientsSecretsWithBody request with any body
-	PatchClientsSecretsWithBody(ctx context.Context, params *PatchClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSetCertificate.
+type PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PatchClientsSecrets(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateParams defines parameters for PostRpcSetCertificate.
+type PostRpcSetCertificateParams struct {
+	// Prefer Preference
+	Prefer *PostRpcSetCertificateParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateParamsPrefer defines parameters for PostRpcSetCertificate.
+type PostRpcSetCertificateParamsPrefer string
 
-	PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateAuthorityJSONBody defines parameters for PostRpcSetCertificateAuthority.
+type PostRpcSetCertificateAuthorityJSONBody = map[string]interface{}
 
-	// PostClientsSecretsWithBody request with any body
-	PostClientsSecretsWithBody(ctx context.Context, params *PostClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSetCertificateAuthority.
+type PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	PostClientsSecrets(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSetCertificateAuthority.
+type PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateAuthorityParams defines parameters for PostRpcSetCertificateAuthority.
+type PostRpcSetCertificateAuthorityParams struct {
+	// Prefer Preference
+	Prefer *PostRpcSetCertificateAuthorityParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetCertificateAuthorityParamsPrefer defines parameters for PostRpcSetCertificateAuthority.
+type PostRpcSetCertificateAuthorityParamsPrefer string
 
-	// DeleteEnvironments request
-	DeleteEnvironments(ctx context.Context, params *DeleteEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetKeypairJSONBody defines parameters for PostRpcSetKeypair.
+type PostRpcSetKeypairJSONBody = map[string]interface{}
 
-	// GetEnvironments request
-	GetEnvironments(ctx context.Context, params *GetEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSetKeypair.
+type PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	// PatchEnvironmentsWithBody request with any body
-	PatchEnvironmentsWithBody(ctx context.Context, params *PatchEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSetKeypair.
+type PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PatchEnvironments(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetKeypairParams defines parameters for PostRpcSetKeypair.
+type PostRpcSetKeypairParams struct {
+	// Prefer Preference
+	Prefer *PostRpcSetKeypairParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetKeypairParamsPrefer defines parameters for PostRpcSetKeypair.
+type PostRpcSetKeypairParamsPrefer string
 
-	PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetSecretJSONBody defines parameters for PostRpcSetSecret.
+type PostRpcSetSecretJSONBody = map[string]interface{}
 
-	// PostEnvironmentsWithBody request with any body
-	PostEnvironmentsWithBody(ctx context.Context, params *PostEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetSecretApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcSetSecret.
+type PostRpcSetSecretApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	PostEnvironments(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcSetSecret.
+type PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetSecretParams defines parameters for PostRpcSetSecret.
+type PostRpcSetSecretParams struct {
+	// Prefer Preference
+	Prefer *PostRpcSetSecretParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcSetSecretParamsPrefer defines parameters for PostRpcSetSecret.
+type PostRpcSetSecretParamsPrefer string
 
-	// DeleteProjects request
-	DeleteProjects(ctx context.Context, params *DeleteProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcUnsetSecretJSONBody defines parameters for PostRpcUnsetSecret.
+type PostRpcUnsetSecretJSONBody = map[string]interface{}
 
-	// GetProjects request
-	GetProjects(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcUnsetSecret.
+type PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	// PatchProjectsWithBody request with any body
-	PatchProjectsWithBody(ctx context.Context, params *PatchProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcUnsetSecret.
+type PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PatchProjects(ctx context.Context, params *PatchProjectsParams, body PatchProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcUnsetSecretParams defines parameters for PostRpcUnsetSecret.
+type PostRpcUnsetSecretParams struct {
+	// Prefer Preference
+	Prefer *PostRpcUnsetSecretParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PatchProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcUnsetSecretParamsPrefer defines parameters for PostRpcUnsetSecret.
+type PostRpcUnsetSecretParamsPrefer string
 
-	PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcValidateSecretsJSONBody defines parameters for PostRpcValidateSecrets.
+type PostRpcValidateSecretsJSONBody = map[string]interface{}
 
-	// PostProjectsWithBody request with any body
-	PostProjectsWithBody(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcValidateSecrets.
+type PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	PostProjects(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcValidateSecrets.
+type PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PostProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcValidateSecretsParams defines parameters for PostRpcValidateSecrets.
+type PostRpcValidateSecretsParams struct {
+	// Prefer Preference
+	Prefer *PostRpcValidateSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcValidateSecretsParamsPrefer defines parameters for PostRpcValidateSecrets.
+type PostRpcValidateSecretsParamsPrefer string
 
-	// GetRpcSecrets request
-	GetRpcSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcVariableMetadataJSONBody defines parameters for PostRpcVariableMetadata.
+type PostRpcVariableMetadataJSONBody = map[string]interface{}
 
-	// PostRpcSecretsWithBody request with any body
-	PostRpcSecretsWithBody(ctx context.Context, params *PostRpcSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONBody defines parameters for PostRpcVariableMetadata.
+type PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONBody = map[string]interface{}
 
-	PostRpcSecrets(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedBody defines parameters for PostRpcVariableMetadata.
+type PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedBody = map[string]interface{}
 
-	PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcVariableMetadataParams defines parameters for PostRpcVariableMetadata.
+type PostRpcVariableMetadataParams struct {
+	// Prefer Preference
+	Prefer *PostRpcVariableMetadataParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostRpcVariableMetadataParamsPrefer defines parameters for PostRpcVariableMetadata.
+type PostRpcVariableMetadataParamsPrefer string
 
-	// DeleteSecrets request
-	DeleteSecrets(ctx context.Context, params *DeleteSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// DeleteSecretsParams defines parameters for DeleteSecrets.
+type DeleteSecretsParams struct {
+	Id            *string `form:"id,omitempty" json:"id,omitempty"`
+	CreatedAt     *string `form:"created_at,omitempty" json:"created_at,omitempty"`
+	VariableId    *string `form:"variable_id,omitempty" json:"variable_id,omitempty"`
+	EnvironmentId *string `form:"environment_id,omitempty" json:"environment_id,omitempty"`
+	Inherited     *string `form:"inherited,omitempty" json:"inherited,omitempty"`
+	Overridden    *string `form:"overridden,omitempty" json:"overridden,omitempty"`
+	Pending       *string `form:"pending,omitempty" json:"pending,omitempty"`
 
-	// GetSecrets request
-	GetSecrets(ctx context.Context, params *GetSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Prefer Preference
+	Prefer *DeleteSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	// PatchSecretsWithBody request with any body
-	PatchSecretsWithBody(ctx context.Context, params *PatchSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// DeleteSecretsParamsPrefer defines parameters for DeleteSecrets.
+type DeleteSecretsParamsPrefer string
 
-	PatchSecrets(ctx context.Context, params *PatchSecretsParams, body PatchSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetSecretsParams defines parameters for GetSecrets.
+type GetSecretsParams struct {
+	Id            *string `form:"id,omitempty" json:"id,omitempty"`
+	CreatedAt     *string `form:"created_at,omitempty" json:"created_at,omitempty"`
+	VariableId    *string `form:"variable_id,omitempty" json:"variable_id,omitempty"`
+	EnvironmentId *string `form:"environment_id,omitempty" json:"environment_id,omitempty"`
+	Inherited     *string `form:"inherited,omitempty" json:"inherited,omitempty"`
+	Overridden    *string `form:"overridden,omitempty" json:"overridden,omitempty"`
+	Pending       *string `form:"pending,omitempty" json:"pending,omitempty"`
 
-	PatchSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Select Filtering Columns
+	Select *string `form:"select,omitempty" json:"select,omitempty"`
 
-	PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Order Ordering
+	Order *string `form:"order,omitempty" json:"order,omitempty"`
 
-	// PostSecretsWithBody request with any body
-	PostSecretsWithBody(ctx context.Context, params *PostSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Offset Limiting and Pagination
+	Offset *string `form:"offset,omitempty" json:"offset,omitempty"`
 
-	PostSecrets(ctx context.Context, params *PostSecretsParams, body PostSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Limit Limiting and Pagination
+	Limit *string `form:"limit,omitempty" json:"limit,omitempty"`
 
-	PostSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Range Limiting and Pagination
+	Range *string `json:"Range,omitempty"`
 
-	PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// RangeUnit Limiting and Pagination
+	RangeUnit *string `json:"Range-Unit,omitempty"`
 
-	// DeleteVariables request
-	DeleteVariables(ctx context.Context, params *DeleteVariablesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Prefer Preference
+	Prefer *GetSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	// GetVariables request
-	GetVariables(ctx context.Context, params *GetVariablesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// GetSecretsParamsPrefer defines parameters for GetSecrets.
+type GetSecretsParamsPrefer string
 
-	// PatchVariablesWithBody request with any body
-	PatchVariablesWithBody(ctx context.Context, params *PatchVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PatchSecretsParams defines parameters for PatchSecrets.
+type PatchSecretsParams struct {
+	Id            *string `form:"id,omitempty" json:"id,omitempty"`
+	CreatedAt     *string `form:"created_at,omitempty" json:"created_at,omitempty"`
+	VariableId    *string `form:"variable_id,omitempty" json:"variable_id,omitempty"`
+	EnvironmentId *string `form:"environment_id,omitempty" json:"environment_id,omitempty"`
+	Inherited     *string `form:"inherited,omitempty" json:"inherited,omitempty"`
+	Overridden    *string `form:"overridden,omitempty" json:"overridden,omitempty"`
+	Pending       *string `form:"pending,omitempty" json:"pending,omitempty"`
 
-	PatchVariables(ctx context.Context, params *PatchVariablesParams, body PatchVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Prefer Preference
+	Prefer *PatchSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PatchVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PatchSecretsParamsPrefer defines parameters for PatchSecrets.
+type PatchSecretsParamsPrefer string
 
-	PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostSecretsParams defines parameters for PostSecrets.
+type PostSecretsParams struct {
+	// Select Filtering Columns
+	Select *string `form:"select,omitempty" json:"select,omitempty"`
 
-	// PostVariablesWithBody request with any body
-	PostVariablesWithBody(ctx context.Context, params *PostVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Prefer Preference
+	Prefer *PostSecretsParamsPrefer `json:"Prefer,omitempty"`
+}
 
-	PostVariables(ctx context.Context, params *PostVariablesParams, body PostVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// PostSecretsParamsPrefer defines parameters for PostSecrets.
+type PostSecretsParamsPrefer string
 
-	PostVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// DeleteVariablesParams defines parameters for DeleteVariables.
+type DeleteVariablesParams struct {
+	Id               *string `form:"id,omitempty" json:"id,omitempty"`
+	Description      *string `form:"description,omitempty" json:"description,omitempty"`
+	Key              *string `form:"key,omitempty" json:"key,omitempty"`
+	GeneratorData    *string `form:"generator_data,omitempty" json:"generator_data,omitempty"`
+	GeneratorType    *string `form:"generator_type,omitempty" json:"generator_type,omitempty"`
+	ProjectId        *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+	ValueType        *string `form:"value_type,omitempty" json:"value_type,omitempty"`
+	ValueConstraints *string `form:"value_constraints,omitempty" json:"value_constraints,omitempty"`
+	Tags             *string `form:"tags,omitempty" json:"tags,omitempty"`
+	Owner            *string `form:"owner,omitempty" json:"owner,omitempty"`
+	Sensitive        *string `form:"sensitive,omitempty" json:"sensitive,omitempty"`
 
-	PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Prefer Preference
+	Prefer *DeleteVariablesParamsPrefer `json:"Prefer,omitempty"`
 }
 
-func (c *Client) Get(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// DeleteVariablesParamsPrefer defines parameters for DeleteVariables.
+type DeleteVariablesParamsPrefer string
 
-func (c *Client) DeleteClients(ctx context.Context, params *DeleteClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteClientsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// GetVariablesParams defines parameters for GetVariables.
+type GetVariablesParams struct {
+	Id               *string `form:"id,omitempty" json:"id,omitempty"`
+	Description      *string `form:"description,omitempty" json:"description,omitempty"`
+	Key              *string `form:"key,omitempty" json:"key,omitempty"`
+	GeneratorData    *string `form:"generator_data,omitempty" json:"generator_data,omitempty"`
+	GeneratorType    *string `form:"generator_type,omitempty" json:"generator_type,omitempty"`
+	ProjectId        *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+	ValueType        *string `form:"value_type,omitempty" json:"value_type,omitempty"`
+	ValueConstraints *string `form:"value_constraints,omitempty" json:"value_constraints,omitempty"`
+	Tags             *string `form:"tags,omitempty" json:"tags,omitempty"`
+	Owner            *string `form:"owner,omitempty" json:"owner,omitempty"`
+	Sensitive        *string `form:"sensitive,omitempty" json:"sensitive,omitempty"`
 
-func (c *Client) GetClients(ctx context.Context, params *GetClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClientsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Select Filtering Columns
+	Select *string `form:"select,omitempty" json:"select,omitempty"`
 
-func (c *Client) PatchClientsWithBody(ctx context.Context, params *PatchClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchClientsRequestWithBody(c.Server, params, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Order Ordering
+	Order *string `form:"order,omitempty" json:"order,omitempty"`
 
-func (c *Client) PatchClients(ctx context.Context, params *PatchClientsParams, body PatchClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchClientsRequest(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Offset Limiting and Pagination
+	Offset *string `form:"offset,omitempty" json:"offset,omitempty"`
 
-func (c *Client) PatchClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Limit Limiting and Pagination
+	Limit *string `form:"limit,omitempty" json:"limit,omitempty"`
 
-func (c *Client) PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Range Limiting and Pagination
+	Range *string `json:"Range,omitempty"`
 
-func (c *Client) PostClientsWithBody(ctx context.Context, params *PostClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostClientsRequestWithBody(c.Server, params, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// RangeUnit Limiting and Pagination
+	RangeUnit *string `json:"Range-Unit,omitempty"`
 
-func (c *Client) PostClients(ctx context.Context, params *PostClientsParams, body PostClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostClientsRequest(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// Prefer Preference
+	Prefer *GetVariablesParamsPrefer `json:"Prefer,omitempty"`
 }
 
-func (c *Client) PostClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// GetVariablesParamsPrefer defines parameters for GetVariables.
+type GetVariablesParamsPrefer string
 
-func (c *Client) PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// PatchVariablesParams defines parameters for PatchVariables.
+type PatchVariablesParams struct {
+	Id               *string `form:"id,omitempty" json:"id,omitempty"`
+	Description      *string `form:"description,omitempty" json:"description,omitempty"`
+	Key              *string `form:"key,omitempty" json:"key,omitempty"`
+	GeneratorData    *string `form:"generator_data,omitempty" json:"generator_data,omitempty"`
+	GeneratorType    *string `form:"generator_type,omitempty" json:"generator_type,omitempty"`
+	ProjectId        *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+	ValueType        *string `form:"value_type,omitempty" json:"value_type,omitempty"`
+	ValueConstraints *string `form:"value_constraints,omitempty" json:"value_constraints,omitempty"`
+	Tags             *string `form:"tags,omitempty" json:"tags,omitempty"`
+	Owner            *string `form:"owner,omitempty" json:"owner,omitempty"`
+	Sensitive        *string `form:"sensitive,omitempty" json:"sensitive,omitempty"`
 
-func (c *Client) DeleteClientsSecrets(ctx context.Context, params *DeleteClientsSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteClientsSecretsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// Prefer Preference
+	Prefer *PatchVariablesParamsPrefer `json:"Prefer,omitempty"`
 }
 
-func (c *Client) GetClientsSecrets(ctx context.Context, params *GetClientsSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClientsSecretsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// PatchVariablesParamsPrefer defines parameters for PatchVariables.
+type PatchVariablesParamsPrefer string
 
-func (c *Client) PatchClientsSecretsWithBody(ctx context.Context, params *PatchClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchClientsSecretsRequestWithBody(c.Server, params, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// PostVariablesParams defines parameters for PostVariables.
+type PostVariablesParams struct {
+	// Select Filtering Columns
+	Select *string `form:"select,omitempty" json:"select,omitempty"`
 
-func (c *Client) PatchClientsSecrets(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchClientsSecretsRequest(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// Prefer Preference
+	Prefer *PostVariablesParamsPrefer `json:"Prefer,omitempty"`
 }
 
-func (c *Client) PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// PostVariablesParamsPrefer defines parameters for PostVariables.
+type PostVariablesParamsPrefer string
 
-func (c *Client) PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// PatchClientsJSONRequestBody defines body for PatchClients for application/json ContentType.
+type PatchClientsJSONRequestBody = Clients
 
-func (c *Client) PostClientsSecretsWithBody(ctx context.Context, params *PostClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostClientsSecretsRequestWithBody(c.Server, params, contentType, body)
+// PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchClients for application/vnd.pgrst.object+json ContentType.
+type PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody = Clients
+
+// PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchClients for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Clients
+
+// PostClientsJSONRequestBody defines body for PostClients for application/json ContentType.
+type PostClientsJSONRequestBody = Clients
+
+// PostClientsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostClients for application/vnd.pgrst.object+json ContentType.
+type PostClientsApplicationVndPgrstObjectPlusJSONRequestBody = Clients
+
+// PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostClients for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Clients
+
+// PatchClientsSecretsJSONRequestBody defines body for PatchClientsSecrets for application/json ContentType.
+type PatchClientsSecretsJSONRequestBody = ClientsSecrets
+
+// PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchClientsSecrets for application/vnd.pgrst.object+json ContentType.
+type PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody = ClientsSecrets
+
+// PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchClientsSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = ClientsSecrets
+
+// PostClientsSecretsJSONRequestBody defines body for PostClientsSecrets for application/json ContentType.
+type PostClientsSecretsJSONRequestBody = ClientsSecrets
+
+// PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostClientsSecrets for application/vnd.pgrst.object+json ContentType.
+type PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody = ClientsSecrets
+
+// PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostClientsSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = ClientsSecrets
+
+// PatchEnvironmentsJSONRequestBody defines body for PatchEnvironments for application/json ContentType.
+type PatchEnvironmentsJSONRequestBody = Environments
+
+// PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchEnvironments for application/vnd.pgrst.object+json ContentType.
+type PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody = Environments
+
+// PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchEnvironments for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Environments
+
+// PostEnvironmentsJSONRequestBody defines body for PostEnvironments for application/json ContentType.
+type PostEnvironmentsJSONRequestBody = Environments
+
+// PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostEnvironments for application/vnd.pgrst.object+json ContentType.
+type PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody = Environments
+
+// PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostEnvironments for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Environments
+
+// PatchProjectsJSONRequestBody defines body for PatchProjects for application/json ContentType.
+type PatchProjectsJSONRequestBody = Projects
+
+// PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchProjects for application/vnd.pgrst.object+json ContentType.
+type PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody = Projects
+
+// PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchProjects for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Projects
+
+// PostProjectsJSONRequestBody defines body for PostProjects for application/json ContentType.
+type PostProjectsJSONRequestBody = Projects
+
+// PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostProjects for application/vnd.pgrst.object+json ContentType.
+type PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody = Projects
+
+// PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostProjects for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Projects
+
+// PostRpcAdminApiKeysJSONRequestBody defines body for PostRpcAdminApiKeys for application/json ContentType.
+type PostRpcAdminApiKeysJSONRequestBody = PostRpcAdminApiKeysJSONBody
+
+// PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcAdminApiKeys for application/vnd.pgrst.object+json ContentType.
+type PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcAdminApiKeys for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcAuditLogJSONRequestBody defines body for PostRpcAuditLog for application/json ContentType.
+type PostRpcAuditLogJSONRequestBody = PostRpcAuditLogJSONBody
+
+// PostRpcAuditLogApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcAuditLog for application/vnd.pgrst.object+json ContentType.
+type PostRpcAuditLogApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcAuditLogApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcAuditLog for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcAuditRequestJSONRequestBody defines body for PostRpcAuditRequest for application/json ContentType.
+type PostRpcAuditRequestJSONRequestBody = PostRpcAuditRequestJSONBody
+
+// PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcAuditRequest for application/vnd.pgrst.object+json ContentType.
+type PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcAuditRequest for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcCertificatesToRotateJSONRequestBody defines body for PostRpcCertificatesToRotate for application/json ContentType.
+type PostRpcCertificatesToRotateJSONRequestBody = PostRpcCertificatesToRotateJSONBody
+
+// PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcCertificatesToRotate for application/vnd.pgrst.object+json ContentType.
+type PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcCertificatesToRotate for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcCreateAdminApiKeyJSONRequestBody defines body for PostRpcCreateAdminApiKey for application/json ContentType.
+type PostRpcCreateAdminApiKeyJSONRequestBody = PostRpcCreateAdminApiKeyJSONBody
+
+// PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcCreateAdminApiKey for application/vnd.pgrst.object+json ContentType.
+type PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcCreateAdminApiKey for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcCreateClientJSONRequestBody defines body for PostRpcCreateClient for application/json ContentType.
+type PostRpcCreateClientJSONRequestBody = PostRpcCreateClientJSONBody
+
+// PostRpcCreateClientApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcCreateClient for application/vnd.pgrst.object+json ContentType.
+type PostRpcCreateClientApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcCreateClientApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcCreateClient for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcCreateClientSecretJSONRequestBody defines body for PostRpcCreateClientSecret for application/json ContentType.
+type PostRpcCreateClientSecretJSONRequestBody = PostRpcCreateClientSecretJSONBody
+
+// PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcCreateClientSecret for application/vnd.pgrst.object+json ContentType.
+type PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcCreateClientSecret for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcImportVariablesJSONRequestBody defines body for PostRpcImportVariables for application/json ContentType.
+type PostRpcImportVariablesJSONRequestBody = PostRpcImportVariablesJSONBody
+
+// PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcImportVariables for application/vnd.pgrst.object+json ContentType.
+type PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcImportVariables for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcIsAdminJSONRequestBody defines body for PostRpcIsAdmin for application/json ContentType.
+type PostRpcIsAdminJSONRequestBody = PostRpcIsAdminJSONBody
+
+// PostRpcIsAdminApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcIsAdmin for application/vnd.pgrst.object+json ContentType.
+type PostRpcIsAdminApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcIsAdminApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcIsAdmin for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcKeypairsToRotateJSONRequestBody defines body for PostRpcKeypairsToRotate for application/json ContentType.
+type PostRpcKeypairsToRotateJSONRequestBody = PostRpcKeypairsToRotateJSONBody
+
+// PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcKeypairsToRotate for application/vnd.pgrst.object+json ContentType.
+type PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcKeypairsToRotate for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcPendingCertificatesJSONRequestBody defines body for PostRpcPendingCertificates for application/json ContentType.
+type PostRpcPendingCertificatesJSONRequestBody = PostRpcPendingCertificatesJSONBody
+
+// PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcPendingCertificates for application/vnd.pgrst.object+json ContentType.
+type PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcPendingCertificates for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcPendingKeypairsJSONRequestBody defines body for PostRpcPendingKeypairs for application/json ContentType.
+type PostRpcPendingKeypairsJSONRequestBody = PostRpcPendingKeypairsJSONBody
+
+// PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcPendingKeypairs for application/vnd.pgrst.object+json ContentType.
+type PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcPendingKeypairs for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcRegenerateSecretsJSONRequestBody defines body for PostRpcRegenerateSecrets for application/json ContentType.
+type PostRpcRegenerateSecretsJSONRequestBody = PostRpcRegenerateSecretsJSONBody
+
+// PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcRegenerateSecrets for application/vnd.pgrst.object+json ContentType.
+type PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcRegenerateSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcResolveSecretsJSONRequestBody defines body for PostRpcResolveSecrets for application/json ContentType.
+type PostRpcResolveSecretsJSONRequestBody = PostRpcResolveSecretsJSONBody
+
+// PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcResolveSecrets for application/vnd.pgrst.object+json ContentType.
+type PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcResolveSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcRevealSecretsJSONRequestBody defines body for PostRpcRevealSecrets for application/json ContentType.
+type PostRpcRevealSecretsJSONRequestBody = PostRpcRevealSecretsJSONBody
+
+// PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcRevealSecrets for application/vnd.pgrst.object+json ContentType.
+type PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcRevealSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcRevokeAdminApiKeyJSONRequestBody defines body for PostRpcRevokeAdminApiKey for application/json ContentType.
+type PostRpcRevokeAdminApiKeyJSONRequestBody = PostRpcRevokeAdminApiKeyJSONBody
+
+// PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcRevokeAdminApiKey for application/vnd.pgrst.object+json ContentType.
+type PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcRevokeAdminApiKey for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcRevokeClientSecretJSONRequestBody defines body for PostRpcRevokeClientSecret for application/json ContentType.
+type PostRpcRevokeClientSecretJSONRequestBody = PostRpcRevokeClientSecretJSONBody
+
+// PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcRevokeClientSecret for application/vnd.pgrst.object+json ContentType.
+type PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcRevokeClientSecret for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcRollbackSecretJSONRequestBody defines body for PostRpcRollbackSecret for application/json ContentType.
+type PostRpcRollbackSecretJSONRequestBody = PostRpcRollbackSecretJSONBody
+
+// PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcRollbackSecret for application/vnd.pgrst.object+json ContentType.
+type PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcRollbackSecret for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcRotateSecretsJSONRequestBody defines body for PostRpcRotateSecrets for application/json ContentType.
+type PostRpcRotateSecretsJSONRequestBody = PostRpcRotateSecretsJSONBody
+
+// PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcRotateSecrets for application/vnd.pgrst.object+json ContentType.
+type PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcRotateSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcSecretEventsJSONRequestBody defines body for PostRpcSecretEvents for application/json ContentType.
+type PostRpcSecretEventsJSONRequestBody = PostRpcSecretEventsJSONBody
+
+// PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSecretEvents for application/vnd.pgrst.object+json ContentType.
+type PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSecretEvents for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcSecretEventsCursorJSONRequestBody defines body for PostRpcSecretEventsCursor for application/json ContentType.
+type PostRpcSecretEventsCursorJSONRequestBody = PostRpcSecretEventsCursorJSONBody
+
+// PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSecretEventsCursor for application/vnd.pgrst.object+json ContentType.
+type PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSecretEventsCursor for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcSecretVersionsJSONRequestBody defines body for PostRpcSecretVersions for application/json ContentType.
+type PostRpcSecretVersionsJSONRequestBody = PostRpcSecretVersionsJSONBody
+
+// PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSecretVersions for application/vnd.pgrst.object+json ContentType.
+type PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSecretVersions for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcSecretsJSONRequestBody defines body for PostRpcSecrets for application/json ContentType.
+type PostRpcSecretsJSONRequestBody = PostRpcSecretsJSONBody
+
+// PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSecrets for application/vnd.pgrst.object+json ContentType.
+type PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSecretsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcSetCertificateJSONRequestBody defines body for PostRpcSetCertificate for application/json ContentType.
+type PostRpcSetCertificateJSONRequestBody = PostRpcSetCertificateJSONBody
+
+// PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSetCertificate for application/vnd.pgrst.object+json ContentType.
+type PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSetCertificate for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcSetCertificateAuthorityJSONRequestBody defines body for PostRpcSetCertificateAuthority for application/json ContentType.
+type PostRpcSetCertificateAuthorityJSONRequestBody = PostRpcSetCertificateAuthorityJSONBody
+
+// PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSetCertificateAuthority for application/vnd.pgrst.object+json ContentType.
+type PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSetCertificateAuthority for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcSetKeypairJSONRequestBody defines body for PostRpcSetKeypair for application/json ContentType.
+type PostRpcSetKeypairJSONRequestBody = PostRpcSetKeypairJSONBody
+
+// PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSetKeypair for application/vnd.pgrst.object+json ContentType.
+type PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSetKeypair for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcSetSecretJSONRequestBody defines body for PostRpcSetSecret for application/json ContentType.
+type PostRpcSetSecretJSONRequestBody = PostRpcSetSecretJSONBody
+
+// PostRpcSetSecretApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcSetSecret for application/vnd.pgrst.object+json ContentType.
+type PostRpcSetSecretApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcSetSecretApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcSetSecret for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcUnsetSecretJSONRequestBody defines body for PostRpcUnsetSecret for application/json ContentType.
+type PostRpcUnsetSecretJSONRequestBody = PostRpcUnsetSecretJSONBody
+
+// PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcUnsetSecret for application/vnd.pgrst.object+json ContentType.
+type PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcUnsetSecret for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcValidateSecretsJSONRequestBody defines body for PostRpcValidateSecrets for application/json ContentType.
+type PostRpcValidateSecretsJSONRequestBody = PostRpcValidateSecretsJSONBody
+
+// PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcValidateSecrets for application/vnd.pgrst.object+json ContentType.
+type PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcValidateSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PostRpcVariableMetadataJSONRequestBody defines body for PostRpcVariableMetadata for application/json ContentType.
+type PostRpcVariableMetadataJSONRequestBody = PostRpcVariableMetadataJSONBody
+
+// PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostRpcVariableMetadata for application/vnd.pgrst.object+json ContentType.
+type PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONRequestBody = PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONBody
+
+// PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostRpcVariableMetadata for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedBody
+
+// PatchSecretsJSONRequestBody defines body for PatchSecrets for application/json ContentType.
+type PatchSecretsJSONRequestBody = Secrets
+
+// PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchSecrets for application/vnd.pgrst.object+json ContentType.
+type PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody = Secrets
+
+// PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Secrets
+
+// PostSecretsJSONRequestBody defines body for PostSecrets for application/json ContentType.
+type PostSecretsJSONRequestBody = Secrets
+
+// PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostSecrets for application/vnd.pgrst.object+json ContentType.
+type PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody = Secrets
+
+// PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostSecrets for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Secrets
+
+// PatchVariablesJSONRequestBody defines body for PatchVariables for application/json ContentType.
+type PatchVariablesJSONRequestBody = Variables
+
+// PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody defines body for PatchVariables for application/vnd.pgrst.object+json ContentType.
+type PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody = Variables
+
+// PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PatchVariables for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Variables
+
+// PostVariablesJSONRequestBody defines body for PostVariables for application/json ContentType.
+type PostVariablesJSONRequestBody = Variables
+
+// PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody defines body for PostVariables for application/vnd.pgrst.object+json ContentType.
+type PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody = Variables
+
+// PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody defines body for PostVariables for application/vnd.pgrst.object+json;nulls=stripped ContentType.
+type PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody = Variables
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// Get request
+	Get(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteClients request
+	DeleteClients(ctx context.Context, params *DeleteClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClients request
+	GetClients(ctx context.Context, params *GetClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchClientsWithBody request with any body
+	PatchClientsWithBody(ctx context.Context, params *PatchClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchClients(ctx context.Context, params *PatchClientsParams, body PatchClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostClientsWithBody request with any body
+	PostClientsWithBody(ctx context.Context, params *PostClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostClients(ctx context.Context, params *PostClientsParams, body PostClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteClientsSecrets request
+	DeleteClientsSecrets(ctx context.Context, params *DeleteClientsSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClientsSecrets request
+	GetClientsSecrets(ctx context.Context, params *GetClientsSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchClientsSecretsWithBody request with any body
+	PatchClientsSecretsWithBody(ctx context.Context, params *PatchClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchClientsSecrets(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostClientsSecretsWithBody request with any body
+	PostClientsSecretsWithBody(ctx context.Context, params *PostClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostClientsSecrets(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteEnvironments request
+	DeleteEnvironments(ctx context.Context, params *DeleteEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEnvironments request
+	GetEnvironments(ctx context.Context, params *GetEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchEnvironmentsWithBody request with any body
+	PatchEnvironmentsWithBody(ctx context.Context, params *PatchEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchEnvironments(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEnvironmentsWithBody request with any body
+	PostEnvironmentsWithBody(ctx context.Context, params *PostEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEnvironments(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProjects request
+	DeleteProjects(ctx context.Context, params *DeleteProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjects request
+	GetProjects(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchProjectsWithBody request with any body
+	PatchProjectsWithBody(ctx context.Context, params *PatchProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchProjects(ctx context.Context, params *PatchProjectsParams, body PatchProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostProjectsWithBody request with any body
+	PostProjectsWithBody(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostProjects(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcAdminApiKeysWithBody request with any body
+	PostRpcAdminApiKeysWithBody(ctx context.Context, params *PostRpcAdminApiKeysParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAdminApiKeys(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcAuditLogWithBody request with any body
+	PostRpcAuditLogWithBody(ctx context.Context, params *PostRpcAuditLogParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAuditLog(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcAuditRequestWithBody request with any body
+	PostRpcAuditRequestWithBody(ctx context.Context, params *PostRpcAuditRequestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAuditRequest(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcCertificatesToRotateWithBody request with any body
+	PostRpcCertificatesToRotateWithBody(ctx context.Context, params *PostRpcCertificatesToRotateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCertificatesToRotate(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcCreateAdminApiKeyWithBody request with any body
+	PostRpcCreateAdminApiKeyWithBody(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateAdminApiKey(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcCreateClientWithBody request with any body
+	PostRpcCreateClientWithBody(ctx context.Context, params *PostRpcCreateClientParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateClient(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcCreateClientSecretWithBody request with any body
+	PostRpcCreateClientSecretWithBody(ctx context.Context, params *PostRpcCreateClientSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateClientSecret(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcImportVariablesWithBody request with any body
+	PostRpcImportVariablesWithBody(ctx context.Context, params *PostRpcImportVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcImportVariables(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcIsAdminWithBody request with any body
+	PostRpcIsAdminWithBody(ctx context.Context, params *PostRpcIsAdminParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcIsAdmin(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcKeypairsToRotateWithBody request with any body
+	PostRpcKeypairsToRotateWithBody(ctx context.Context, params *PostRpcKeypairsToRotateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcKeypairsToRotate(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcPendingCertificatesWithBody request with any body
+	PostRpcPendingCertificatesWithBody(ctx context.Context, params *PostRpcPendingCertificatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcPendingCertificates(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcPendingKeypairsWithBody request with any body
+	PostRpcPendingKeypairsWithBody(ctx context.Context, params *PostRpcPendingKeypairsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcPendingKeypairs(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcRegenerateSecretsWithBody request with any body
+	PostRpcRegenerateSecretsWithBody(ctx context.Context, params *PostRpcRegenerateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRegenerateSecrets(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcResolveSecretsWithBody request with any body
+	PostRpcResolveSecretsWithBody(ctx context.Context, params *PostRpcResolveSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcResolveSecrets(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcRevealSecretsWithBody request with any body
+	PostRpcRevealSecretsWithBody(ctx context.Context, params *PostRpcRevealSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevealSecrets(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcRevokeAdminApiKeyWithBody request with any body
+	PostRpcRevokeAdminApiKeyWithBody(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevokeAdminApiKey(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcRevokeClientSecretWithBody request with any body
+	PostRpcRevokeClientSecretWithBody(ctx context.Context, params *PostRpcRevokeClientSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevokeClientSecret(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcRollbackSecretWithBody request with any body
+	PostRpcRollbackSecretWithBody(ctx context.Context, params *PostRpcRollbackSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRollbackSecret(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcRotateSecretsWithBody request with any body
+	PostRpcRotateSecretsWithBody(ctx context.Context, params *PostRpcRotateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRotateSecrets(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcSecretEventsWithBody request with any body
+	PostRpcSecretEventsWithBody(ctx context.Context, params *PostRpcSecretEventsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretEvents(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcSecretEventsCursorWithBody request with any body
+	PostRpcSecretEventsCursorWithBody(ctx context.Context, params *PostRpcSecretEventsCursorParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretEventsCursor(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcSecretVersionsWithBody request with any body
+	PostRpcSecretVersionsWithBody(ctx context.Context, params *PostRpcSecretVersionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretVersions(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRpcSecrets request
+	GetRpcSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcSecretsWithBody request with any body
+	PostRpcSecretsWithBody(ctx context.Context, params *PostRpcSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecrets(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcSetCertificateWithBody request with any body
+	PostRpcSetCertificateWithBody(ctx context.Context, params *PostRpcSetCertificateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetCertificate(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcSetCertificateAuthorityWithBody request with any body
+	PostRpcSetCertificateAuthorityWithBody(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetCertificateAuthority(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcSetKeypairWithBody request with any body
+	PostRpcSetKeypairWithBody(ctx context.Context, params *PostRpcSetKeypairParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetKeypair(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcSetSecretWithBody request with any body
+	PostRpcSetSecretWithBody(ctx context.Context, params *PostRpcSetSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetSecret(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcUnsetSecretWithBody request with any body
+	PostRpcUnsetSecretWithBody(ctx context.Context, params *PostRpcUnsetSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcUnsetSecret(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcValidateSecretsWithBody request with any body
+	PostRpcValidateSecretsWithBody(ctx context.Context, params *PostRpcValidateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcValidateSecrets(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRpcVariableMetadataWithBody request with any body
+	PostRpcVariableMetadataWithBody(ctx context.Context, params *PostRpcVariableMetadataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcVariableMetadata(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSecrets request
+	DeleteSecrets(ctx context.Context, params *DeleteSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSecrets request
+	GetSecrets(ctx context.Context, params *GetSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchSecretsWithBody request with any body
+	PatchSecretsWithBody(ctx context.Context, params *PatchSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchSecrets(ctx context.Context, params *PatchSecretsParams, body PatchSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSecretsWithBody request with any body
+	PostSecretsWithBody(ctx context.Context, params *PostSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSecrets(ctx context.Context, params *PostSecretsParams, body PostSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteVariables request
+	DeleteVariables(ctx context.Context, params *DeleteVariablesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVariables request
+	GetVariables(ctx context.Context, params *GetVariablesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchVariablesWithBody request with any body
+	PatchVariablesWithBody(ctx context.Context, params *PatchVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchVariables(ctx context.Context, params *PatchVariablesParams, body PatchVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostVariablesWithBody request with any body
+	PostVariablesWithBody(ctx context.Context, params *PostVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostVariables(ctx context.Context, params *PostVariablesParams, body PostVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Get(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteClients(ctx context.Context, params *DeleteClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClientsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClients(ctx context.Context, params *GetClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchClientsWithBody(ctx context.Context, params *PatchClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchClientsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchClients(ctx context.Context, params *PatchClientsParams, body PatchClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchClientsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostClientsWithBody(ctx context.Context, params *PostClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostClientsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostClients(ctx context.Context, params *PostClientsParams, body PostClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostClientsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteClientsSecrets(ctx context.Context, params *DeleteClientsSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClientsSecretsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClientsSecrets(ctx context.Context, params *GetClientsSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientsSecretsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchClientsSecretsWithBody(ctx context.Context, params *PatchClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchClientsSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchClientsSecrets(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchClientsSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostClientsSecretsWithBody(ctx context.Context, params *PostClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostClientsSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostClientsSecrets(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostClientsSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteEnvironments(ctx context.Context, params *DeleteEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEnvironmentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEnvironments(ctx context.Context, params *GetEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEnvironmentsWithBody(ctx context.Context, params *PatchEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEnvironmentsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEnvironments(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEnvironmentsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEnvironmentsWithBody(ctx context.Context, params *PostEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEnvironmentsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEnvironments(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEnvironmentsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProjects(ctx context.Context, params *DeleteProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjects(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchProjectsWithBody(ctx context.Context, params *PatchProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchProjects(ctx context.Context, params *PatchProjectsParams, body PatchProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostProjectsWithBody(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostProjects(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAdminApiKeysWithBody(ctx context.Context, params *PostRpcAdminApiKeysParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAdminApiKeysRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAdminApiKeys(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAdminApiKeysRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAdminApiKeysRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAdminApiKeysRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAuditLogWithBody(ctx context.Context, params *PostRpcAuditLogParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAuditLogRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAuditLog(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAuditLogRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAuditLogRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAuditLogRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAuditRequestWithBody(ctx context.Context, params *PostRpcAuditRequestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAuditRequestRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAuditRequest(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAuditRequestRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAuditRequestRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcAuditRequestRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCertificatesToRotateWithBody(ctx context.Context, params *PostRpcCertificatesToRotateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCertificatesToRotateRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCertificatesToRotate(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCertificatesToRotateRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCertificatesToRotateRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCertificatesToRotateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateAdminApiKeyWithBody(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateAdminApiKeyRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateAdminApiKey(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateAdminApiKeyRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateClientWithBody(ctx context.Context, params *PostRpcCreateClientParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateClientRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateClient(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateClientRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateClientRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateClientRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateClientSecretWithBody(ctx context.Context, params *PostRpcCreateClientSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateClientSecretRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateClientSecret(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateClientSecretRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateClientSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcCreateClientSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcImportVariablesWithBody(ctx context.Context, params *PostRpcImportVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcImportVariablesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcImportVariables(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcImportVariablesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcImportVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcImportVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcIsAdminWithBody(ctx context.Context, params *PostRpcIsAdminParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcIsAdminRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcIsAdmin(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcIsAdminRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcIsAdminRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcIsAdminRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcKeypairsToRotateWithBody(ctx context.Context, params *PostRpcKeypairsToRotateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcKeypairsToRotateRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcKeypairsToRotate(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcKeypairsToRotateRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcKeypairsToRotateRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcKeypairsToRotateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcPendingCertificatesWithBody(ctx context.Context, params *PostRpcPendingCertificatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcPendingCertificatesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcPendingCertificates(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcPendingCertificatesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcPendingCertificatesRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcPendingCertificatesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcPendingKeypairsWithBody(ctx context.Context, params *PostRpcPendingKeypairsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcPendingKeypairsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcPendingKeypairs(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcPendingKeypairsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcPendingKeypairsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcPendingKeypairsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRegenerateSecretsWithBody(ctx context.Context, params *PostRpcRegenerateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRegenerateSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRegenerateSecrets(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRegenerateSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRegenerateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRegenerateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcResolveSecretsWithBody(ctx context.Context, params *PostRpcResolveSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcResolveSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcResolveSecrets(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcResolveSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcResolveSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcResolveSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevealSecretsWithBody(ctx context.Context, params *PostRpcRevealSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevealSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevealSecrets(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevealSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevealSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevealSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevokeAdminApiKeyWithBody(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevokeAdminApiKeyRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevokeAdminApiKey(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevokeAdminApiKeyRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevokeAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevokeAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevokeClientSecretWithBody(ctx context.Context, params *PostRpcRevokeClientSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevokeClientSecretRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevokeClientSecret(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevokeClientSecretRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevokeClientSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRevokeClientSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRollbackSecretWithBody(ctx context.Context, params *PostRpcRollbackSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRollbackSecretRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRollbackSecret(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRollbackSecretRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRollbackSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRollbackSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRotateSecretsWithBody(ctx context.Context, params *PostRpcRotateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRotateSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRotateSecrets(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRotateSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRotateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcRotateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretEventsWithBody(ctx context.Context, params *PostRpcSecretEventsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretEventsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretEvents(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretEventsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretEventsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretEventsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretEventsCursorWithBody(ctx context.Context, params *PostRpcSecretEventsCursorParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretEventsCursorRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretEventsCursor(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretEventsCursorRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretEventsCursorRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretEventsCursorRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretVersionsWithBody(ctx context.Context, params *PostRpcSecretVersionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretVersionsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretVersions(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretVersionsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretVersionsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretVersionsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRpcSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRpcSecretsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretsWithBody(ctx context.Context, params *PostRpcSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecrets(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetCertificateWithBody(ctx context.Context, params *PostRpcSetCertificateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetCertificateRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetCertificate(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetCertificateRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetCertificateRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetCertificateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetCertificateAuthorityWithBody(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetCertificateAuthorityRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetCertificateAuthority(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetCertificateAuthorityRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetCertificateAuthorityRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetCertificateAuthorityRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetKeypairWithBody(ctx context.Context, params *PostRpcSetKeypairParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetKeypairRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetKeypair(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetKeypairRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetKeypairRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetKeypairRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetSecretWithBody(ctx context.Context, params *PostRpcSetSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetSecretRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetSecret(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetSecretRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcSetSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcUnsetSecretWithBody(ctx context.Context, params *PostRpcUnsetSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcUnsetSecretRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcUnsetSecret(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcUnsetSecretRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcUnsetSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcUnsetSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcValidateSecretsWithBody(ctx context.Context, params *PostRpcValidateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcValidateSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcValidateSecrets(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcValidateSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcValidateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcValidateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcVariableMetadataWithBody(ctx context.Context, params *PostRpcVariableMetadataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcVariableMetadataRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcVariableMetadata(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcVariableMetadataRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcVariableMetadataRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRpcVariableMetadataRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSecrets(ctx context.Context, params *DeleteSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSecretsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSecrets(ctx context.Context, params *GetSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSecretsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSecretsWithBody(ctx context.Context, params *PatchSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSecrets(ctx context.Context, params *PatchSecretsParams, body PatchSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSecretsWithBody(ctx context.Context, params *PostSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSecretsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSecrets(ctx context.Context, params *PostSecretsParams, body PostSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSecretsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteVariables(ctx context.Context, params *DeleteVariablesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVariablesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVariables(ctx context.Context, params *GetVariablesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVariablesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchVariablesWithBody(ctx context.Context, params *PatchVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchVariablesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchVariables(ctx context.Context, params *PatchVariablesParams, body PatchVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchVariablesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostVariablesWithBody(ctx context.Context, params *PostVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostVariablesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostVariables(ctx context.Context, params *PostVariablesParams, body PostVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostVariablesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetRequest generates requests for Get
+func NewGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteClientsRequest generates requests for DeleteClients
+func NewDeleteClientsRequest(server string, params *DeleteClientsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EnvironmentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetClientsRequest generates requests for GetClients
+func NewGetClientsRequest(server string, params *GetClientsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EnvironmentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Range != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range", headerParam0)
+		}
+
+		if params.RangeUnit != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Range-Unit", runtime.ParamLocationHeader, *params.RangeUnit)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range-Unit", headerParam1)
+		}
+
+		if params.Prefer != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchClientsRequest calls the generic PatchClients builder with application/json body
+func NewPatchClientsRequest(server string, params *PatchClientsParams, body PatchClientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchClientsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchClients builder with application/vnd.pgrst.object+json body
+func NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchClientsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchClients builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchClientsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPatchClientsRequestWithBody generates requests for PatchClients with any type of body
+func NewPatchClientsRequestWithBody(server string, params *PatchClientsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EnvironmentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostClientsRequest calls the generic PostClients builder with application/json body
+func NewPostClientsRequest(server string, params *PostClientsParams, body PostClientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostClientsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostClients builder with application/vnd.pgrst.object+json body
+func NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostClientsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostClients builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostClientsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostClientsRequestWithBody generates requests for PostClients with any type of body
+func NewPostClientsRequestWithBody(server string, params *PostClientsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteClientsSecretsRequest generates requests for DeleteClientsSecrets
+func NewDeleteClientsSecretsRequest(server string, params *DeleteClientsSecretsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clients_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Hash != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hash", runtime.ParamLocationQuery, *params.Hash); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_at", runtime.ParamLocationQuery, *params.ExpiresAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RevokedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "revoked_at", runtime.ParamLocationQuery, *params.RevokedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetClientsSecretsRequest generates requests for GetClientsSecrets
+func NewGetClientsSecretsRequest(server string, params *GetClientsSecretsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clients_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Hash != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hash", runtime.ParamLocationQuery, *params.Hash); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_at", runtime.ParamLocationQuery, *params.ExpiresAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RevokedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "revoked_at", runtime.ParamLocationQuery, *params.RevokedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Range != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range", headerParam0)
+		}
+
+		if params.RangeUnit != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Range-Unit", runtime.ParamLocationHeader, *params.RangeUnit)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range-Unit", headerParam1)
+		}
+
+		if params.Prefer != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchClientsSecretsRequest calls the generic PatchClientsSecrets builder with application/json body
+func NewPatchClientsSecretsRequest(server string, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchClientsSecretsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchClientsSecrets builder with application/vnd.pgrst.object+json body
+func NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchClientsSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchClientsSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchClientsSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPatchClientsSecretsRequestWithBody generates requests for PatchClientsSecrets with any type of body
+func NewPatchClientsSecretsRequestWithBody(server string, params *PatchClientsSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clients_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Hash != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hash", runtime.ParamLocationQuery, *params.Hash); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_at", runtime.ParamLocationQuery, *params.ExpiresAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RevokedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "revoked_at", runtime.ParamLocationQuery, *params.RevokedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostClientsSecretsRequest calls the generic PostClientsSecrets builder with application/json body
+func NewPostClientsSecretsRequest(server string, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostClientsSecretsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostClientsSecrets builder with application/vnd.pgrst.object+json body
+func NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostClientsSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostClientsSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostClientsSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostClientsSecretsRequestWithBody generates requests for PostClientsSecrets with any type of body
+func NewPostClientsSecretsRequestWithBody(server string, params *PostClientsSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clients_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteEnvironmentsRequest generates requests for DeleteEnvironments
+func NewDeleteEnvironmentsRequest(server string, params *DeleteEnvironmentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/environments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parent_id", runtime.ParamLocationQuery, *params.ParentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetEnvironmentsRequest generates requests for GetEnvironments
+func NewGetEnvironmentsRequest(server string, params *GetEnvironmentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/environments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parent_id", runtime.ParamLocationQuery, *params.ParentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Range != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range", headerParam0)
+		}
+
+		if params.RangeUnit != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Range-Unit", runtime.ParamLocationHeader, *params.RangeUnit)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range-Unit", headerParam1)
+		}
+
+		if params.Prefer != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchEnvironmentsRequest calls the generic PatchEnvironments builder with application/json body
+func NewPatchEnvironmentsRequest(server string, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchEnvironmentsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchEnvironments builder with application/vnd.pgrst.object+json body
+func NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchEnvironmentsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchEnvironments builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchEnvironmentsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPatchEnvironmentsRequestWithBody generates requests for PatchEnvironments with any type of body
+func NewPatchEnvironmentsRequestWithBody(server string, params *PatchEnvironmentsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/environments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parent_id", runtime.ParamLocationQuery, *params.ParentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostEnvironmentsRequest calls the generic PostEnvironments builder with application/json body
+func NewPostEnvironmentsRequest(server string, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEnvironmentsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostEnvironments builder with application/vnd.pgrst.object+json body
+func NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEnvironmentsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostEnvironments builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEnvironmentsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostEnvironmentsRequestWithBody generates requests for PostEnvironments with any type of body
+func NewPostEnvironmentsRequestWithBody(server string, params *PostEnvironmentsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/environments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteProjectsRequest generates requests for DeleteProjects
+func NewDeleteProjectsRequest(server string, params *DeleteProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetProjectsRequest generates requests for GetProjects
+func NewGetProjectsRequest(server string, params *GetProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Range != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range", headerParam0)
+		}
+
+		if params.RangeUnit != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Range-Unit", runtime.ParamLocationHeader, *params.RangeUnit)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range-Unit", headerParam1)
+		}
+
+		if params.Prefer != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchProjectsRequest calls the generic PatchProjects builder with application/json body
+func NewPatchProjectsRequest(server string, params *PatchProjectsParams, body PatchProjectsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchProjectsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchProjects builder with application/vnd.pgrst.object+json body
+func NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchProjectsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchProjects builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchProjectsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPatchProjectsRequestWithBody generates requests for PatchProjects with any type of body
+func NewPatchProjectsRequestWithBody(server string, params *PatchProjectsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Display != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostProjectsRequest calls the generic PostProjects builder with application/json body
+func NewPostProjectsRequest(server string, params *PostProjectsParams, body PostProjectsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostProjectsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostProjects builder with application/vnd.pgrst.object+json body
+func NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostProjectsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostProjects builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostProjectsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostProjectsRequestWithBody generates requests for PostProjects with any type of body
+func NewPostProjectsRequestWithBody(server string, params *PostProjectsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcAdminApiKeysRequest calls the generic PostRpcAdminApiKeys builder with application/json body
+func NewPostRpcAdminApiKeysRequest(server string, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAdminApiKeysRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcAdminApiKeysRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcAdminApiKeys builder with application/vnd.pgrst.object+json body
+func NewPostRpcAdminApiKeysRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAdminApiKeysRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcAdminApiKeysRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcAdminApiKeys builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcAdminApiKeysRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAdminApiKeysRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcAdminApiKeysRequestWithBody generates requests for PostRpcAdminApiKeys with any type of body
+func NewPostRpcAdminApiKeysRequestWithBody(server string, params *PostRpcAdminApiKeysParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/admin_api_keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcAuditLogRequest calls the generic PostRpcAuditLog builder with application/json body
+func NewPostRpcAuditLogRequest(server string, params *PostRpcAuditLogParams, body PostRpcAuditLogJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAuditLogRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcAuditLogRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcAuditLog builder with application/vnd.pgrst.object+json body
+func NewPostRpcAuditLogRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAuditLogRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcAuditLogRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcAuditLog builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcAuditLogRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAuditLogRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcAuditLogRequestWithBody generates requests for PostRpcAuditLog with any type of body
+func NewPostRpcAuditLogRequestWithBody(server string, params *PostRpcAuditLogParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/audit_log")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcAuditRequestRequest calls the generic PostRpcAuditRequest builder with application/json body
+func NewPostRpcAuditRequestRequest(server string, params *PostRpcAuditRequestParams, body PostRpcAuditRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAuditRequestRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcAuditRequestRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcAuditRequest builder with application/vnd.pgrst.object+json body
+func NewPostRpcAuditRequestRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAuditRequestRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcAuditRequestRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcAuditRequest builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcAuditRequestRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcAuditRequestRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcAuditRequestRequestWithBody generates requests for PostRpcAuditRequest with any type of body
+func NewPostRpcAuditRequestRequestWithBody(server string, params *PostRpcAuditRequestParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/audit_request")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcCertificatesToRotateRequest calls the generic PostRpcCertificatesToRotate builder with application/json body
+func NewPostRpcCertificatesToRotateRequest(server string, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCertificatesToRotateRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcCertificatesToRotateRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcCertificatesToRotate builder with application/vnd.pgrst.object+json body
+func NewPostRpcCertificatesToRotateRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCertificatesToRotateRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcCertificatesToRotateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcCertificatesToRotate builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcCertificatesToRotateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCertificatesToRotateRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcCertificatesToRotateRequestWithBody generates requests for PostRpcCertificatesToRotate with any type of body
+func NewPostRpcCertificatesToRotateRequestWithBody(server string, params *PostRpcCertificatesToRotateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/certificates_to_rotate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcCreateAdminApiKeyRequest calls the generic PostRpcCreateAdminApiKey builder with application/json body
+func NewPostRpcCreateAdminApiKeyRequest(server string, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateAdminApiKeyRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcCreateAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcCreateAdminApiKey builder with application/vnd.pgrst.object+json body
+func NewPostRpcCreateAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateAdminApiKeyRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcCreateAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcCreateAdminApiKey builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcCreateAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateAdminApiKeyRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcCreateAdminApiKeyRequestWithBody generates requests for PostRpcCreateAdminApiKey with any type of body
+func NewPostRpcCreateAdminApiKeyRequestWithBody(server string, params *PostRpcCreateAdminApiKeyParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/create_admin_api_key")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcCreateClientRequest calls the generic PostRpcCreateClient builder with application/json body
+func NewPostRpcCreateClientRequest(server string, params *PostRpcCreateClientParams, body PostRpcCreateClientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateClientRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcCreateClientRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcCreateClient builder with application/vnd.pgrst.object+json body
+func NewPostRpcCreateClientRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateClientRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcCreateClientRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcCreateClient builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcCreateClientRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateClientRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcCreateClientRequestWithBody generates requests for PostRpcCreateClient with any type of body
+func NewPostRpcCreateClientRequestWithBody(server string, params *PostRpcCreateClientParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/create_client")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcCreateClientSecretRequest calls the generic PostRpcCreateClientSecret builder with application/json body
+func NewPostRpcCreateClientSecretRequest(server string, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateClientSecretRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcCreateClientSecretRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcCreateClientSecret builder with application/vnd.pgrst.object+json body
+func NewPostRpcCreateClientSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateClientSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcCreateClientSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcCreateClientSecret builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcCreateClientSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcCreateClientSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcCreateClientSecretRequestWithBody generates requests for PostRpcCreateClientSecret with any type of body
+func NewPostRpcCreateClientSecretRequestWithBody(server string, params *PostRpcCreateClientSecretParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/create_client_secret")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcImportVariablesRequest calls the generic PostRpcImportVariables builder with application/json body
+func NewPostRpcImportVariablesRequest(server string, params *PostRpcImportVariablesParams, body PostRpcImportVariablesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcImportVariablesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcImportVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcImportVariables builder with application/vnd.pgrst.object+json body
+func NewPostRpcImportVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcImportVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcImportVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcImportVariables builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcImportVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcImportVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcImportVariablesRequestWithBody generates requests for PostRpcImportVariables with any type of body
+func NewPostRpcImportVariablesRequestWithBody(server string, params *PostRpcImportVariablesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/import_variables")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcIsAdminRequest calls the generic PostRpcIsAdmin builder with application/json body
+func NewPostRpcIsAdminRequest(server string, params *PostRpcIsAdminParams, body PostRpcIsAdminJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcIsAdminRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcIsAdminRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcIsAdmin builder with application/vnd.pgrst.object+json body
+func NewPostRpcIsAdminRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcIsAdminRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcIsAdminRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcIsAdmin builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcIsAdminRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcIsAdminRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcIsAdminRequestWithBody generates requests for PostRpcIsAdmin with any type of body
+func NewPostRpcIsAdminRequestWithBody(server string, params *PostRpcIsAdminParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/is_admin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcKeypairsToRotateRequest calls the generic PostRpcKeypairsToRotate builder with application/json body
+func NewPostRpcKeypairsToRotateRequest(server string, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcKeypairsToRotateRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcKeypairsToRotateRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcKeypairsToRotate builder with application/vnd.pgrst.object+json body
+func NewPostRpcKeypairsToRotateRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcKeypairsToRotateRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcKeypairsToRotateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcKeypairsToRotate builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcKeypairsToRotateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcKeypairsToRotateRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcKeypairsToRotateRequestWithBody generates requests for PostRpcKeypairsToRotate with any type of body
+func NewPostRpcKeypairsToRotateRequestWithBody(server string, params *PostRpcKeypairsToRotateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/keypairs_to_rotate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcPendingCertificatesRequest calls the generic PostRpcPendingCertificates builder with application/json body
+func NewPostRpcPendingCertificatesRequest(server string, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcPendingCertificatesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcPendingCertificatesRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcPendingCertificates builder with application/vnd.pgrst.object+json body
+func NewPostRpcPendingCertificatesRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcPendingCertificatesRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcPendingCertificatesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcPendingCertificates builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcPendingCertificatesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcPendingCertificatesRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcPendingCertificatesRequestWithBody generates requests for PostRpcPendingCertificates with any type of body
+func NewPostRpcPendingCertificatesRequestWithBody(server string, params *PostRpcPendingCertificatesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/pending_certificates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostRpcPendingKeypairsRequest calls the generic PostRpcPendingKeypairs builder with application/json body
+func NewPostRpcPendingKeypairsRequest(server string, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcPendingKeypairsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcPendingKeypairsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcPendingKeypairs builder with application/vnd.pgrst.object+json body
+func NewPostRpcPendingKeypairsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcPendingKeypairsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) PostClientsSecrets(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostClientsSecretsRequest(c.Server, params, body)
+// NewPostRpcPendingKeypairsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcPendingKeypairs builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcPendingKeypairsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcPendingKeypairsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcPendingKeypairsRequestWithBody generates requests for PostRpcPendingKeypairs with any type of body
+func NewPostRpcPendingKeypairsRequestWithBody(server string, params *PostRpcPendingKeypairsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	operationPath := fmt.Sprintf("/rpc/pending_keypairs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-func (c *Client) PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+// NewPostRpcRegenerateSecretsRequest calls the generic PostRpcRegenerateSecrets builder with application/json body
+func NewPostRpcRegenerateSecretsRequest(server string, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRegenerateSecretsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcRegenerateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcRegenerateSecrets builder with application/vnd.pgrst.object+json body
+func NewPostRpcRegenerateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRegenerateSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) DeleteEnvironments(ctx context.Context, params *DeleteEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteEnvironmentsRequest(c.Server, params)
+// NewPostRpcRegenerateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcRegenerateSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcRegenerateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRegenerateSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcRegenerateSecretsRequestWithBody generates requests for PostRpcRegenerateSecrets with any type of body
+func NewPostRpcRegenerateSecretsRequestWithBody(server string, params *PostRpcRegenerateSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetEnvironments(ctx context.Context, params *GetEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEnvironmentsRequest(c.Server, params)
+	operationPath := fmt.Sprintf("/rpc/regenerate_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-func (c *Client) PatchEnvironmentsWithBody(ctx context.Context, params *PatchEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchEnvironmentsRequestWithBody(c.Server, params, contentType, body)
+// NewPostRpcResolveSecretsRequest calls the generic PostRpcResolveSecrets builder with application/json body
+func NewPostRpcResolveSecretsRequest(server string, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcResolveSecretsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcResolveSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcResolveSecrets builder with application/vnd.pgrst.object+json body
+func NewPostRpcResolveSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcResolveSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) PatchEnvironments(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchEnvironmentsRequest(c.Server, params, body)
+// NewPostRpcResolveSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcResolveSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcResolveSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcResolveSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcResolveSecretsRequestWithBody generates requests for PostRpcResolveSecrets with any type of body
+func NewPostRpcResolveSecretsRequestWithBody(server string, params *PostRpcResolveSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+
+	operationPath := fmt.Sprintf("/rpc/resolve_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-func (c *Client) PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+// NewPostRpcRevealSecretsRequest calls the generic PostRpcRevealSecrets builder with application/json body
+func NewPostRpcRevealSecretsRequest(server string, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevealSecretsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcRevealSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcRevealSecrets builder with application/vnd.pgrst.object+json body
+func NewPostRpcRevealSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevealSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+// NewPostRpcRevealSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcRevealSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcRevealSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevealSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcRevealSecretsRequestWithBody generates requests for PostRpcRevealSecrets with any type of body
+func NewPostRpcRevealSecretsRequestWithBody(server string, params *PostRpcRevealSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/reveal_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-func (c *Client) PostEnvironmentsWithBody(ctx context.Context, params *PostEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostEnvironmentsRequestWithBody(c.Server, params, contentType, body)
+// NewPostRpcRevokeAdminApiKeyRequest calls the generic PostRpcRevokeAdminApiKey builder with application/json body
+func NewPostRpcRevokeAdminApiKeyRequest(server string, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevokeAdminApiKeyRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-func (c *Client) PostEnvironments(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostEnvironmentsRequest(c.Server, params, body)
+// NewPostRpcRevokeAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcRevokeAdminApiKey builder with application/vnd.pgrst.object+json body
+func NewPostRpcRevokeAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevokeAdminApiKeyRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+// NewPostRpcRevokeAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcRevokeAdminApiKey builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcRevokeAdminApiKeyRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevokeAdminApiKeyRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-func (c *Client) PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+// NewPostRpcRevokeAdminApiKeyRequestWithBody generates requests for PostRpcRevokeAdminApiKey with any type of body
+func NewPostRpcRevokeAdminApiKeyRequestWithBody(server string, params *PostRpcRevokeAdminApiKeyParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/rpc/revoke_admin_api_key")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) DeleteProjects(ctx context.Context, params *DeleteProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteProjectsRequest(c.Server, params)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetProjects(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectsRequest(c.Server, params)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
 	}
-	return c.Client.Do(req)
+
+	return req, nil
 }
 
-func (c *Client) PatchProjectsWithBody(ctx context.Context, params *PatchProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchProjectsRequestWithBody(c.Server, params, contentType, body)
+// NewPostRpcRevokeClientSecretRequest calls the generic PostRpcRevokeClientSecret builder with application/json body
+func NewPostRpcRevokeClientSecretRequest(server string, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevokeClientSecretRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-func (c *Client) PatchProjects(ctx context.Context, params *PatchProjectsParams, body PatchProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchProjectsRequest(c.Server, params, body)
+// NewPostRpcRevokeClientSecretRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcRevokeClientSecret builder with application/vnd.pgrst.object+json body
+func NewPostRpcRevokeClientSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevokeClientSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) PatchProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+// NewPostRpcRevokeClientSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcRevokeClientSecret builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcRevokeClientSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRevokeClientSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-func (c *Client) PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+// NewPostRpcRevokeClientSecretRequestWithBody generates requests for PostRpcRevokeClientSecret with any type of body
+func NewPostRpcRevokeClientSecretRequestWithBody(server string, params *PostRpcRevokeClientSecretParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/rpc/revoke_client_secret")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostProjectsWithBody(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostProjectsRequestWithBody(c.Server, params, contentType, body)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostProjects(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostProjectsRequest(c.Server, params, body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
 	}
-	return c.Client.Do(req)
+
+	return req, nil
 }
 
-func (c *Client) PostProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+// NewPostRpcRollbackSecretRequest calls the generic PostRpcRollbackSecret builder with application/json body
+func NewPostRpcRollbackSecretRequest(server string, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRollbackSecretRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-func (c *Client) PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+// NewPostRpcRollbackSecretRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcRollbackSecret builder with application/vnd.pgrst.object+json body
+func NewPostRpcRollbackSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRollbackSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) GetRpcSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRpcSecretsRequest(c.Server)
+// NewPostRpcRollbackSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcRollbackSecret builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcRollbackSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRollbackSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-func (c *Client) PostRpcSecretsWithBody(ctx context.Context, params *PostRpcSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostRpcSecretsRequestWithBody(c.Server, params, contentType, body)
+// NewPostRpcRollbackSecretRequestWithBody generates requests for PostRpcRollbackSecret with any type of body
+func NewPostRpcRollbackSecretRequestWithBody(server string, params *PostRpcRollbackSecretParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/rpc/rollback_secret")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostRpcSecrets(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostRpcSecretsRequest(c.Server, params, body)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
 	}
-	return c.Client.Do(req)
+
+	return req, nil
 }
 
-func (c *Client) PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+// NewPostRpcRotateSecretsRequest calls the generic PostRpcRotateSecrets builder with application/json body
+func NewPostRpcRotateSecretsRequest(server string, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRotateSecretsRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-func (c *Client) DeleteSecrets(ctx context.Context, params *DeleteSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteSecretsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+// NewPostRpcRotateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcRotateSecrets builder with application/vnd.pgrst.object+json body
+func NewPostRpcRotateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRotateSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) GetSecrets(ctx context.Context, params *GetSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSecretsRequest(c.Server, params)
+// NewPostRpcRotateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcRotateSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcRotateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcRotateSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-func (c *Client) PatchSecretsWithBody(ctx context.Context, params *PatchSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchSecretsRequestWithBody(c.Server, params, contentType, body)
+// NewPostRpcRotateSecretsRequestWithBody generates requests for PostRpcRotateSecrets with any type of body
+func NewPostRpcRotateSecretsRequestWithBody(server string, params *PostRpcRotateSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/rpc/rotate_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PatchSecrets(ctx context.Context, params *PatchSecretsParams, body PatchSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchSecretsRequest(c.Server, params, body)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PatchSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
 	}
-	return c.Client.Do(req)
+
+	return req, nil
 }
 
-func (c *Client) PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+// NewPostRpcSecretEventsRequest calls the generic PostRpcSecretEvents builder with application/json body
+func NewPostRpcSecretEventsRequest(server string, params *PostRpcSecretEventsParams, body PostRpcSecretEventsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretEventsRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-func (c *Client) PostSecretsWithBody(ctx context.Context, params *PostSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSecretsRequestWithBody(c.Server, params, contentType, body)
+// NewPostRpcSecretEventsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSecretEvents builder with application/vnd.pgrst.object+json body
+func NewPostRpcSecretEventsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretEventsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-func (c *Client) PostSecrets(ctx context.Context, params *PostSecretsParams, body PostSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSecretsRequest(c.Server, params, body)
+// NewPostRpcSecretEventsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSecretEvents builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcSecretEventsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretEventsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-func (c *Client) PostSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+// NewPostRpcSecretEventsRequestWithBody generates requests for PostRpcSecretEvents with any type of body
+func NewPostRpcSecretEventsRequestWithBody(server string, params *PostRpcSecretEventsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/rpc/secret_events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) DeleteVariables(ctx context.Context, params *DeleteVariablesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteVariablesRequest(c.Server, params)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
 	}
-	return c.Client.Do(req)
+
+	return req, nil
 }
 
-func (c *Client) GetVariables(ctx context.Context, params *GetVariablesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVariablesRequest(c.Server, params)
+// NewPostRpcSecretEventsCursorRequest calls the generic PostRpcSecretEventsCursor builder with application/json body
+func NewPostRpcSecretEventsCursorRequest(server string, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretEventsCursorRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-func (c *Client) PatchVariablesWithBody(ctx context.Context, params *PatchVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchVariablesRequestWithBody(c.Server, params, contentType, body)
+// NewPostRpcSecretEventsCursorRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSecretEventsCursor builder with application/vnd.pgrst.object+json body
+func NewPostRpcSecretEventsCursorRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretEventsCursorRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcSecretEventsCursorRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSecretEventsCursor builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcSecretEventsCursorRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretEventsCursorRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-func (c *Client) PatchVariables(ctx context.Context, params *PatchVariablesParams, body PatchVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchVariablesRequest(c.Server, params, body)
+// NewPostRpcSecretEventsCursorRequestWithBody generates requests for PostRpcSecretEventsCursor with any type of body
+func NewPostRpcSecretEventsCursorRequestWithBody(server string, params *PostRpcSecretEventsCursorParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/rpc/secret_events_cursor")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PatchVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-func (c *Client) PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
+// NewPostRpcSecretVersionsRequest calls the generic PostRpcSecretVersions builder with application/json body
+func NewPostRpcSecretVersionsRequest(server string, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretVersionsRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-func (c *Client) PostVariablesWithBody(ctx context.Context, params *PostVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostVariablesRequestWithBody(c.Server, params, contentType, body)
+// NewPostRpcSecretVersionsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSecretVersions builder with application/vnd.pgrst.object+json body
+func NewPostRpcSecretVersionsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretVersionsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcSecretVersionsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSecretVersions builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcSecretVersionsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretVersionsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-func (c *Client) PostVariables(ctx context.Context, params *PostVariablesParams, body PostVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostVariablesRequest(c.Server, params, body)
+// NewPostRpcSecretVersionsRequestWithBody generates requests for PostRpcSecretVersions with any type of body
+func NewPostRpcSecretVersionsRequestWithBody(server string, params *PostRpcSecretVersionsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/rpc/secret_versions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(c.Server, params, body)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(c.Server, params, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Prefer", headerParam0)
+		}
+
 	}
-	return c.Client.Do(req)
+
+	return req, nil
 }
 
-// NewGetRequest generates requests for Get
-func NewGetRequest(server string) (*http.Request, error) {
+// NewGetRpcSecretsRequest generates requests for GetRpcSecrets
+func NewGetRpcSecretsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1883,7 +8523,7 @@ func NewGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/")
+	operationPath := fmt.Sprintf("/rpc/secrets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1901,8 +8541,41 @@ func NewGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeleteClientsRequest generates requests for DeleteClients
-func NewDeleteClientsRequest(server string, params *DeleteClientsParams) (*http.Request, error) {
+// NewPostRpcSecretsRequest calls the generic PostRpcSecrets builder with application/json body
+func NewPostRpcSecretsRequest(server string, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSecrets builder with application/vnd.pgrst.object+json body
+func NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcSecretsRequestWithBody generates requests for PostRpcSecrets with any type of body
+func NewPostRpcSecretsRequestWithBody(server string, params *PostRpcSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1910,7 +8583,7 @@ func NewDeleteClientsRequest(server string, params *DeleteClientsParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clients")
+	operationPath := fmt.Sprintf("/rpc/secrets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1920,81 +8593,13 @@ func NewDeleteClientsRequest(server string, params *DeleteClientsParams) (*http.
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Id != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.CreatedAt != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Display != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.EnvironmentId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		if params.Prefer != nil {
@@ -2013,8 +8618,41 @@ func NewDeleteClientsRequest(server string, params *DeleteClientsParams) (*http.
 	return req, nil
 }
 
-// NewGetClientsRequest generates requests for GetClients
-func NewGetClientsRequest(server string, params *GetClientsParams) (*http.Request, error) {
+// NewPostRpcSetCertificateRequest calls the generic PostRpcSetCertificate builder with application/json body
+func NewPostRpcSetCertificateRequest(server string, params *PostRpcSetCertificateParams, body PostRpcSetCertificateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetCertificateRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcSetCertificateRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSetCertificate builder with application/vnd.pgrst.object+json body
+func NewPostRpcSetCertificateRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetCertificateRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcSetCertificateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSetCertificate builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcSetCertificateRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetCertificateRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcSetCertificateRequestWithBody generates requests for PostRpcSetCertificate with any type of body
+func NewPostRpcSetCertificateRequestWithBody(server string, params *PostRpcSetCertificateParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2022,7 +8660,7 @@ func NewGetClientsRequest(server string, params *GetClientsParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clients")
+	operationPath := fmt.Sprintf("/rpc/set_certificate")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2032,178 +8670,255 @@ func NewGetClientsRequest(server string, params *GetClientsParams) (*http.Reques
 		return nil, err
 	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
-		queryValues := queryURL.Query()
 
-		if params.Id != nil {
+		if params.Prefer != nil {
+			var headerParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
 				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
 			}
 
+			req.Header.Set("Prefer", headerParam0)
 		}
 
-		if params.CreatedAt != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Display != nil {
+// NewPostRpcSetCertificateAuthorityRequest calls the generic PostRpcSetCertificateAuthority builder with application/json body
+func NewPostRpcSetCertificateAuthorityRequest(server string, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetCertificateAuthorityRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewPostRpcSetCertificateAuthorityRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSetCertificateAuthority builder with application/vnd.pgrst.object+json body
+func NewPostRpcSetCertificateAuthorityRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetCertificateAuthorityRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
 
-		}
+// NewPostRpcSetCertificateAuthorityRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSetCertificateAuthority builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcSetCertificateAuthorityRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetCertificateAuthorityRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
 
-		if params.EnvironmentId != nil {
+// NewPostRpcSetCertificateAuthorityRequestWithBody generates requests for PostRpcSetCertificateAuthority with any type of body
+func NewPostRpcSetCertificateAuthorityRequestWithBody(server string, params *PostRpcSetCertificateAuthorityParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/rpc/set_certificate_authority")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.Select != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if params.Order != nil {
+	if params != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
 				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
 			}
 
+			req.Header.Set("Prefer", headerParam0)
 		}
 
-		if params.Offset != nil {
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewPostRpcSetKeypairRequest calls the generic PostRpcSetKeypair builder with application/json body
+func NewPostRpcSetKeypairRequest(server string, params *PostRpcSetKeypairParams, body PostRpcSetKeypairJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetKeypairRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-		if params.Limit != nil {
+// NewPostRpcSetKeypairRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSetKeypair builder with application/vnd.pgrst.object+json body
+func NewPostRpcSetKeypairRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetKeypairRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewPostRpcSetKeypairRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSetKeypair builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcSetKeypairRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetKeypairRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
 
-		}
+// NewPostRpcSetKeypairRequestWithBody generates requests for PostRpcSetKeypair with any type of body
+func NewPostRpcSetKeypairRequestWithBody(server string, params *PostRpcSetKeypairParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		queryURL.RawQuery = queryValues.Encode()
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	operationPath := fmt.Sprintf("/rpc/set_keypair")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
-		if params.Range != nil {
+		if params.Prefer != nil {
 			var headerParam0 string
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
 			if err != nil {
 				return nil, err
 			}
 
-			req.Header.Set("Range", headerParam0)
+			req.Header.Set("Prefer", headerParam0)
 		}
 
-		if params.RangeUnit != nil {
-			var headerParam1 string
+	}
 
-			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Range-Unit", runtime.ParamLocationHeader, *params.RangeUnit)
-			if err != nil {
-				return nil, err
-			}
+	return req, nil
+}
 
-			req.Header.Set("Range-Unit", headerParam1)
-		}
+// NewPostRpcSetSecretRequest calls the generic PostRpcSetSecret builder with application/json body
+func NewPostRpcSetSecretRequest(server string, params *PostRpcSetSecretParams, body PostRpcSetSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetSecretRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostRpcSetSecretRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSetSecret builder with application/vnd.pgrst.object+json body
+func NewPostRpcSetSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
+
+// NewPostRpcSetSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSetSecret builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcSetSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcSetSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
+
+// NewPostRpcSetSecretRequestWithBody generates requests for PostRpcSetSecret with any type of body
+func NewPostRpcSetSecretRequestWithBody(server string, params *PostRpcSetSecretParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rpc/set_secret")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
 
 		if params.Prefer != nil {
-			var headerParam2 string
+			var headerParam0 string
 
-			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
 			if err != nil {
 				return nil, err
 			}
 
-			req.Header.Set("Prefer", headerParam2)
+			req.Header.Set("Prefer", headerParam0)
 		}
 
 	}
@@ -2211,41 +8926,41 @@ func NewGetClientsRequest(server string, params *GetClientsParams) (*http.Reques
 	return req, nil
 }
 
-// NewPatchClientsRequest calls the generic PatchClients builder with application/json body
-func NewPatchClientsRequest(server string, params *PatchClientsParams, body PatchClientsJSONRequestBody) (*http.Request, error) {
+// NewPostRpcUnsetSecretRequest calls the generic PostRpcUnsetSecret builder with application/json body
+func NewPostRpcUnsetSecretRequest(server string, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchClientsRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPostRpcUnsetSecretRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchClients builder with application/vnd.pgrst.object+json body
-func NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+// NewPostRpcUnsetSecretRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcUnsetSecret builder with application/vnd.pgrst.object+json body
+func NewPostRpcUnsetSecretRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchClientsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return NewPostRpcUnsetSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-// NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchClients builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPatchClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+// NewPostRpcUnsetSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcUnsetSecret builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcUnsetSecretRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchClientsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+	return NewPostRpcUnsetSecretRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-// NewPatchClientsRequestWithBody generates requests for PatchClients with any type of body
-func NewPatchClientsRequestWithBody(server string, params *PatchClientsParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostRpcUnsetSecretRequestWithBody generates requests for PostRpcUnsetSecret with any type of body
+func NewPostRpcUnsetSecretRequestWithBody(server string, params *PostRpcUnsetSecretParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2253,7 +8968,7 @@ func NewPatchClientsRequestWithBody(server string, params *PatchClientsParams, c
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clients")
+	operationPath := fmt.Sprintf("/rpc/unset_secret")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2263,77 +8978,84 @@ func NewPatchClientsRequestWithBody(server string, params *PatchClientsParams, c
 		return nil, err
 	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
-		queryValues := queryURL.Query()
 
-		if params.Id != nil {
+		if params.Prefer != nil {
+			var headerParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
 				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
 			}
 
+			req.Header.Set("Prefer", headerParam0)
 		}
 
-		if params.CreatedAt != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Display != nil {
+// NewPostRpcValidateSecretsRequest calls the generic PostRpcValidateSecrets builder with application/json body
+func NewPostRpcValidateSecretsRequest(server string, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcValidateSecretsRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewPostRpcValidateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcValidateSecrets builder with application/vnd.pgrst.object+json body
+func NewPostRpcValidateSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcValidateSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
 
-		}
+// NewPostRpcValidateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcValidateSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcValidateSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRpcValidateSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
 
-		if params.EnvironmentId != nil {
+// NewPostRpcValidateSecretsRequestWithBody generates requests for PostRpcValidateSecrets with any type of body
+func NewPostRpcValidateSecretsRequestWithBody(server string, params *PostRpcValidateSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/rpc/validate_secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2358,41 +9080,41 @@ func NewPatchClientsRequestWithBody(server string, params *PatchClientsParams, c
 	return req, nil
 }
 
-// NewPostClientsRequest calls the generic PostClients builder with application/json body
-func NewPostClientsRequest(server string, params *PostClientsParams, body PostClientsJSONRequestBody) (*http.Request, error) {
+// NewPostRpcVariableMetadataRequest calls the generic PostRpcVariableMetadata builder with application/json body
+func NewPostRpcVariableMetadataRequest(server string, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostClientsRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPostRpcVariableMetadataRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostClients builder with application/vnd.pgrst.object+json body
-func NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+// NewPostRpcVariableMetadataRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcVariableMetadata builder with application/vnd.pgrst.object+json body
+func NewPostRpcVariableMetadataRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostClientsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return NewPostRpcVariableMetadataRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-// NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostClients builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPostClientsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+// NewPostRpcVariableMetadataRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcVariableMetadata builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostRpcVariableMetadataRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostClientsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+	return NewPostRpcVariableMetadataRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-// NewPostClientsRequestWithBody generates requests for PostClients with any type of body
-func NewPostClientsRequestWithBody(server string, params *PostClientsParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostRpcVariableMetadataRequestWithBody generates requests for PostRpcVariableMetadata with any type of body
+func NewPostRpcVariableMetadataRequestWithBody(server string, params *PostRpcVariableMetadataParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2400,7 +9122,7 @@ func NewPostClientsRequestWithBody(server string, params *PostClientsParams, con
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clients")
+	operationPath := fmt.Sprintf("/rpc/variable_metadata")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2410,28 +9132,6 @@ func NewPostClientsRequestWithBody(server string, params *PostClientsParams, con
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Select != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -2457,8 +9157,8 @@ func NewPostClientsRequestWithBody(server string, params *PostClientsParams, con
 	return req, nil
 }
 
-// NewDeleteClientsSecretsRequest generates requests for DeleteClientsSecrets
-func NewDeleteClientsSecretsRequest(server string, params *DeleteClientsSecretsParams) (*http.Request, error) {
+// NewDeleteSecretsRequest generates requests for DeleteSecrets
+func NewDeleteSecretsRequest(server string, params *DeleteSecretsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2466,7 +9166,7 @@ func NewDeleteClientsSecretsRequest(server string, params *DeleteClientsSecretsP
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clients_secrets")
+	operationPath := fmt.Sprintf("/secrets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2511,9 +9211,9 @@ func NewDeleteClientsSecretsRequest(server string, params *DeleteClientsSecretsP
 
 		}
 
-		if params.Hash != nil {
+		if params.VariableId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hash", runtime.ParamLocationQuery, *params.Hash); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variable_id", runtime.ParamLocationQuery, *params.VariableId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2527,9 +9227,57 @@ func NewDeleteClientsSecretsRequest(server string, params *DeleteClientsSecretsP
 
 		}
 
-		if params.ClientId != nil {
+		if params.EnvironmentId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Inherited != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "inherited", runtime.ParamLocationQuery, *params.Inherited); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overridden != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overridden", runtime.ParamLocationQuery, *params.Overridden); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Pending != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pending", runtime.ParamLocationQuery, *params.Pending); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2569,8 +9317,8 @@ func NewDeleteClientsSecretsRequest(server string, params *DeleteClientsSecretsP
 	return req, nil
 }
 
-// NewGetClientsSecretsRequest generates requests for GetClientsSecrets
-func NewGetClientsSecretsRequest(server string, params *GetClientsSecretsParams) (*http.Request, error) {
+// NewGetSecretsRequest generates requests for GetSecrets
+func NewGetSecretsRequest(server string, params *GetSecretsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2578,7 +9326,7 @@ func NewGetClientsSecretsRequest(server string, params *GetClientsSecretsParams)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clients_secrets")
+	operationPath := fmt.Sprintf("/secrets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2623,9 +9371,9 @@ func NewGetClientsSecretsRequest(server string, params *GetClientsSecretsParams)
 
 		}
 
-		if params.Hash != nil {
+		if params.VariableId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hash", runtime.ParamLocationQuery, *params.Hash); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variable_id", runtime.ParamLocationQuery, *params.VariableId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2639,9 +9387,57 @@ func NewGetClientsSecretsRequest(server string, params *GetClientsSecretsParams)
 
 		}
 
-		if params.ClientId != nil {
+		if params.EnvironmentId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Inherited != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "inherited", runtime.ParamLocationQuery, *params.Inherited); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overridden != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overridden", runtime.ParamLocationQuery, *params.Overridden); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Pending != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pending", runtime.ParamLocationQuery, *params.Pending); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2767,41 +9563,41 @@ func NewGetClientsSecretsRequest(server string, params *GetClientsSecretsParams)
 	return req, nil
 }
 
-// NewPatchClientsSecretsRequest calls the generic PatchClientsSecrets builder with application/json body
-func NewPatchClientsSecretsRequest(server string, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody) (*http.Request, error) {
+// NewPatchSecretsRequest calls the generic PatchSecrets builder with application/json body
+func NewPatchSecretsRequest(server string, params *PatchSecretsParams, body PatchSecretsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchClientsSecretsRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPatchSecretsRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchClientsSecrets builder with application/vnd.pgrst.object+json body
-func NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+// NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchSecrets builder with application/vnd.pgrst.object+json body
+func NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchClientsSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return NewPatchSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-// NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchClientsSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPatchClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+// NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchClientsSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+	return NewPatchSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-// NewPatchClientsSecretsRequestWithBody generates requests for PatchClientsSecrets with any type of body
-func NewPatchClientsSecretsRequestWithBody(server string, params *PatchClientsSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchSecretsRequestWithBody generates requests for PatchSecrets with any type of body
+func NewPatchSecretsRequestWithBody(server string, params *PatchSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2809,7 +9605,7 @@ func NewPatchClientsSecretsRequestWithBody(server string, params *PatchClientsSe
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clients_secrets")
+	operationPath := fmt.Sprintf("/secrets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2854,9 +9650,57 @@ func NewPatchClientsSecretsRequestWithBody(server string, params *PatchClientsSe
 
 		}
 
-		if params.Hash != nil {
+		if params.VariableId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variable_id", runtime.ParamLocationQuery, *params.VariableId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EnvironmentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Inherited != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "inherited", runtime.ParamLocationQuery, *params.Inherited); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overridden != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hash", runtime.ParamLocationQuery, *params.Hash); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overridden", runtime.ParamLocationQuery, *params.Overridden); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2870,9 +9714,9 @@ func NewPatchClientsSecretsRequestWithBody(server string, params *PatchClientsSe
 
 		}
 
-		if params.ClientId != nil {
+		if params.Pending != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pending", runtime.ParamLocationQuery, *params.Pending); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2914,41 +9758,41 @@ func NewPatchClientsSecretsRequestWithBody(server string, params *PatchClientsSe
 	return req, nil
 }
 
-// NewPostClientsSecretsRequest calls the generic PostClientsSecrets builder with application/json body
-func NewPostClientsSecretsRequest(server string, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody) (*http.Request, error) {
+// NewPostSecretsRequest calls the generic PostSecrets builder with application/json body
+func NewPostSecretsRequest(server string, params *PostSecretsParams, body PostSecretsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostClientsSecretsRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPostSecretsRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostClientsSecrets builder with application/vnd.pgrst.object+json body
-func NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+// NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostSecrets builder with application/vnd.pgrst.object+json body
+func NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostClientsSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return NewPostSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-// NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostClientsSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPostClientsSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+// NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostClientsSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+	return NewPostSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-// NewPostClientsSecretsRequestWithBody generates requests for PostClientsSecrets with any type of body
-func NewPostClientsSecretsRequestWithBody(server string, params *PostClientsSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostSecretsRequestWithBody generates requests for PostSecrets with any type of body
+func NewPostSecretsRequestWithBody(server string, params *PostSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2956,7 +9800,7 @@ func NewPostClientsSecretsRequestWithBody(server string, params *PostClientsSecr
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clients_secrets")
+	operationPath := fmt.Sprintf("/secrets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3013,8 +9857,8 @@ func NewPostClientsSecretsRequestWithBody(server string, params *PostClientsSecr
 	return req, nil
 }
 
-// NewDeleteEnvironmentsRequest generates requests for DeleteEnvironments
-func NewDeleteEnvironmentsRequest(server string, params *DeleteEnvironmentsParams) (*http.Request, error) {
+// NewDeleteVariablesRequest generates requests for DeleteVariables
+func NewDeleteVariablesRequest(server string, params *DeleteVariablesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3022,7 +9866,7 @@ func NewDeleteEnvironmentsRequest(server string, params *DeleteEnvironmentsParam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/environments")
+	operationPath := fmt.Sprintf("/variables")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3051,9 +9895,9 @@ func NewDeleteEnvironmentsRequest(server string, params *DeleteEnvironmentsParam
 
 		}
 
-		if params.CreatedAt != nil {
+		if params.Description != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3067,9 +9911,41 @@ func NewDeleteEnvironmentsRequest(server string, params *DeleteEnvironmentsParam
 
 		}
 
-		if params.Display != nil {
+		if params.Key != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GeneratorData != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_data", runtime.ParamLocationQuery, *params.GeneratorData); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GeneratorType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_type", runtime.ParamLocationQuery, *params.GeneratorType); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3099,6 +9975,86 @@ func NewDeleteEnvironmentsRequest(server string, params *DeleteEnvironmentsParam
 
 		}
 
+		if params.ValueType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "value_type", runtime.ParamLocationQuery, *params.ValueType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ValueConstraints != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "value_constraints", runtime.ParamLocationQuery, *params.ValueConstraints); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tags != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Owner != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "owner", runtime.ParamLocationQuery, *params.Owner); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sensitive != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensitive", runtime.ParamLocationQuery, *params.Sensitive); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -3125,8 +10081,8 @@ func NewDeleteEnvironmentsRequest(server string, params *DeleteEnvironmentsParam
 	return req, nil
 }
 
-// NewGetEnvironmentsRequest generates requests for GetEnvironments
-func NewGetEnvironmentsRequest(server string, params *GetEnvironmentsParams) (*http.Request, error) {
+// NewGetVariablesRequest generates requests for GetVariables
+func NewGetVariablesRequest(server string, params *GetVariablesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3134,22 +10090,134 @@ func NewGetEnvironmentsRequest(server string, params *GetEnvironmentsParams) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/environments")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	operationPath := fmt.Sprintf("/variables")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Description != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Key != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GeneratorData != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_data", runtime.ParamLocationQuery, *params.GeneratorData); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GeneratorType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_type", runtime.ParamLocationQuery, *params.GeneratorType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ValueType != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "value_type", runtime.ParamLocationQuery, *params.ValueType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		}
 
-		if params.Id != nil {
+		if params.ValueConstraints != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "value_constraints", runtime.ParamLocationQuery, *params.ValueConstraints); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3163,9 +10231,9 @@ func NewGetEnvironmentsRequest(server string, params *GetEnvironmentsParams) (*h
 
 		}
 
-		if params.CreatedAt != nil {
+		if params.Tags != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3179,9 +10247,9 @@ func NewGetEnvironmentsRequest(server string, params *GetEnvironmentsParams) (*h
 
 		}
 
-		if params.Display != nil {
+		if params.Owner != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "owner", runtime.ParamLocationQuery, *params.Owner); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3195,9 +10263,9 @@ func NewGetEnvironmentsRequest(server string, params *GetEnvironmentsParams) (*h
 
 		}
 
-		if params.ProjectId != nil {
+		if params.Sensitive != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensitive", runtime.ParamLocationQuery, *params.Sensitive); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3323,41 +10391,41 @@ func NewGetEnvironmentsRequest(server string, params *GetEnvironmentsParams) (*h
 	return req, nil
 }
 
-// NewPatchEnvironmentsRequest calls the generic PatchEnvironments builder with application/json body
-func NewPatchEnvironmentsRequest(server string, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody) (*http.Request, error) {
+// NewPatchVariablesRequest calls the generic PatchVariables builder with application/json body
+func NewPatchVariablesRequest(server string, params *PatchVariablesParams, body PatchVariablesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchEnvironmentsRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPatchVariablesRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchEnvironments builder with application/vnd.pgrst.object+json body
-func NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+// NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchVariables builder with application/vnd.pgrst.object+json body
+func NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchEnvironmentsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return NewPatchVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
 }
 
-// NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchEnvironments builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPatchEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+// NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchVariables builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchEnvironmentsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+	return NewPatchVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
 }
 
-// NewPatchEnvironmentsRequestWithBody generates requests for PatchEnvironments with any type of body
-func NewPatchEnvironmentsRequestWithBody(server string, params *PatchEnvironmentsParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchVariablesRequestWithBody generates requests for PatchVariables with any type of body
+func NewPatchVariablesRequestWithBody(server string, params *PatchVariablesParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3365,7 +10433,7 @@ func NewPatchEnvironmentsRequestWithBody(server string, params *PatchEnvironment
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/environments")
+	operationPath := fmt.Sprintf("/variables")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3394,9 +10462,9 @@ func NewPatchEnvironmentsRequestWithBody(server string, params *PatchEnvironment
 
 		}
 
-		if params.CreatedAt != nil {
+		if params.Description != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3410,9 +10478,9 @@ func NewPatchEnvironmentsRequestWithBody(server string, params *PatchEnvironment
 
 		}
 
-		if params.Display != nil {
+		if params.Key != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3426,9 +10494,9 @@ func NewPatchEnvironmentsRequestWithBody(server string, params *PatchEnvironment
 
 		}
 
-		if params.ProjectId != nil {
+		if params.GeneratorData != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_data", runtime.ParamLocationQuery, *params.GeneratorData); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3442,92 +10510,105 @@ func NewPatchEnvironmentsRequestWithBody(server string, params *PatchEnvironment
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+		if params.GeneratorType != nil {
 
-	req.Header.Add("Content-Type", contentType)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_type", runtime.ParamLocationQuery, *params.GeneratorType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	if params != nil {
+		}
 
-		if params.Prefer != nil {
-			var headerParam0 string
+		if params.ProjectId != nil {
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
 			}
 
-			req.Header.Set("Prefer", headerParam0)
 		}
 
-	}
+		if params.ValueType != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "value_type", runtime.ParamLocationQuery, *params.ValueType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewPostEnvironmentsRequest calls the generic PostEnvironments builder with application/json body
-func NewPostEnvironmentsRequest(server string, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostEnvironmentsRequestWithBody(server, params, "application/json", bodyReader)
-}
+		}
 
-// NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostEnvironments builder with application/vnd.pgrst.object+json body
-func NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostEnvironmentsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
-}
+		if params.ValueConstraints != nil {
 
-// NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostEnvironments builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPostEnvironmentsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostEnvironmentsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "value_constraints", runtime.ParamLocationQuery, *params.ValueConstraints); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewPostEnvironmentsRequestWithBody generates requests for PostEnvironments with any type of body
-func NewPostEnvironmentsRequestWithBody(server string, params *PostEnvironmentsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.Tags != nil {
 
-	operationPath := fmt.Sprintf("/environments")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+		if params.Owner != nil {
 
-		if params.Select != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "owner", runtime.ParamLocationQuery, *params.Owner); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
+		}
+
+		if params.Sensitive != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensitive", runtime.ParamLocationQuery, *params.Sensitive); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3544,7 +10625,7 @@ func NewPostEnvironmentsRequestWithBody(server string, params *PostEnvironmentsP
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3569,47 +10650,64 @@ func NewPostEnvironmentsRequestWithBody(server string, params *PostEnvironmentsP
 	return req, nil
 }
 
-// NewDeleteProjectsRequest generates requests for DeleteProjects
-func NewDeleteProjectsRequest(server string, params *DeleteProjectsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// NewPostVariablesRequest calls the generic PostVariables builder with application/json body
+func NewPostVariablesRequest(server string, params *PostVariablesParams, body PostVariablesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostVariablesRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-	operationPath := fmt.Sprintf("/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostVariables builder with application/vnd.pgrst.object+json body
+func NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostVariables builder with application/vnd.pgrst.object+json;nulls=stripped body
+func NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+// NewPostVariablesRequestWithBody generates requests for PostVariables with any type of body
+func NewPostVariablesRequestWithBody(server string, params *PostVariablesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		if params.Id != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/variables")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Display != nil {
+	if params != nil {
+		queryValues := queryURL.Query()
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3626,11 +10724,13 @@ func NewDeleteProjectsRequest(server string, params *DeleteProjectsParams) (*htt
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		if params.Prefer != nil {
@@ -3649,3050 +10749,3693 @@ func NewDeleteProjectsRequest(server string, params *DeleteProjectsParams) (*htt
 	return req, nil
 }
 
-// NewGetProjectsRequest generates requests for GetProjects
-func NewGetProjectsRequest(server string, params *GetProjectsParams) (*http.Request, error) {
-	var err error
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	serverURL, err := url.Parse(server)
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return &ClientWithResponses{client}, nil
+}
 
-	operationPath := fmt.Sprintf("/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetWithResponse request
+	GetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResponse, error)
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	// DeleteClientsWithResponse request
+	DeleteClientsWithResponse(ctx context.Context, params *DeleteClientsParams, reqEditors ...RequestEditorFn) (*DeleteClientsResponse, error)
 
-		if params.Id != nil {
+	// GetClientsWithResponse request
+	GetClientsWithResponse(ctx context.Context, params *GetClientsParams, reqEditors ...RequestEditorFn) (*GetClientsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// PatchClientsWithBodyWithResponse request with any body
+	PatchClientsWithBodyWithResponse(ctx context.Context, params *PatchClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error)
 
-		}
+	PatchClientsWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error)
 
-		if params.Display != nil {
+	PatchClientsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error)
 
-		}
+	// PostClientsWithBodyWithResponse request with any body
+	PostClientsWithBodyWithResponse(ctx context.Context, params *PostClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostClientsResponse, error)
 
-		if params.Select != nil {
+	PostClientsWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PostClientsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error)
 
-		}
+	PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error)
 
-		if params.Order != nil {
+	// DeleteClientsSecretsWithResponse request
+	DeleteClientsSecretsWithResponse(ctx context.Context, params *DeleteClientsSecretsParams, reqEditors ...RequestEditorFn) (*DeleteClientsSecretsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// GetClientsSecretsWithResponse request
+	GetClientsSecretsWithResponse(ctx context.Context, params *GetClientsSecretsParams, reqEditors ...RequestEditorFn) (*GetClientsSecretsResponse, error)
 
-		}
+	// PatchClientsSecretsWithBodyWithResponse request with any body
+	PatchClientsSecretsWithBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error)
 
-		if params.Offset != nil {
+	PatchClientsSecretsWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error)
 
-		}
+	PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error)
+
+	// PostClientsSecretsWithBodyWithResponse request with any body
+	PostClientsSecretsWithBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error)
+
+	PostClientsSecretsWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error)
+
+	PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error)
+
+	PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error)
+
+	// DeleteEnvironmentsWithResponse request
+	DeleteEnvironmentsWithResponse(ctx context.Context, params *DeleteEnvironmentsParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentsResponse, error)
+
+	// GetEnvironmentsWithResponse request
+	GetEnvironmentsWithResponse(ctx context.Context, params *GetEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetEnvironmentsResponse, error)
+
+	// PatchEnvironmentsWithBodyWithResponse request with any body
+	PatchEnvironmentsWithBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error)
+
+	PatchEnvironmentsWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error)
+
+	PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error)
+
+	PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error)
+
+	// PostEnvironmentsWithBodyWithResponse request with any body
+	PostEnvironmentsWithBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error)
+
+	PostEnvironmentsWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error)
+
+	PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error)
+
+	PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error)
+
+	// DeleteProjectsWithResponse request
+	DeleteProjectsWithResponse(ctx context.Context, params *DeleteProjectsParams, reqEditors ...RequestEditorFn) (*DeleteProjectsResponse, error)
+
+	// GetProjectsWithResponse request
+	GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error)
+
+	// PatchProjectsWithBodyWithResponse request with any body
+	PatchProjectsWithBodyWithResponse(ctx context.Context, params *PatchProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error)
+
+	PatchProjectsWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error)
+
+	PatchProjectsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error)
+
+	PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error)
+
+	// PostProjectsWithBodyWithResponse request with any body
+	PostProjectsWithBodyWithResponse(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
+
+	PostProjectsWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
+
+	PostProjectsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
+
+	PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
+
+	// PostRpcAdminApiKeysWithBodyWithResponse request with any body
+	PostRpcAdminApiKeysWithBodyWithResponse(ctx context.Context, params *PostRpcAdminApiKeysParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcAdminApiKeysResponse, error)
+
+	PostRpcAdminApiKeysWithResponse(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAdminApiKeysResponse, error)
+
+	PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAdminApiKeysResponse, error)
+
+	PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAdminApiKeysResponse, error)
+
+	// PostRpcAuditLogWithBodyWithResponse request with any body
+	PostRpcAuditLogWithBodyWithResponse(ctx context.Context, params *PostRpcAuditLogParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcAuditLogResponse, error)
+
+	PostRpcAuditLogWithResponse(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditLogResponse, error)
+
+	PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditLogResponse, error)
+
+	PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditLogResponse, error)
+
+	// PostRpcAuditRequestWithBodyWithResponse request with any body
+	PostRpcAuditRequestWithBodyWithResponse(ctx context.Context, params *PostRpcAuditRequestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcAuditRequestResponse, error)
+
+	PostRpcAuditRequestWithResponse(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditRequestResponse, error)
+
+	PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditRequestResponse, error)
+
+	PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditRequestResponse, error)
+
+	// PostRpcCertificatesToRotateWithBodyWithResponse request with any body
+	PostRpcCertificatesToRotateWithBodyWithResponse(ctx context.Context, params *PostRpcCertificatesToRotateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcCertificatesToRotateResponse, error)
+
+	PostRpcCertificatesToRotateWithResponse(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCertificatesToRotateResponse, error)
+
+	PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCertificatesToRotateResponse, error)
+
+	PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCertificatesToRotateResponse, error)
+
+	// PostRpcCreateAdminApiKeyWithBodyWithResponse request with any body
+	PostRpcCreateAdminApiKeyWithBodyWithResponse(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcCreateAdminApiKeyResponse, error)
+
+	PostRpcCreateAdminApiKeyWithResponse(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateAdminApiKeyResponse, error)
+
+	PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateAdminApiKeyResponse, error)
+
+	PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateAdminApiKeyResponse, error)
+
+	// PostRpcCreateClientWithBodyWithResponse request with any body
+	PostRpcCreateClientWithBodyWithResponse(ctx context.Context, params *PostRpcCreateClientParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcCreateClientResponse, error)
+
+	PostRpcCreateClientWithResponse(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientResponse, error)
+
+	PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientResponse, error)
+
+	PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientResponse, error)
+
+	// PostRpcCreateClientSecretWithBodyWithResponse request with any body
+	PostRpcCreateClientSecretWithBodyWithResponse(ctx context.Context, params *PostRpcCreateClientSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcCreateClientSecretResponse, error)
+
+	PostRpcCreateClientSecretWithResponse(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientSecretResponse, error)
+
+	PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientSecretResponse, error)
+
+	PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientSecretResponse, error)
+
+	// PostRpcImportVariablesWithBodyWithResponse request with any body
+	PostRpcImportVariablesWithBodyWithResponse(ctx context.Context, params *PostRpcImportVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcImportVariablesResponse, error)
+
+	PostRpcImportVariablesWithResponse(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcImportVariablesResponse, error)
+
+	PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcImportVariablesResponse, error)
+
+	PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcImportVariablesResponse, error)
+
+	// PostRpcIsAdminWithBodyWithResponse request with any body
+	PostRpcIsAdminWithBodyWithResponse(ctx context.Context, params *PostRpcIsAdminParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcIsAdminResponse, error)
+
+	PostRpcIsAdminWithResponse(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcIsAdminResponse, error)
+
+	PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcIsAdminResponse, error)
+
+	PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcIsAdminResponse, error)
+
+	// PostRpcKeypairsToRotateWithBodyWithResponse request with any body
+	PostRpcKeypairsToRotateWithBodyWithResponse(ctx context.Context, params *PostRpcKeypairsToRotateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcKeypairsToRotateResponse, error)
+
+	PostRpcKeypairsToRotateWithResponse(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcKeypairsToRotateResponse, error)
+
+	PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcKeypairsToRotateResponse, error)
+
+	PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcKeypairsToRotateResponse, error)
+
+	// PostRpcPendingCertificatesWithBodyWithResponse request with any body
+	PostRpcPendingCertificatesWithBodyWithResponse(ctx context.Context, params *PostRpcPendingCertificatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcPendingCertificatesResponse, error)
 
-		if params.Limit != nil {
+	PostRpcPendingCertificatesWithResponse(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingCertificatesResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingCertificatesResponse, error)
 
-		}
+	PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingCertificatesResponse, error)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	// PostRpcPendingKeypairsWithBodyWithResponse request with any body
+	PostRpcPendingKeypairsWithBodyWithResponse(ctx context.Context, params *PostRpcPendingKeypairsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcPendingKeypairsResponse, error)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcPendingKeypairsWithResponse(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingKeypairsResponse, error)
 
-	if params != nil {
+	PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingKeypairsResponse, error)
 
-		if params.Range != nil {
-			var headerParam0 string
+	PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingKeypairsResponse, error)
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
-			if err != nil {
-				return nil, err
-			}
+	// PostRpcRegenerateSecretsWithBodyWithResponse request with any body
+	PostRpcRegenerateSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcRegenerateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRegenerateSecretsResponse, error)
 
-			req.Header.Set("Range", headerParam0)
-		}
+	PostRpcRegenerateSecretsWithResponse(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRegenerateSecretsResponse, error)
 
-		if params.RangeUnit != nil {
-			var headerParam1 string
+	PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRegenerateSecretsResponse, error)
 
-			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Range-Unit", runtime.ParamLocationHeader, *params.RangeUnit)
-			if err != nil {
-				return nil, err
-			}
+	PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRegenerateSecretsResponse, error)
 
-			req.Header.Set("Range-Unit", headerParam1)
-		}
+	// PostRpcResolveSecretsWithBodyWithResponse request with any body
+	PostRpcResolveSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcResolveSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcResolveSecretsResponse, error)
 
-		if params.Prefer != nil {
-			var headerParam2 string
+	PostRpcResolveSecretsWithResponse(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcResolveSecretsResponse, error)
 
-			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+	PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcResolveSecretsResponse, error)
 
-			req.Header.Set("Prefer", headerParam2)
-		}
+	PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcResolveSecretsResponse, error)
 
-	}
+	// PostRpcRevealSecretsWithBodyWithResponse request with any body
+	PostRpcRevealSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcRevealSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRevealSecretsResponse, error)
 
-	return req, nil
-}
+	PostRpcRevealSecretsWithResponse(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevealSecretsResponse, error)
 
-// NewPatchProjectsRequest calls the generic PatchProjects builder with application/json body
-func NewPatchProjectsRequest(server string, params *PatchProjectsParams, body PatchProjectsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchProjectsRequestWithBody(server, params, "application/json", bodyReader)
-}
+	PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevealSecretsResponse, error)
 
-// NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchProjects builder with application/vnd.pgrst.object+json body
-func NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchProjectsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
-}
+	PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevealSecretsResponse, error)
 
-// NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchProjects builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPatchProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchProjectsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
-}
+	// PostRpcRevokeAdminApiKeyWithBodyWithResponse request with any body
+	PostRpcRevokeAdminApiKeyWithBodyWithResponse(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRevokeAdminApiKeyResponse, error)
 
-// NewPatchProjectsRequestWithBody generates requests for PatchProjects with any type of body
-func NewPatchProjectsRequestWithBody(server string, params *PatchProjectsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	PostRpcRevokeAdminApiKeyWithResponse(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeAdminApiKeyResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeAdminApiKeyResponse, error)
 
-	operationPath := fmt.Sprintf("/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeAdminApiKeyResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// PostRpcRevokeClientSecretWithBodyWithResponse request with any body
+	PostRpcRevokeClientSecretWithBodyWithResponse(ctx context.Context, params *PostRpcRevokeClientSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRevokeClientSecretResponse, error)
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	PostRpcRevokeClientSecretWithResponse(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeClientSecretResponse, error)
 
-		if params.Id != nil {
+	PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeClientSecretResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeClientSecretResponse, error)
 
-		}
+	// PostRpcRollbackSecretWithBodyWithResponse request with any body
+	PostRpcRollbackSecretWithBodyWithResponse(ctx context.Context, params *PostRpcRollbackSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRollbackSecretResponse, error)
 
-		if params.Display != nil {
+	PostRpcRollbackSecretWithResponse(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRollbackSecretResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "display", runtime.ParamLocationQuery, *params.Display); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRollbackSecretResponse, error)
 
-		}
+	PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRollbackSecretResponse, error)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	// PostRpcRotateSecretsWithBodyWithResponse request with any body
+	PostRpcRotateSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcRotateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRotateSecretsResponse, error)
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcRotateSecretsWithResponse(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRotateSecretsResponse, error)
 
-	req.Header.Add("Content-Type", contentType)
+	PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRotateSecretsResponse, error)
 
-	if params != nil {
+	PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRotateSecretsResponse, error)
 
-		if params.Prefer != nil {
-			var headerParam0 string
+	// PostRpcSecretEventsWithBodyWithResponse request with any body
+	PostRpcSecretEventsWithBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsResponse, error)
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+	PostRpcSecretEventsWithResponse(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsResponse, error)
 
-			req.Header.Set("Prefer", headerParam0)
-		}
+	PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsResponse, error)
 
-	}
+	PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsResponse, error)
 
-	return req, nil
-}
+	// PostRpcSecretEventsCursorWithBodyWithResponse request with any body
+	PostRpcSecretEventsCursorWithBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsCursorParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsCursorResponse, error)
 
-// NewPostProjectsRequest calls the generic PostProjects builder with application/json body
-func NewPostProjectsRequest(server string, params *PostProjectsParams, body PostProjectsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostProjectsRequestWithBody(server, params, "application/json", bodyReader)
-}
+	PostRpcSecretEventsCursorWithResponse(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsCursorResponse, error)
 
-// NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostProjects builder with application/vnd.pgrst.object+json body
-func NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostProjectsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
-}
+	PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsCursorResponse, error)
 
-// NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostProjects builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPostProjectsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostProjectsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
-}
+	PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsCursorResponse, error)
 
-// NewPostProjectsRequestWithBody generates requests for PostProjects with any type of body
-func NewPostProjectsRequestWithBody(server string, params *PostProjectsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// PostRpcSecretVersionsWithBodyWithResponse request with any body
+	PostRpcSecretVersionsWithBodyWithResponse(ctx context.Context, params *PostRpcSecretVersionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretVersionsResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcSecretVersionsWithResponse(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretVersionsResponse, error)
 
-	operationPath := fmt.Sprintf("/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretVersionsResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretVersionsResponse, error)
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	// GetRpcSecretsWithResponse request
+	GetRpcSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRpcSecretsResponse, error)
 
-		if params.Select != nil {
+	// PostRpcSecretsWithBodyWithResponse request with any body
+	PostRpcSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PostRpcSecretsWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error)
 
-		}
+	PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// PostRpcSetCertificateWithBodyWithResponse request with any body
+	PostRpcSetCertificateWithBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateResponse, error)
 
-	req.Header.Add("Content-Type", contentType)
+	PostRpcSetCertificateWithResponse(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateResponse, error)
 
-	if params != nil {
+	PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateResponse, error)
 
-		if params.Prefer != nil {
-			var headerParam0 string
+	PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateResponse, error)
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+	// PostRpcSetCertificateAuthorityWithBodyWithResponse request with any body
+	PostRpcSetCertificateAuthorityWithBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateAuthorityResponse, error)
 
-			req.Header.Set("Prefer", headerParam0)
-		}
+	PostRpcSetCertificateAuthorityWithResponse(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateAuthorityResponse, error)
 
-	}
+	PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateAuthorityResponse, error)
 
-	return req, nil
-}
+	PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateAuthorityResponse, error)
 
-// NewGetRpcSecretsRequest generates requests for GetRpcSecrets
-func NewGetRpcSecretsRequest(server string) (*http.Request, error) {
-	var err error
+	// PostRpcSetKeypairWithBodyWithResponse request with any body
+	PostRpcSetKeypairWithBodyWithResponse(ctx context.Context, params *PostRpcSetKeypairParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSetKeypairResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcSetKeypairWithResponse(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetKeypairResponse, error)
 
-	operationPath := fmt.Sprintf("/rpc/secrets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetKeypairResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetKeypairResponse, error)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// PostRpcSetSecretWithBodyWithResponse request with any body
+	PostRpcSetSecretWithBodyWithResponse(ctx context.Context, params *PostRpcSetSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSetSecretResponse, error)
 
-	return req, nil
-}
+	PostRpcSetSecretWithResponse(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetSecretResponse, error)
 
-// NewPostRpcSecretsRequest calls the generic PostRpcSecrets builder with application/json body
-func NewPostRpcSecretsRequest(server string, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostRpcSecretsRequestWithBody(server, params, "application/json", bodyReader)
-}
+	PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetSecretResponse, error)
 
-// NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostRpcSecrets builder with application/vnd.pgrst.object+json body
-func NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostRpcSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
-}
+	PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetSecretResponse, error)
 
-// NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostRpcSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPostRpcSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostRpcSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
-}
+	// PostRpcUnsetSecretWithBodyWithResponse request with any body
+	PostRpcUnsetSecretWithBodyWithResponse(ctx context.Context, params *PostRpcUnsetSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcUnsetSecretResponse, error)
 
-// NewPostRpcSecretsRequestWithBody generates requests for PostRpcSecrets with any type of body
-func NewPostRpcSecretsRequestWithBody(server string, params *PostRpcSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	PostRpcUnsetSecretWithResponse(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcUnsetSecretResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcUnsetSecretResponse, error)
 
-	operationPath := fmt.Sprintf("/rpc/secrets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcUnsetSecretResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// PostRpcValidateSecretsWithBodyWithResponse request with any body
+	PostRpcValidateSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcValidateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcValidateSecretsResponse, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	PostRpcValidateSecretsWithResponse(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcValidateSecretsResponse, error)
 
-	req.Header.Add("Content-Type", contentType)
+	PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcValidateSecretsResponse, error)
 
-	if params != nil {
+	PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcValidateSecretsResponse, error)
 
-		if params.Prefer != nil {
-			var headerParam0 string
+	// PostRpcVariableMetadataWithBodyWithResponse request with any body
+	PostRpcVariableMetadataWithBodyWithResponse(ctx context.Context, params *PostRpcVariableMetadataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcVariableMetadataResponse, error)
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+	PostRpcVariableMetadataWithResponse(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcVariableMetadataResponse, error)
 
-			req.Header.Set("Prefer", headerParam0)
-		}
+	PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcVariableMetadataResponse, error)
 
-	}
+	PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcVariableMetadataResponse, error)
 
-	return req, nil
-}
+	// DeleteSecretsWithResponse request
+	DeleteSecretsWithResponse(ctx context.Context, params *DeleteSecretsParams, reqEditors ...RequestEditorFn) (*DeleteSecretsResponse, error)
 
-// NewDeleteSecretsRequest generates requests for DeleteSecrets
-func NewDeleteSecretsRequest(server string, params *DeleteSecretsParams) (*http.Request, error) {
-	var err error
+	// GetSecretsWithResponse request
+	GetSecretsWithResponse(ctx context.Context, params *GetSecretsParams, reqEditors ...RequestEditorFn) (*GetSecretsResponse, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// PatchSecretsWithBodyWithResponse request with any body
+	PatchSecretsWithBodyWithResponse(ctx context.Context, params *PatchSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error)
 
-	operationPath := fmt.Sprintf("/secrets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	PatchSecretsWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	PatchSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error)
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error)
 
-		if params.Id != nil {
+	// PostSecretsWithBodyWithResponse request with any body
+	PostSecretsWithBodyWithResponse(ctx context.Context, params *PostSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PostSecretsWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error)
 
-		}
+	PostSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error)
 
-		if params.CreatedAt != nil {
+	PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// DeleteVariablesWithResponse request
+	DeleteVariablesWithResponse(ctx context.Context, params *DeleteVariablesParams, reqEditors ...RequestEditorFn) (*DeleteVariablesResponse, error)
 
-		}
+	// GetVariablesWithResponse request
+	GetVariablesWithResponse(ctx context.Context, params *GetVariablesParams, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error)
 
-		if params.VariableId != nil {
+	// PatchVariablesWithBodyWithResponse request with any body
+	PatchVariablesWithBodyWithResponse(ctx context.Context, params *PatchVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variable_id", runtime.ParamLocationQuery, *params.VariableId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	PatchVariablesWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error)
 
-		}
+	PatchVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error)
 
-		if params.EnvironmentId != nil {
+	PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// PostVariablesWithBodyWithResponse request with any body
+	PostVariablesWithBodyWithResponse(ctx context.Context, params *PostVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error)
 
-		}
+	PostVariablesWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	PostVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error)
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error)
+}
 
-	if params != nil {
+type GetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Prefer != nil {
-			var headerParam0 string
+// Status returns HTTPResponse.Status
+func (r GetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-			req.Header.Set("Prefer", headerParam0)
-		}
+type DeleteClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return req, nil
+type GetClientsResponse struct {
+	Body                                          []byte
+	HTTPResponse                                  *http.Response
+	JSON200                                       *[]Clients
+	ApplicationvndPgrstObjectJSON200              *[]Clients
+	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Clients
 }
 
-// NewGetSecretsRequest generates requests for GetSecrets
-func NewGetSecretsRequest(server string, params *GetSecretsParams) (*http.Request, error) {
-	var err error
+// Status returns HTTPResponse.Status
+func (r GetClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/secrets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+type PatchClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+type PostClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Id != nil {
+// Status returns HTTPResponse.Status
+func (r PostClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type DeleteClientsSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.CreatedAt != nil {
+// Status returns HTTPResponse.Status
+func (r DeleteClientsSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClientsSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type GetClientsSecretsResponse struct {
+	Body                                          []byte
+	HTTPResponse                                  *http.Response
+	JSON200                                       *[]ClientsSecrets
+	ApplicationvndPgrstObjectJSON200              *[]ClientsSecrets
+	ApplicationvndPgrstObjectJSONNullsStripped200 *[]ClientsSecrets
+}
 
-		if params.VariableId != nil {
+// Status returns HTTPResponse.Status
+func (r GetClientsSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variable_id", runtime.ParamLocationQuery, *params.VariableId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientsSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PatchClientsSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.EnvironmentId != nil {
+// Status returns HTTPResponse.Status
+func (r PatchClientsSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchClientsSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostClientsSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Select != nil {
+// Status returns HTTPResponse.Status
+func (r PostClientsSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostClientsSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type DeleteEnvironmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Order != nil {
+// Status returns HTTPResponse.Status
+func (r DeleteEnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type GetEnvironmentsResponse struct {
+	Body                                          []byte
+	HTTPResponse                                  *http.Response
+	JSON200                                       *[]Environments
+	ApplicationvndPgrstObjectJSON200              *[]Environments
+	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Environments
+}
 
-		if params.Offset != nil {
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PatchEnvironmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Limit != nil {
+// Status returns HTTPResponse.Status
+func (r PatchEnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchEnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostEnvironmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// Status returns HTTPResponse.Status
+func (r PostEnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	if params != nil {
-
-		if params.Range != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
-			if err != nil {
-				return nil, err
-			}
+type DeleteProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			req.Header.Set("Range", headerParam0)
-		}
+// Status returns HTTPResponse.Status
+func (r DeleteProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		if params.RangeUnit != nil {
-			var headerParam1 string
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Range-Unit", runtime.ParamLocationHeader, *params.RangeUnit)
-			if err != nil {
-				return nil, err
-			}
+type GetProjectsResponse struct {
+	Body                                          []byte
+	HTTPResponse                                  *http.Response
+	JSON200                                       *[]Projects
+	ApplicationvndPgrstObjectJSON200              *[]Projects
+	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Projects
+}
 
-			req.Header.Set("Range-Unit", headerParam1)
-		}
+// Status returns HTTPResponse.Status
+func (r GetProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		if params.Prefer != nil {
-			var headerParam2 string
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+type PatchProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			req.Header.Set("Prefer", headerParam2)
-		}
+// Status returns HTTPResponse.Status
+func (r PatchProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return req, nil
+type PostProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
-// NewPatchSecretsRequest calls the generic PatchSecrets builder with application/json body
-func NewPatchSecretsRequest(server string, params *PatchSecretsParams, body PatchSecretsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchSecretsRequestWithBody(server, params, "application/json", bodyReader)
+	return http.StatusText(0)
 }
 
-// NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchSecrets builder with application/vnd.pgrst.object+json body
-func NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return 0
 }
 
-// NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPatchSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+type PostRpcAdminApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
-// NewPatchSecretsRequestWithBody generates requests for PatchSecrets with any type of body
-func NewPatchSecretsRequestWithBody(server string, params *PatchSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// Status returns HTTPResponse.Status
+func (r PostRpcAdminApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcAdminApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/secrets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+type PostRpcAuditLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRpcAuditLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcAuditLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+type PostRpcAuditRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Id != nil {
+// Status returns HTTPResponse.Status
+func (r PostRpcAuditRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcAuditRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostRpcCertificatesToRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.CreatedAt != nil {
+// Status returns HTTPResponse.Status
+func (r PostRpcCertificatesToRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_at", runtime.ParamLocationQuery, *params.CreatedAt); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcCertificatesToRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostRpcCreateAdminApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.VariableId != nil {
+// Status returns HTTPResponse.Status
+func (r PostRpcCreateAdminApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variable_id", runtime.ParamLocationQuery, *params.VariableId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcCreateAdminApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostRpcCreateClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.EnvironmentId != nil {
+// Status returns HTTPResponse.Status
+func (r PostRpcCreateClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment_id", runtime.ParamLocationQuery, *params.EnvironmentId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcCreateClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostRpcCreateClientSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// Status returns HTTPResponse.Status
+func (r PostRpcCreateClientSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcCreateClientSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	req.Header.Add("Content-Type", contentType)
+type PostRpcImportVariablesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	if params != nil {
+// Status returns HTTPResponse.Status
+func (r PostRpcImportVariablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		if params.Prefer != nil {
-			var headerParam0 string
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcImportVariablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+type PostRpcIsAdminResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			req.Header.Set("Prefer", headerParam0)
-		}
+// Status returns HTTPResponse.Status
+func (r PostRpcIsAdminResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcIsAdminResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return req, nil
+type PostRpcKeypairsToRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
-// NewPostSecretsRequest calls the generic PostSecrets builder with application/json body
-func NewPostSecretsRequest(server string, params *PostSecretsParams, body PostSecretsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostRpcKeypairsToRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSecretsRequestWithBody(server, params, "application/json", bodyReader)
+	return http.StatusText(0)
 }
 
-// NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostSecrets builder with application/vnd.pgrst.object+json body
-func NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcKeypairsToRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return 0
 }
 
-// NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostSecrets builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPostSecretsRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSecretsRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+type PostRpcPendingCertificatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
-// NewPostSecretsRequestWithBody generates requests for PostSecrets with any type of body
-func NewPostSecretsRequestWithBody(server string, params *PostSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// Status returns HTTPResponse.Status
+func (r PostRpcPendingCertificatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcPendingCertificatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/secrets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+type PostRpcPendingKeypairsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRpcPendingKeypairsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcPendingKeypairsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+type PostRpcRegenerateSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Select != nil {
+// Status returns HTTPResponse.Status
+func (r PostRpcRegenerateSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcRegenerateSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostRpcResolveSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// Status returns HTTPResponse.Status
+func (r PostRpcResolveSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcResolveSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	req.Header.Add("Content-Type", contentType)
+type PostRpcRevealSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	if params != nil {
+// Status returns HTTPResponse.Status
+func (r PostRpcRevealSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		if params.Prefer != nil {
-			var headerParam0 string
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcRevealSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+type PostRpcRevokeAdminApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			req.Header.Set("Prefer", headerParam0)
-		}
+// Status returns HTTPResponse.Status
+func (r PostRpcRevokeAdminApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcRevokeAdminApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRpcRevokeClientSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
+// Status returns HTTPResponse.Status
+func (r PostRpcRevokeClientSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	return req, nil
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcRevokeClientSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-// NewDeleteVariablesRequest generates requests for DeleteVariables
-func NewDeleteVariablesRequest(server string, params *DeleteVariablesParams) (*http.Request, error) {
-	var err error
+type PostRpcRollbackSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PostRpcRollbackSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	operationPath := fmt.Sprintf("/variables")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcRollbackSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+type PostRpcRotateSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRpcRotateSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcRotateSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		if params.Id != nil {
+type PostRpcSecretEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// Status returns HTTPResponse.Status
+func (r PostRpcSecretEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcSecretEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		if params.Description != nil {
+type PostRpcSecretEventsCursorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// Status returns HTTPResponse.Status
+func (r PostRpcSecretEventsCursorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcSecretEventsCursorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		if params.Key != nil {
+type PostRpcSecretVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// Status returns HTTPResponse.Status
+func (r PostRpcSecretVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcSecretVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		if params.GeneratorData != nil {
+type GetRpcSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_data", runtime.ParamLocationQuery, *params.GeneratorData); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// Status returns HTTPResponse.Status
+func (r GetRpcSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		}
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRpcSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		if params.GeneratorType != nil {
+type PostRpcSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_type", runtime.ParamLocationQuery, *params.GeneratorType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// Status returns HTTPResponse.Status
+func (r PostRpcSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		if params.ProjectId != nil {
+type PostRpcSetCertificateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// Status returns HTTPResponse.Status
+func (r PostRpcSetCertificateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcSetCertificateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+type PostRpcSetCertificateAuthorityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRpcSetCertificateAuthorityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcSetCertificateAuthorityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	if params != nil {
+type PostRpcSetKeypairResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRpcSetKeypairResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-		if params.Prefer != nil {
-			var headerParam0 string
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcSetKeypairResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+type PostRpcSetSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-			req.Header.Set("Prefer", headerParam0)
-		}
+// Status returns HTTPResponse.Status
+func (r PostRpcSetSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcSetSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return req, nil
+type PostRpcUnsetSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
-// NewGetVariablesRequest generates requests for GetVariables
-func NewGetVariablesRequest(server string, params *GetVariablesParams) (*http.Request, error) {
-	var err error
+// Status returns HTTPResponse.Status
+func (r PostRpcUnsetSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcUnsetSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	operationPath := fmt.Sprintf("/variables")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+type PostRpcValidateSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRpcValidateSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcValidateSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+type PostRpcVariableMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Id != nil {
+// Status returns HTTPResponse.Status
+func (r PostRpcVariableMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRpcVariableMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type DeleteSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Description != nil {
+// Status returns HTTPResponse.Status
+func (r DeleteSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type GetSecretsResponse struct {
+	Body                                          []byte
+	HTTPResponse                                  *http.Response
+	JSON200                                       *[]Secrets
+	ApplicationvndPgrstObjectJSON200              *[]Secrets
+	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Secrets
+}
 
-		if params.Key != nil {
+// Status returns HTTPResponse.Status
+func (r GetSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PatchSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.GeneratorData != nil {
+// Status returns HTTPResponse.Status
+func (r PatchSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_data", runtime.ParamLocationQuery, *params.GeneratorData); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.GeneratorType != nil {
+// Status returns HTTPResponse.Status
+func (r PostSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_type", runtime.ParamLocationQuery, *params.GeneratorType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type DeleteVariablesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.ProjectId != nil {
+// Status returns HTTPResponse.Status
+func (r DeleteVariablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVariablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type GetVariablesResponse struct {
+	Body                                          []byte
+	HTTPResponse                                  *http.Response
+	JSON200                                       *[]Variables
+	ApplicationvndPgrstObjectJSON200              *[]Variables
+	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Variables
+}
 
-		if params.Select != nil {
+// Status returns HTTPResponse.Status
+func (r GetVariablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVariablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PatchVariablesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Order != nil {
+// Status returns HTTPResponse.Status
+func (r PatchVariablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchVariablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type PostVariablesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-		if params.Offset != nil {
+// Status returns HTTPResponse.Status
+func (r PostVariablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostVariablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+// GetWithResponse request returning *GetResponse
+func (c *ClientWithResponses) GetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResponse, error) {
+	rsp, err := c.Get(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResponse(rsp)
+}
 
-		if params.Limit != nil {
+// DeleteClientsWithResponse request returning *DeleteClientsResponse
+func (c *ClientWithResponses) DeleteClientsWithResponse(ctx context.Context, params *DeleteClientsParams, reqEditors ...RequestEditorFn) (*DeleteClientsResponse, error) {
+	rsp, err := c.DeleteClients(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClientsResponse(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// GetClientsWithResponse request returning *GetClientsResponse
+func (c *ClientWithResponses) GetClientsWithResponse(ctx context.Context, params *GetClientsParams, reqEditors ...RequestEditorFn) (*GetClientsResponse, error) {
+	rsp, err := c.GetClients(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientsResponse(rsp)
+}
 
-		}
+// PatchClientsWithBodyWithResponse request with arbitrary body returning *PatchClientsResponse
+func (c *ClientWithResponses) PatchClientsWithBodyWithResponse(ctx context.Context, params *PatchClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error) {
+	rsp, err := c.PatchClientsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchClientsResponse(rsp)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+func (c *ClientWithResponses) PatchClientsWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error) {
+	rsp, err := c.PatchClients(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePatchClientsResponse(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *ClientWithResponses) PatchClientsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error) {
+	rsp, err := c.PatchClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePatchClientsResponse(rsp)
+}
 
-	if params != nil {
+func (c *ClientWithResponses) PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error) {
+	rsp, err := c.PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchClientsResponse(rsp)
+}
 
-		if params.Range != nil {
-			var headerParam0 string
+// PostClientsWithBodyWithResponse request with arbitrary body returning *PostClientsResponse
+func (c *ClientWithResponses) PostClientsWithBodyWithResponse(ctx context.Context, params *PostClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostClientsResponse, error) {
+	rsp, err := c.PostClientsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClientsResponse(rsp)
+}
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
-			if err != nil {
-				return nil, err
-			}
+func (c *ClientWithResponses) PostClientsWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error) {
+	rsp, err := c.PostClients(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClientsResponse(rsp)
+}
 
-			req.Header.Set("Range", headerParam0)
-		}
+func (c *ClientWithResponses) PostClientsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error) {
+	rsp, err := c.PostClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClientsResponse(rsp)
+}
 
-		if params.RangeUnit != nil {
-			var headerParam1 string
+func (c *ClientWithResponses) PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error) {
+	rsp, err := c.PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostClientsResponse(rsp)
+}
 
-			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Range-Unit", runtime.ParamLocationHeader, *params.RangeUnit)
-			if err != nil {
-				return nil, err
-			}
+// DeleteClientsSecretsWithResponse request returning *DeleteClientsSecretsResponse
+func (c *ClientWithResponses) DeleteClientsSecretsWithResponse(ctx context.Context, params *DeleteClientsSecretsParams, reqEditors ...RequestEditorFn) (*DeleteClientsSecretsResponse, error) {
+	rsp, err := c.DeleteClientsSecrets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClientsSecretsResponse(rsp)
+}
 
-			req.Header.Set("Range-Unit", headerParam1)
-		}
+// GetClientsSecretsWithResponse request returning *GetClientsSecretsResponse
+func (c *ClientWithResponses) GetClientsSecretsWithResponse(ctx context.Context, params *GetClientsSecretsParams, reqEditors ...RequestEditorFn) (*GetClientsSecretsResponse, error) {
+	rsp, err := c.GetClientsSecrets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientsSecretsResponse(rsp)
+}
 
-		if params.Prefer != nil {
-			var headerParam2 string
+// PatchClientsSecretsWithBodyWithResponse request with arbitrary body returning *PatchClientsSecretsResponse
+func (c *ClientWithResponses) PatchClientsSecretsWithBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error) {
+	rsp, err := c.PatchClientsSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchClientsSecretsResponse(rsp)
+}
 
-			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
+func (c *ClientWithResponses) PatchClientsSecretsWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error) {
+	rsp, err := c.PatchClientsSecrets(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchClientsSecretsResponse(rsp)
+}
 
-			req.Header.Set("Prefer", headerParam2)
-		}
+func (c *ClientWithResponses) PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error) {
+	rsp, err := c.PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchClientsSecretsResponse(rsp)
+}
 
+func (c *ClientWithResponses) PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error) {
+	rsp, err := c.PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return ParsePatchClientsSecretsResponse(rsp)
 }
 
-// NewPatchVariablesRequest calls the generic PatchVariables builder with application/json body
-func NewPatchVariablesRequest(server string, params *PatchVariablesParams, body PatchVariablesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// PostClientsSecretsWithBodyWithResponse request with arbitrary body returning *PostClientsSecretsResponse
+func (c *ClientWithResponses) PostClientsSecretsWithBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error) {
+	rsp, err := c.PostClientsSecretsWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchVariablesRequestWithBody(server, params, "application/json", bodyReader)
+	return ParsePostClientsSecretsResponse(rsp)
 }
 
-// NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PatchVariables builder with application/vnd.pgrst.object+json body
-func NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *ClientWithResponses) PostClientsSecretsWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error) {
+	rsp, err := c.PostClientsSecrets(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return ParsePostClientsSecretsResponse(rsp)
 }
 
-// NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PatchVariables builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPatchVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *ClientWithResponses) PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error) {
+	rsp, err := c.PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+	return ParsePostClientsSecretsResponse(rsp)
 }
 
-// NewPatchVariablesRequestWithBody generates requests for PatchVariables with any type of body
-func NewPatchVariablesRequestWithBody(server string, params *PatchVariablesParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *ClientWithResponses) PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error) {
+	rsp, err := c.PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostClientsSecretsResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/variables")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// DeleteEnvironmentsWithResponse request returning *DeleteEnvironmentsResponse
+func (c *ClientWithResponses) DeleteEnvironmentsWithResponse(ctx context.Context, params *DeleteEnvironmentsParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentsResponse, error) {
+	rsp, err := c.DeleteEnvironments(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeleteEnvironmentsResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// GetEnvironmentsWithResponse request returning *GetEnvironmentsResponse
+func (c *ClientWithResponses) GetEnvironmentsWithResponse(ctx context.Context, params *GetEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetEnvironmentsResponse, error) {
+	rsp, err := c.GetEnvironments(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetEnvironmentsResponse(rsp)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Id != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Description != nil {
+// PatchEnvironmentsWithBodyWithResponse request with arbitrary body returning *PatchEnvironmentsResponse
+func (c *ClientWithResponses) PatchEnvironmentsWithBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error) {
+	rsp, err := c.PatchEnvironmentsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEnvironmentsResponse(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *ClientWithResponses) PatchEnvironmentsWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error) {
+	rsp, err := c.PatchEnvironments(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEnvironmentsResponse(rsp)
+}
 
-		}
+func (c *ClientWithResponses) PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error) {
+	rsp, err := c.PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEnvironmentsResponse(rsp)
+}
 
-		if params.Key != nil {
+func (c *ClientWithResponses) PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error) {
+	rsp, err := c.PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEnvironmentsResponse(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, *params.Key); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// PostEnvironmentsWithBodyWithResponse request with arbitrary body returning *PostEnvironmentsResponse
+func (c *ClientWithResponses) PostEnvironmentsWithBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error) {
+	rsp, err := c.PostEnvironmentsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEnvironmentsResponse(rsp)
+}
 
-		}
+func (c *ClientWithResponses) PostEnvironmentsWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error) {
+	rsp, err := c.PostEnvironments(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEnvironmentsResponse(rsp)
+}
 
-		if params.GeneratorData != nil {
+func (c *ClientWithResponses) PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error) {
+	rsp, err := c.PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEnvironmentsResponse(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_data", runtime.ParamLocationQuery, *params.GeneratorData); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *ClientWithResponses) PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error) {
+	rsp, err := c.PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEnvironmentsResponse(rsp)
+}
 
-		}
+// DeleteProjectsWithResponse request returning *DeleteProjectsResponse
+func (c *ClientWithResponses) DeleteProjectsWithResponse(ctx context.Context, params *DeleteProjectsParams, reqEditors ...RequestEditorFn) (*DeleteProjectsResponse, error) {
+	rsp, err := c.DeleteProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectsResponse(rsp)
+}
 
-		if params.GeneratorType != nil {
+// GetProjectsWithResponse request returning *GetProjectsResponse
+func (c *ClientWithResponses) GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error) {
+	rsp, err := c.GetProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsResponse(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "generator_type", runtime.ParamLocationQuery, *params.GeneratorType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// PatchProjectsWithBodyWithResponse request with arbitrary body returning *PatchProjectsResponse
+func (c *ClientWithResponses) PatchProjectsWithBodyWithResponse(ctx context.Context, params *PatchProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error) {
+	rsp, err := c.PatchProjectsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectsResponse(rsp)
+}
 
-		}
+func (c *ClientWithResponses) PatchProjectsWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error) {
+	rsp, err := c.PatchProjects(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectsResponse(rsp)
+}
 
-		if params.ProjectId != nil {
+func (c *ClientWithResponses) PatchProjectsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error) {
+	rsp, err := c.PatchProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectsResponse(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *ClientWithResponses) PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error) {
+	rsp, err := c.PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectsResponse(rsp)
+}
 
-		}
+// PostProjectsWithBodyWithResponse request with arbitrary body returning *PostProjectsResponse
+func (c *ClientWithResponses) PostProjectsWithBodyWithResponse(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
+	rsp, err := c.PostProjectsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProjectsResponse(rsp)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+func (c *ClientWithResponses) PostProjectsWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
+	rsp, err := c.PostProjects(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostProjectsResponse(rsp)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *ClientWithResponses) PostProjectsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
+	rsp, err := c.PostProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostProjectsResponse(rsp)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		if params.Prefer != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Prefer", headerParam0)
-		}
-
+func (c *ClientWithResponses) PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
+	rsp, err := c.PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return ParsePostProjectsResponse(rsp)
 }
 
-// NewPostVariablesRequest calls the generic PostVariables builder with application/json body
-func NewPostVariablesRequest(server string, params *PostVariablesParams, body PostVariablesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// PostRpcAdminApiKeysWithBodyWithResponse request with arbitrary body returning *PostRpcAdminApiKeysResponse
+func (c *ClientWithResponses) PostRpcAdminApiKeysWithBodyWithResponse(ctx context.Context, params *PostRpcAdminApiKeysParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcAdminApiKeysResponse, error) {
+	rsp, err := c.PostRpcAdminApiKeysWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostVariablesRequestWithBody(server, params, "application/json", bodyReader)
+	return ParsePostRpcAdminApiKeysResponse(rsp)
 }
 
-// NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody calls the generic PostVariables builder with application/vnd.pgrst.object+json body
-func NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONBody(server string, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *ClientWithResponses) PostRpcAdminApiKeysWithResponse(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAdminApiKeysResponse, error) {
+	rsp, err := c.PostRpcAdminApiKeys(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json", bodyReader)
+	return ParsePostRpcAdminApiKeysResponse(rsp)
 }
 
-// NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody calls the generic PostVariables builder with application/vnd.pgrst.object+json;nulls=stripped body
-func NewPostVariablesRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(server string, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *ClientWithResponses) PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAdminApiKeysResponse, error) {
+	rsp, err := c.PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostVariablesRequestWithBody(server, params, "application/vnd.pgrst.object+json;nulls=stripped", bodyReader)
+	return ParsePostRpcAdminApiKeysResponse(rsp)
 }
 
-// NewPostVariablesRequestWithBody generates requests for PostVariables with any type of body
-func NewPostVariablesRequestWithBody(server string, params *PostVariablesParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *ClientWithResponses) PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcAdminApiKeysParams, body PostRpcAdminApiKeysApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAdminApiKeysResponse, error) {
+	rsp, err := c.PostRpcAdminApiKeysWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostRpcAdminApiKeysResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/variables")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// PostRpcAuditLogWithBodyWithResponse request with arbitrary body returning *PostRpcAuditLogResponse
+func (c *ClientWithResponses) PostRpcAuditLogWithBodyWithResponse(ctx context.Context, params *PostRpcAuditLogParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcAuditLogResponse, error) {
+	rsp, err := c.PostRpcAuditLogWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostRpcAuditLogResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *ClientWithResponses) PostRpcAuditLogWithResponse(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditLogResponse, error) {
+	rsp, err := c.PostRpcAuditLog(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostRpcAuditLogResponse(rsp)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Select != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "select", runtime.ParamLocationQuery, *params.Select); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+func (c *ClientWithResponses) PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditLogResponse, error) {
+	rsp, err := c.PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostRpcAuditLogResponse(rsp)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *ClientWithResponses) PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcAuditLogParams, body PostRpcAuditLogApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditLogResponse, error) {
+	rsp, err := c.PostRpcAuditLogWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePostRpcAuditLogResponse(rsp)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		if params.Prefer != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Prefer", headerParam0)
-		}
-
+// PostRpcAuditRequestWithBodyWithResponse request with arbitrary body returning *PostRpcAuditRequestResponse
+func (c *ClientWithResponses) PostRpcAuditRequestWithBodyWithResponse(ctx context.Context, params *PostRpcAuditRequestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcAuditRequestResponse, error) {
+	rsp, err := c.PostRpcAuditRequestWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return ParsePostRpcAuditRequestResponse(rsp)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *ClientWithResponses) PostRpcAuditRequestWithResponse(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditRequestResponse, error) {
+	rsp, err := c.PostRpcAuditRequest(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return ParsePostRpcAuditRequestResponse(rsp)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *ClientWithResponses) PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditRequestResponse, error) {
+	rsp, err := c.PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcAuditRequestResponse(rsp)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *ClientWithResponses) PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcAuditRequestParams, body PostRpcAuditRequestApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcAuditRequestResponse, error) {
+	rsp, err := c.PostRpcAuditRequestWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	return ParsePostRpcAuditRequestResponse(rsp)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// PostRpcCertificatesToRotateWithBodyWithResponse request with arbitrary body returning *PostRpcCertificatesToRotateResponse
+func (c *ClientWithResponses) PostRpcCertificatesToRotateWithBodyWithResponse(ctx context.Context, params *PostRpcCertificatesToRotateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcCertificatesToRotateResponse, error) {
+	rsp, err := c.PostRpcCertificatesToRotateWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePostRpcCertificatesToRotateResponse(rsp)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetWithResponse request
-	GetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResponse, error)
-
-	// DeleteClientsWithResponse request
-	DeleteClientsWithResponse(ctx context.Context, params *DeleteClientsParams, reqEditors ...RequestEditorFn) (*DeleteClientsResponse, error)
-
-	// GetClientsWithResponse request
-	GetClientsWithResponse(ctx context.Context, params *GetClientsParams, reqEditors ...RequestEditorFn) (*GetClientsResponse, error)
-
-	// PatchClientsWithBodyWithResponse request with any body
-	PatchClientsWithBodyWithResponse(ctx context.Context, params *PatchClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error)
-
-	PatchClientsWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error)
-
-	PatchClientsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error)
-
-	PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error)
-
-	// PostClientsWithBodyWithResponse request with any body
-	PostClientsWithBodyWithResponse(ctx context.Context, params *PostClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostClientsResponse, error)
-
-	PostClientsWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error)
-
-	PostClientsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error)
-
-	PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error)
-
-	// DeleteClientsSecretsWithResponse request
-	DeleteClientsSecretsWithResponse(ctx context.Context, params *DeleteClientsSecretsParams, reqEditors ...RequestEditorFn) (*DeleteClientsSecretsResponse, error)
-
-	// GetClientsSecretsWithResponse request
-	GetClientsSecretsWithResponse(ctx context.Context, params *GetClientsSecretsParams, reqEditors ...RequestEditorFn) (*GetClientsSecretsResponse, error)
-
-	// PatchClientsSecretsWithBodyWithResponse request with any body
-	PatchClientsSecretsWithBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error)
-
-	PatchClientsSecretsWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error)
-
-	PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error)
-
-	PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error)
-
-	// PostClientsSecretsWithBodyWithResponse request with any body
-	PostClientsSecretsWithBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error)
-
-	PostClientsSecretsWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error)
-
-	PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error)
-
-	PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error)
-
-	// DeleteEnvironmentsWithResponse request
-	DeleteEnvironmentsWithResponse(ctx context.Context, params *DeleteEnvironmentsParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentsResponse, error)
-
-	// GetEnvironmentsWithResponse request
-	GetEnvironmentsWithResponse(ctx context.Context, params *GetEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetEnvironmentsResponse, error)
-
-	// PatchEnvironmentsWithBodyWithResponse request with any body
-	PatchEnvironmentsWithBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error)
-
-	PatchEnvironmentsWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error)
-
-	PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error)
-
-	PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error)
-
-	// PostEnvironmentsWithBodyWithResponse request with any body
-	PostEnvironmentsWithBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error)
-
-	PostEnvironmentsWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error)
-
-	PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error)
-
-	PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error)
-
-	// DeleteProjectsWithResponse request
-	DeleteProjectsWithResponse(ctx context.Context, params *DeleteProjectsParams, reqEditors ...RequestEditorFn) (*DeleteProjectsResponse, error)
-
-	// GetProjectsWithResponse request
-	GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error)
-
-	// PatchProjectsWithBodyWithResponse request with any body
-	PatchProjectsWithBodyWithResponse(ctx context.Context, params *PatchProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error)
-
-	PatchProjectsWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error)
-
-	PatchProjectsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error)
-
-	PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error)
-
-	// PostProjectsWithBodyWithResponse request with any body
-	PostProjectsWithBodyWithResponse(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
-
-	PostProjectsWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
-
-	PostProjectsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
-
-	PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
-
-	// GetRpcSecretsWithResponse request
-	GetRpcSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRpcSecretsResponse, error)
-
-	// PostRpcSecretsWithBodyWithResponse request with any body
-	PostRpcSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error)
-
-	PostRpcSecretsWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error)
-
-	PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error)
-
-	PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error)
-
-	// DeleteSecretsWithResponse request
-	DeleteSecretsWithResponse(ctx context.Context, params *DeleteSecretsParams, reqEditors ...RequestEditorFn) (*DeleteSecretsResponse, error)
+func (c *ClientWithResponses) PostRpcCertificatesToRotateWithResponse(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCertificatesToRotateResponse, error) {
+	rsp, err := c.PostRpcCertificatesToRotate(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCertificatesToRotateResponse(rsp)
+}
 
-	// GetSecretsWithResponse request
-	GetSecretsWithResponse(ctx context.Context, params *GetSecretsParams, reqEditors ...RequestEditorFn) (*GetSecretsResponse, error)
+func (c *ClientWithResponses) PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCertificatesToRotateResponse, error) {
+	rsp, err := c.PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCertificatesToRotateResponse(rsp)
+}
 
-	// PatchSecretsWithBodyWithResponse request with any body
-	PatchSecretsWithBodyWithResponse(ctx context.Context, params *PatchSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error)
+func (c *ClientWithResponses) PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcCertificatesToRotateParams, body PostRpcCertificatesToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCertificatesToRotateResponse, error) {
+	rsp, err := c.PostRpcCertificatesToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCertificatesToRotateResponse(rsp)
+}
 
-	PatchSecretsWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error)
+// PostRpcCreateAdminApiKeyWithBodyWithResponse request with arbitrary body returning *PostRpcCreateAdminApiKeyResponse
+func (c *ClientWithResponses) PostRpcCreateAdminApiKeyWithBodyWithResponse(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcCreateAdminApiKeyResponse, error) {
+	rsp, err := c.PostRpcCreateAdminApiKeyWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateAdminApiKeyResponse(rsp)
+}
 
-	PatchSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error)
+func (c *ClientWithResponses) PostRpcCreateAdminApiKeyWithResponse(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateAdminApiKeyResponse, error) {
+	rsp, err := c.PostRpcCreateAdminApiKey(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateAdminApiKeyResponse(rsp)
+}
 
-	PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error)
+func (c *ClientWithResponses) PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateAdminApiKeyResponse, error) {
+	rsp, err := c.PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateAdminApiKeyResponse(rsp)
+}
 
-	// PostSecretsWithBodyWithResponse request with any body
-	PostSecretsWithBodyWithResponse(ctx context.Context, params *PostSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error)
+func (c *ClientWithResponses) PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcCreateAdminApiKeyParams, body PostRpcCreateAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateAdminApiKeyResponse, error) {
+	rsp, err := c.PostRpcCreateAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateAdminApiKeyResponse(rsp)
+}
 
-	PostSecretsWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error)
+// PostRpcCreateClientWithBodyWithResponse request with arbitrary body returning *PostRpcCreateClientResponse
+func (c *ClientWithResponses) PostRpcCreateClientWithBodyWithResponse(ctx context.Context, params *PostRpcCreateClientParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcCreateClientResponse, error) {
+	rsp, err := c.PostRpcCreateClientWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateClientResponse(rsp)
+}
 
-	PostSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error)
+func (c *ClientWithResponses) PostRpcCreateClientWithResponse(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientResponse, error) {
+	rsp, err := c.PostRpcCreateClient(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateClientResponse(rsp)
+}
 
-	PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error)
+func (c *ClientWithResponses) PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientResponse, error) {
+	rsp, err := c.PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateClientResponse(rsp)
+}
 
-	// DeleteVariablesWithResponse request
-	DeleteVariablesWithResponse(ctx context.Context, params *DeleteVariablesParams, reqEditors ...RequestEditorFn) (*DeleteVariablesResponse, error)
+func (c *ClientWithResponses) PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcCreateClientParams, body PostRpcCreateClientApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientResponse, error) {
+	rsp, err := c.PostRpcCreateClientWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateClientResponse(rsp)
+}
 
-	// GetVariablesWithResponse request
-	GetVariablesWithResponse(ctx context.Context, params *GetVariablesParams, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error)
+// PostRpcCreateClientSecretWithBodyWithResponse request with arbitrary body returning *PostRpcCreateClientSecretResponse
+func (c *ClientWithResponses) PostRpcCreateClientSecretWithBodyWithResponse(ctx context.Context, params *PostRpcCreateClientSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcCreateClientSecretResponse, error) {
+	rsp, err := c.PostRpcCreateClientSecretWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateClientSecretResponse(rsp)
+}
 
-	// PatchVariablesWithBodyWithResponse request with any body
-	PatchVariablesWithBodyWithResponse(ctx context.Context, params *PatchVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error)
+func (c *ClientWithResponses) PostRpcCreateClientSecretWithResponse(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientSecretResponse, error) {
+	rsp, err := c.PostRpcCreateClientSecret(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateClientSecretResponse(rsp)
+}
 
-	PatchVariablesWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error)
+func (c *ClientWithResponses) PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientSecretResponse, error) {
+	rsp, err := c.PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateClientSecretResponse(rsp)
+}
 
-	PatchVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error)
+func (c *ClientWithResponses) PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcCreateClientSecretParams, body PostRpcCreateClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcCreateClientSecretResponse, error) {
+	rsp, err := c.PostRpcCreateClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcCreateClientSecretResponse(rsp)
+}
 
-	PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error)
+// PostRpcImportVariablesWithBodyWithResponse request with arbitrary body returning *PostRpcImportVariablesResponse
+func (c *ClientWithResponses) PostRpcImportVariablesWithBodyWithResponse(ctx context.Context, params *PostRpcImportVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcImportVariablesResponse, error) {
+	rsp, err := c.PostRpcImportVariablesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcImportVariablesResponse(rsp)
+}
 
-	// PostVariablesWithBodyWithResponse request with any body
-	PostVariablesWithBodyWithResponse(ctx context.Context, params *PostVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error)
+func (c *ClientWithResponses) PostRpcImportVariablesWithResponse(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcImportVariablesResponse, error) {
+	rsp, err := c.PostRpcImportVariables(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcImportVariablesResponse(rsp)
+}
 
-	PostVariablesWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error)
+func (c *ClientWithResponses) PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcImportVariablesResponse, error) {
+	rsp, err := c.PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcImportVariablesResponse(rsp)
+}
 
-	PostVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error)
+func (c *ClientWithResponses) PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcImportVariablesParams, body PostRpcImportVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcImportVariablesResponse, error) {
+	rsp, err := c.PostRpcImportVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcImportVariablesResponse(rsp)
+}
 
-	PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error)
+// PostRpcIsAdminWithBodyWithResponse request with arbitrary body returning *PostRpcIsAdminResponse
+func (c *ClientWithResponses) PostRpcIsAdminWithBodyWithResponse(ctx context.Context, params *PostRpcIsAdminParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcIsAdminResponse, error) {
+	rsp, err := c.PostRpcIsAdminWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcIsAdminResponse(rsp)
 }
 
-type GetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcIsAdminWithResponse(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcIsAdminResponse, error) {
+	rsp, err := c.PostRpcIsAdmin(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcIsAdminResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcIsAdminResponse, error) {
+	rsp, err := c.PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcIsAdminResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcIsAdminParams, body PostRpcIsAdminApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcIsAdminResponse, error) {
+	rsp, err := c.PostRpcIsAdminWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcIsAdminResponse(rsp)
 }
 
-type DeleteClientsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// PostRpcKeypairsToRotateWithBodyWithResponse request with arbitrary body returning *PostRpcKeypairsToRotateResponse
+func (c *ClientWithResponses) PostRpcKeypairsToRotateWithBodyWithResponse(ctx context.Context, params *PostRpcKeypairsToRotateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcKeypairsToRotateResponse, error) {
+	rsp, err := c.PostRpcKeypairsToRotateWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcKeypairsToRotateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteClientsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcKeypairsToRotateWithResponse(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcKeypairsToRotateResponse, error) {
+	rsp, err := c.PostRpcKeypairsToRotate(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcKeypairsToRotateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteClientsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcKeypairsToRotateResponse, error) {
+	rsp, err := c.PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcKeypairsToRotateResponse(rsp)
 }
 
-type GetClientsResponse struct {
-	Body                                          []byte
-	HTTPResponse                                  *http.Response
-	JSON200                                       *[]Clients
-	ApplicationvndPgrstObjectJSON200              *[]Clients
-	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Clients
+func (c *ClientWithResponses) PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcKeypairsToRotateParams, body PostRpcKeypairsToRotateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcKeypairsToRotateResponse, error) {
+	rsp, err := c.PostRpcKeypairsToRotateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcKeypairsToRotateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetClientsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostRpcPendingCertificatesWithBodyWithResponse request with arbitrary body returning *PostRpcPendingCertificatesResponse
+func (c *ClientWithResponses) PostRpcPendingCertificatesWithBodyWithResponse(ctx context.Context, params *PostRpcPendingCertificatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcPendingCertificatesResponse, error) {
+	rsp, err := c.PostRpcPendingCertificatesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcPendingCertificatesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClientsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcPendingCertificatesWithResponse(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingCertificatesResponse, error) {
+	rsp, err := c.PostRpcPendingCertificates(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcPendingCertificatesResponse(rsp)
 }
 
-type PatchClientsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingCertificatesResponse, error) {
+	rsp, err := c.PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcPendingCertificatesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchClientsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcPendingCertificatesParams, body PostRpcPendingCertificatesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingCertificatesResponse, error) {
+	rsp, err := c.PostRpcPendingCertificatesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcPendingCertificatesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchClientsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRpcPendingKeypairsWithBodyWithResponse request with arbitrary body returning *PostRpcPendingKeypairsResponse
+func (c *ClientWithResponses) PostRpcPendingKeypairsWithBodyWithResponse(ctx context.Context, params *PostRpcPendingKeypairsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcPendingKeypairsResponse, error) {
+	rsp, err := c.PostRpcPendingKeypairsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcPendingKeypairsResponse(rsp)
 }
 
-type PostClientsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcPendingKeypairsWithResponse(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingKeypairsResponse, error) {
+	rsp, err := c.PostRpcPendingKeypairs(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcPendingKeypairsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostClientsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingKeypairsResponse, error) {
+	rsp, err := c.PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcPendingKeypairsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostClientsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcPendingKeypairsParams, body PostRpcPendingKeypairsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcPendingKeypairsResponse, error) {
+	rsp, err := c.PostRpcPendingKeypairsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcPendingKeypairsResponse(rsp)
 }
 
-type DeleteClientsSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// PostRpcRegenerateSecretsWithBodyWithResponse request with arbitrary body returning *PostRpcRegenerateSecretsResponse
+func (c *ClientWithResponses) PostRpcRegenerateSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcRegenerateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRegenerateSecretsResponse, error) {
+	rsp, err := c.PostRpcRegenerateSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRegenerateSecretsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteClientsSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcRegenerateSecretsWithResponse(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRegenerateSecretsResponse, error) {
+	rsp, err := c.PostRpcRegenerateSecrets(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcRegenerateSecretsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteClientsSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRegenerateSecretsResponse, error) {
+	rsp, err := c.PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcRegenerateSecretsResponse(rsp)
 }
 
-type GetClientsSecretsResponse struct {
-	Body                                          []byte
-	HTTPResponse                                  *http.Response
-	JSON200                                       *[]ClientsSecrets
-	ApplicationvndPgrstObjectJSON200              *[]ClientsSecrets
-	ApplicationvndPgrstObjectJSONNullsStripped200 *[]ClientsSecrets
+func (c *ClientWithResponses) PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRegenerateSecretsParams, body PostRpcRegenerateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRegenerateSecretsResponse, error) {
+	rsp, err := c.PostRpcRegenerateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRegenerateSecretsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetClientsSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostRpcResolveSecretsWithBodyWithResponse request with arbitrary body returning *PostRpcResolveSecretsResponse
+func (c *ClientWithResponses) PostRpcResolveSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcResolveSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcResolveSecretsResponse, error) {
+	rsp, err := c.PostRpcResolveSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcResolveSecretsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClientsSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcResolveSecretsWithResponse(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcResolveSecretsResponse, error) {
+	rsp, err := c.PostRpcResolveSecrets(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcResolveSecretsResponse(rsp)
 }
 
-type PatchClientsSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcResolveSecretsResponse, error) {
+	rsp, err := c.PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcResolveSecretsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchClientsSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcResolveSecretsParams, body PostRpcResolveSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcResolveSecretsResponse, error) {
+	rsp, err := c.PostRpcResolveSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcResolveSecretsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchClientsSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRpcRevealSecretsWithBodyWithResponse request with arbitrary body returning *PostRpcRevealSecretsResponse
+func (c *ClientWithResponses) PostRpcRevealSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcRevealSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRevealSecretsResponse, error) {
+	rsp, err := c.PostRpcRevealSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcRevealSecretsResponse(rsp)
 }
 
-type PostClientsSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcRevealSecretsWithResponse(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevealSecretsResponse, error) {
+	rsp, err := c.PostRpcRevealSecrets(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRevealSecretsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostClientsSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevealSecretsResponse, error) {
+	rsp, err := c.PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcRevealSecretsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostClientsSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRevealSecretsParams, body PostRpcRevealSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevealSecretsResponse, error) {
+	rsp, err := c.PostRpcRevealSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcRevealSecretsResponse(rsp)
 }
 
-type DeleteEnvironmentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// PostRpcRevokeAdminApiKeyWithBodyWithResponse request with arbitrary body returning *PostRpcRevokeAdminApiKeyResponse
+func (c *ClientWithResponses) PostRpcRevokeAdminApiKeyWithBodyWithResponse(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRevokeAdminApiKeyResponse, error) {
+	rsp, err := c.PostRpcRevokeAdminApiKeyWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRevokeAdminApiKeyResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteEnvironmentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcRevokeAdminApiKeyWithResponse(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeAdminApiKeyResponse, error) {
+	rsp, err := c.PostRpcRevokeAdminApiKey(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcRevokeAdminApiKeyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEnvironmentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeAdminApiKeyResponse, error) {
+	rsp, err := c.PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcRevokeAdminApiKeyResponse(rsp)
 }
 
-type GetEnvironmentsResponse struct {
-	Body                                          []byte
-	HTTPResponse                                  *http.Response
-	JSON200                                       *[]Environments
-	ApplicationvndPgrstObjectJSON200              *[]Environments
-	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Environments
+func (c *ClientWithResponses) PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRevokeAdminApiKeyParams, body PostRpcRevokeAdminApiKeyApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeAdminApiKeyResponse, error) {
+	rsp, err := c.PostRpcRevokeAdminApiKeyWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRevokeAdminApiKeyResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetEnvironmentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostRpcRevokeClientSecretWithBodyWithResponse request with arbitrary body returning *PostRpcRevokeClientSecretResponse
+func (c *ClientWithResponses) PostRpcRevokeClientSecretWithBodyWithResponse(ctx context.Context, params *PostRpcRevokeClientSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRevokeClientSecretResponse, error) {
+	rsp, err := c.PostRpcRevokeClientSecretWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcRevokeClientSecretResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcRevokeClientSecretWithResponse(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeClientSecretResponse, error) {
+	rsp, err := c.PostRpcRevokeClientSecret(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcRevokeClientSecretResponse(rsp)
 }
 
-type PatchEnvironmentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeClientSecretResponse, error) {
+	rsp, err := c.PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRevokeClientSecretResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchEnvironmentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRevokeClientSecretParams, body PostRpcRevokeClientSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRevokeClientSecretResponse, error) {
+	rsp, err := c.PostRpcRevokeClientSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcRevokeClientSecretResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchEnvironmentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRpcRollbackSecretWithBodyWithResponse request with arbitrary body returning *PostRpcRollbackSecretResponse
+func (c *ClientWithResponses) PostRpcRollbackSecretWithBodyWithResponse(ctx context.Context, params *PostRpcRollbackSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRollbackSecretResponse, error) {
+	rsp, err := c.PostRpcRollbackSecretWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcRollbackSecretResponse(rsp)
 }
 
-type PostEnvironmentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcRollbackSecretWithResponse(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRollbackSecretResponse, error) {
+	rsp, err := c.PostRpcRollbackSecret(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRollbackSecretResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostEnvironmentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRollbackSecretResponse, error) {
+	rsp, err := c.PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcRollbackSecretResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostEnvironmentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRollbackSecretParams, body PostRpcRollbackSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRollbackSecretResponse, error) {
+	rsp, err := c.PostRpcRollbackSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcRollbackSecretResponse(rsp)
 }
 
-type DeleteProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// PostRpcRotateSecretsWithBodyWithResponse request with arbitrary body returning *PostRpcRotateSecretsResponse
+func (c *ClientWithResponses) PostRpcRotateSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcRotateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcRotateSecretsResponse, error) {
+	rsp, err := c.PostRpcRotateSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRotateSecretsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcRotateSecretsWithResponse(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRotateSecretsResponse, error) {
+	rsp, err := c.PostRpcRotateSecrets(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcRotateSecretsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRotateSecretsResponse, error) {
+	rsp, err := c.PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcRotateSecretsResponse(rsp)
 }
 
-type GetProjectsResponse struct {
-	Body                                          []byte
-	HTTPResponse                                  *http.Response
-	JSON200                                       *[]Projects
-	ApplicationvndPgrstObjectJSON200              *[]Projects
-	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Projects
+func (c *ClientWithResponses) PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcRotateSecretsParams, body PostRpcRotateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcRotateSecretsResponse, error) {
+	rsp, err := c.PostRpcRotateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcRotateSecretsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostRpcSecretEventsWithBodyWithResponse request with arbitrary body returning *PostRpcSecretEventsResponse
+func (c *ClientWithResponses) PostRpcSecretEventsWithBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsResponse, error) {
+	rsp, err := c.PostRpcSecretEventsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSecretEventsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcSecretEventsWithResponse(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsResponse, error) {
+	rsp, err := c.PostRpcSecretEvents(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSecretEventsResponse(rsp)
 }
 
-type PatchProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsResponse, error) {
+	rsp, err := c.PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSecretEventsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsParams, body PostRpcSecretEventsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsResponse, error) {
+	rsp, err := c.PostRpcSecretEventsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSecretEventsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRpcSecretEventsCursorWithBodyWithResponse request with arbitrary body returning *PostRpcSecretEventsCursorResponse
+func (c *ClientWithResponses) PostRpcSecretEventsCursorWithBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsCursorParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsCursorResponse, error) {
+	rsp, err := c.PostRpcSecretEventsCursorWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSecretEventsCursorResponse(rsp)
 }
 
-type PostProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcSecretEventsCursorWithResponse(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsCursorResponse, error) {
+	rsp, err := c.PostRpcSecretEventsCursor(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSecretEventsCursorResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsCursorResponse, error) {
+	rsp, err := c.PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSecretEventsCursorResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretEventsCursorParams, body PostRpcSecretEventsCursorApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretEventsCursorResponse, error) {
+	rsp, err := c.PostRpcSecretEventsCursorWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSecretEventsCursorResponse(rsp)
 }
 
-type GetRpcSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// PostRpcSecretVersionsWithBodyWithResponse request with arbitrary body returning *PostRpcSecretVersionsResponse
+func (c *ClientWithResponses) PostRpcSecretVersionsWithBodyWithResponse(ctx context.Context, params *PostRpcSecretVersionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretVersionsResponse, error) {
+	rsp, err := c.PostRpcSecretVersionsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSecretVersionsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetRpcSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcSecretVersionsWithResponse(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretVersionsResponse, error) {
+	rsp, err := c.PostRpcSecretVersions(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSecretVersionsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRpcSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretVersionsResponse, error) {
+	rsp, err := c.PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSecretVersionsResponse(rsp)
 }
 
-type PostRpcSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretVersionsParams, body PostRpcSecretVersionsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretVersionsResponse, error) {
+	rsp, err := c.PostRpcSecretVersionsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSecretVersionsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostRpcSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetRpcSecretsWithResponse request returning *GetRpcSecretsResponse
+func (c *ClientWithResponses) GetRpcSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRpcSecretsResponse, error) {
+	rsp, err := c.GetRpcSecrets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetRpcSecretsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostRpcSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRpcSecretsWithBodyWithResponse request with arbitrary body returning *PostRpcSecretsResponse
+func (c *ClientWithResponses) PostRpcSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error) {
+	rsp, err := c.PostRpcSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSecretsResponse(rsp)
 }
 
-type DeleteSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcSecretsWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error) {
+	rsp, err := c.PostRpcSecrets(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSecretsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error) {
+	rsp, err := c.PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSecretsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error) {
+	rsp, err := c.PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSecretsResponse(rsp)
 }
 
-type GetSecretsResponse struct {
-	Body                                          []byte
-	HTTPResponse                                  *http.Response
-	JSON200                                       *[]Secrets
-	ApplicationvndPgrstObjectJSON200              *[]Secrets
-	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Secrets
+// PostRpcSetCertificateWithBodyWithResponse request with arbitrary body returning *PostRpcSetCertificateResponse
+func (c *ClientWithResponses) PostRpcSetCertificateWithBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateResponse, error) {
+	rsp, err := c.PostRpcSetCertificateWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSetCertificateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcSetCertificateWithResponse(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateResponse, error) {
+	rsp, err := c.PostRpcSetCertificate(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSetCertificateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateResponse, error) {
+	rsp, err := c.PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSetCertificateResponse(rsp)
 }
 
-type PatchSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateParams, body PostRpcSetCertificateApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateResponse, error) {
+	rsp, err := c.PostRpcSetCertificateWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSetCertificateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostRpcSetCertificateAuthorityWithBodyWithResponse request with arbitrary body returning *PostRpcSetCertificateAuthorityResponse
+func (c *ClientWithResponses) PostRpcSetCertificateAuthorityWithBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateAuthorityResponse, error) {
+	rsp, err := c.PostRpcSetCertificateAuthorityWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSetCertificateAuthorityResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcSetCertificateAuthorityWithResponse(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateAuthorityResponse, error) {
+	rsp, err := c.PostRpcSetCertificateAuthority(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSetCertificateAuthorityResponse(rsp)
 }
 
-type PostSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateAuthorityResponse, error) {
+	rsp, err := c.PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSetCertificateAuthorityResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSetCertificateAuthorityParams, body PostRpcSetCertificateAuthorityApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetCertificateAuthorityResponse, error) {
+	rsp, err := c.PostRpcSetCertificateAuthorityWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSetCertificateAuthorityResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRpcSetKeypairWithBodyWithResponse request with arbitrary body returning *PostRpcSetKeypairResponse
+func (c *ClientWithResponses) PostRpcSetKeypairWithBodyWithResponse(ctx context.Context, params *PostRpcSetKeypairParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSetKeypairResponse, error) {
+	rsp, err := c.PostRpcSetKeypairWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSetKeypairResponse(rsp)
 }
 
-type DeleteVariablesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcSetKeypairWithResponse(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetKeypairResponse, error) {
+	rsp, err := c.PostRpcSetKeypair(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSetKeypairResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteVariablesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetKeypairResponse, error) {
+	rsp, err := c.PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSetKeypairResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteVariablesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSetKeypairParams, body PostRpcSetKeypairApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetKeypairResponse, error) {
+	rsp, err := c.PostRpcSetKeypairWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSetKeypairResponse(rsp)
 }
 
-type GetVariablesResponse struct {
-	Body                                          []byte
-	HTTPResponse                                  *http.Response
-	JSON200                                       *[]Variables
-	ApplicationvndPgrstObjectJSON200              *[]Variables
-	ApplicationvndPgrstObjectJSONNullsStripped200 *[]Variables
+// PostRpcSetSecretWithBodyWithResponse request with arbitrary body returning *PostRpcSetSecretResponse
+func (c *ClientWithResponses) PostRpcSetSecretWithBodyWithResponse(ctx context.Context, params *PostRpcSetSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSetSecretResponse, error) {
+	rsp, err := c.PostRpcSetSecretWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSetSecretResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetVariablesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcSetSecretWithResponse(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetSecretResponse, error) {
+	rsp, err := c.PostRpcSetSecret(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcSetSecretResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVariablesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetSecretResponse, error) {
+	rsp, err := c.PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcSetSecretResponse(rsp)
 }
 
-type PatchVariablesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSetSecretParams, body PostRpcSetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSetSecretResponse, error) {
+	rsp, err := c.PostRpcSetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcSetSecretResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchVariablesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostRpcUnsetSecretWithBodyWithResponse request with arbitrary body returning *PostRpcUnsetSecretResponse
+func (c *ClientWithResponses) PostRpcUnsetSecretWithBodyWithResponse(ctx context.Context, params *PostRpcUnsetSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcUnsetSecretResponse, error) {
+	rsp, err := c.PostRpcUnsetSecretWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcUnsetSecretResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchVariablesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostRpcUnsetSecretWithResponse(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcUnsetSecretResponse, error) {
+	rsp, err := c.PostRpcUnsetSecret(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcUnsetSecretResponse(rsp)
 }
 
-type PostVariablesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcUnsetSecretResponse, error) {
+	rsp, err := c.PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRpcUnsetSecretResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostVariablesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcUnsetSecretParams, body PostRpcUnsetSecretApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcUnsetSecretResponse, error) {
+	rsp, err := c.PostRpcUnsetSecretWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRpcUnsetSecretResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostVariablesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRpcValidateSecretsWithBodyWithResponse request with arbitrary body returning *PostRpcValidateSecretsResponse
+func (c *ClientWithResponses) PostRpcValidateSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcValidateSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcValidateSecretsResponse, error) {
+	rsp, err := c.PostRpcValidateSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRpcValidateSecretsResponse(rsp)
 }
 
-// GetWithResponse request returning *GetResponse
-func (c *ClientWithResponses) GetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResponse, error) {
-	rsp, err := c.Get(ctx, reqEditors...)
+func (c *ClientWithResponses) PostRpcValidateSecretsWithResponse(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcValidateSecretsResponse, error) {
+	rsp, err := c.PostRpcValidateSecrets(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetResponse(rsp)
+	return ParsePostRpcValidateSecretsResponse(rsp)
 }
 
-// DeleteClientsWithResponse request returning *DeleteClientsResponse
-func (c *ClientWithResponses) DeleteClientsWithResponse(ctx context.Context, params *DeleteClientsParams, reqEditors ...RequestEditorFn) (*DeleteClientsResponse, error) {
-	rsp, err := c.DeleteClients(ctx, params, reqEditors...)
+func (c *ClientWithResponses) PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcValidateSecretsResponse, error) {
+	rsp, err := c.PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteClientsResponse(rsp)
+	return ParsePostRpcValidateSecretsResponse(rsp)
 }
 
-// GetClientsWithResponse request returning *GetClientsResponse
-func (c *ClientWithResponses) GetClientsWithResponse(ctx context.Context, params *GetClientsParams, reqEditors ...RequestEditorFn) (*GetClientsResponse, error) {
-	rsp, err := c.GetClients(ctx, params, reqEditors...)
+func (c *ClientWithResponses) PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcValidateSecretsParams, body PostRpcValidateSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcValidateSecretsResponse, error) {
+	rsp, err := c.PostRpcValidateSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClientsResponse(rsp)
+	return ParsePostRpcValidateSecretsResponse(rsp)
 }
 
-// PatchClientsWithBodyWithResponse request with arbitrary body returning *PatchClientsResponse
-func (c *ClientWithResponses) PatchClientsWithBodyWithResponse(ctx context.Context, params *PatchClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error) {
-	rsp, err := c.PatchClientsWithBody(ctx, params, contentType, body, reqEditors...)
+// PostRpcVariableMetadataWithBodyWithResponse request with arbitrary body returning *PostRpcVariableMetadataResponse
+func (c *ClientWithResponses) PostRpcVariableMetadataWithBodyWithResponse(ctx context.Context, params *PostRpcVariableMetadataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcVariableMetadataResponse, error) {
+	rsp, err := c.PostRpcVariableMetadataWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClientsResponse(rsp)
+	return ParsePostRpcVariableMetadataResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchClientsWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error) {
-	rsp, err := c.PatchClients(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostRpcVariableMetadataWithResponse(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcVariableMetadataResponse, error) {
+	rsp, err := c.PostRpcVariableMetadata(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClientsResponse(rsp)
+	return ParsePostRpcVariableMetadataResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchClientsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error) {
-	rsp, err := c.PatchClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcVariableMetadataResponse, error) {
+	rsp, err := c.PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClientsResponse(rsp)
+	return ParsePostRpcVariableMetadataResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchClientsParams, body PatchClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsResponse, error) {
-	rsp, err := c.PatchClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcVariableMetadataParams, body PostRpcVariableMetadataApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcVariableMetadataResponse, error) {
+	rsp, err := c.PostRpcVariableMetadataWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClientsResponse(rsp)
+	return ParsePostRpcVariableMetadataResponse(rsp)
 }
 
-// PostClientsWithBodyWithResponse request with arbitrary body returning *PostClientsResponse
-func (c *ClientWithResponses) PostClientsWithBodyWithResponse(ctx context.Context, params *PostClientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostClientsResponse, error) {
-	rsp, err := c.PostClientsWithBody(ctx, params, contentType, body, reqEditors...)
+// DeleteSecretsWithResponse request returning *DeleteSecretsResponse
+func (c *ClientWithResponses) DeleteSecretsWithResponse(ctx context.Context, params *DeleteSecretsParams, reqEditors ...RequestEditorFn) (*DeleteSecretsResponse, error) {
+	rsp, err := c.DeleteSecrets(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClientsResponse(rsp)
+	return ParseDeleteSecretsResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostClientsWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error) {
-	rsp, err := c.PostClients(ctx, params, body, reqEditors...)
+// GetSecretsWithResponse request returning *GetSecretsResponse
+func (c *ClientWithResponses) GetSecretsWithResponse(ctx context.Context, params *GetSecretsParams, reqEditors ...RequestEditorFn) (*GetSecretsResponse, error) {
+	rsp, err := c.GetSecrets(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClientsResponse(rsp)
+	return ParseGetSecretsResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostClientsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error) {
-	rsp, err := c.PostClientsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+// PatchSecretsWithBodyWithResponse request with arbitrary body returning *PatchSecretsResponse
+func (c *ClientWithResponses) PatchSecretsWithBodyWithResponse(ctx context.Context, params *PatchSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error) {
+	rsp, err := c.PatchSecretsWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClientsResponse(rsp)
+	return ParsePatchSecretsResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostClientsParams, body PostClientsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostClientsResponse, error) {
-	rsp, err := c.PostClientsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PatchSecretsWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error) {
+	rsp, err := c.PatchSecrets(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClientsResponse(rsp)
+	return ParsePatchSecretsResponse(rsp)
 }
 
-// DeleteClientsSecretsWithResponse request returning *DeleteClientsSecretsResponse
-func (c *ClientWithResponses) DeleteClientsSecretsWithResponse(ctx context.Context, params *DeleteClientsSecretsParams, reqEditors ...RequestEditorFn) (*DeleteClientsSecretsResponse, error) {
-	rsp, err := c.DeleteClientsSecrets(ctx, params, reqEditors...)
+func (c *ClientWithResponses) PatchSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error) {
+	rsp, err := c.PatchSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteClientsSecretsResponse(rsp)
+	return ParsePatchSecretsResponse(rsp)
 }
 
-// GetClientsSecretsWithResponse request returning *GetClientsSecretsResponse
-func (c *ClientWithResponses) GetClientsSecretsWithResponse(ctx context.Context, params *GetClientsSecretsParams, reqEditors ...RequestEditorFn) (*GetClientsSecretsResponse, error) {
-	rsp, err := c.GetClientsSecrets(ctx, params, reqEditors...)
+func (c *ClientWithResponses) PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error) {
+	rsp, err := c.PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClientsSecretsResponse(rsp)
+	return ParsePatchSecretsResponse(rsp)
 }
 
-// PatchClientsSecretsWithBodyWithResponse request with arbitrary body returning *PatchClientsSecretsResponse
-func (c *ClientWithResponses) PatchClientsSecretsWithBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error) {
-	rsp, err := c.PatchClientsSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+// PostSecretsWithBodyWithResponse request with arbitrary body returning *PostSecretsResponse
+func (c *ClientWithResponses) PostSecretsWithBodyWithResponse(ctx context.Context, params *PostSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error) {
+	rsp, err := c.PostSecretsWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClientsSecretsResponse(rsp)
+	return ParsePostSecretsResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchClientsSecretsWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error) {
-	rsp, err := c.PatchClientsSecrets(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostSecretsWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error) {
+	rsp, err := c.PostSecrets(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClientsSecretsResponse(rsp)
+	return ParsePostSecretsResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error) {
-	rsp, err := c.PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error) {
+	rsp, err := c.PostSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClientsSecretsResponse(rsp)
+	return ParsePostSecretsResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchClientsSecretsParams, body PatchClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchClientsSecretsResponse, error) {
-	rsp, err := c.PatchClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error) {
+	rsp, err := c.PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchClientsSecretsResponse(rsp)
+	return ParsePostSecretsResponse(rsp)
 }
 
-// PostClientsSecretsWithBodyWithResponse request with arbitrary body returning *PostClientsSecretsResponse
-func (c *ClientWithResponses) PostClientsSecretsWithBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error) {
-	rsp, err := c.PostClientsSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+// DeleteVariablesWithResponse request returning *DeleteVariablesResponse
+func (c *ClientWithResponses) DeleteVariablesWithResponse(ctx context.Context, params *DeleteVariablesParams, reqEditors ...RequestEditorFn) (*DeleteVariablesResponse, error) {
+	rsp, err := c.DeleteVariables(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClientsSecretsResponse(rsp)
+	return ParseDeleteVariablesResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostClientsSecretsWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error) {
-	rsp, err := c.PostClientsSecrets(ctx, params, body, reqEditors...)
+// GetVariablesWithResponse request returning *GetVariablesResponse
+func (c *ClientWithResponses) GetVariablesWithResponse(ctx context.Context, params *GetVariablesParams, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error) {
+	rsp, err := c.GetVariables(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClientsSecretsResponse(rsp)
+	return ParseGetVariablesResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error) {
-	rsp, err := c.PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+// PatchVariablesWithBodyWithResponse request with arbitrary body returning *PatchVariablesResponse
+func (c *ClientWithResponses) PatchVariablesWithBodyWithResponse(ctx context.Context, params *PatchVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error) {
+	rsp, err := c.PatchVariablesWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClientsSecretsResponse(rsp)
+	return ParsePatchVariablesResponse(rsp)
 }
 
-func (c *ClientWithResponses) PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostClientsSecretsParams, body PostClientsSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostClientsSecretsResponse, error) {
-	rsp, err := c.PostClientsSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PatchVariablesWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error) {
+	rsp, err := c.PatchVariables(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostClientsSecretsResponse(rsp)
+	return ParsePatchVariablesResponse(rsp)
 }
 
-// DeleteEnvironmentsWithResponse request returning *DeleteEnvironmentsResponse
-func (c *ClientWithResponses) DeleteEnvironmentsWithResponse(ctx context.Context, params *DeleteEnvironmentsParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentsResponse, error) {
-	rsp, err := c.DeleteEnvironments(ctx, params, reqEditors...)
+func (c *ClientWithResponses) PatchVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error) {
+	rsp, err := c.PatchVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteEnvironmentsResponse(rsp)
+	return ParsePatchVariablesResponse(rsp)
 }
 
-// GetEnvironmentsWithResponse request returning *GetEnvironmentsResponse
-func (c *ClientWithResponses) GetEnvironmentsWithResponse(ctx context.Context, params *GetEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetEnvironmentsResponse, error) {
-	rsp, err := c.GetEnvironments(ctx, params, reqEditors...)
+func (c *ClientWithResponses) PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error) {
+	rsp, err := c.PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEnvironmentsResponse(rsp)
+	return ParsePatchVariablesResponse(rsp)
 }
 
-// PatchEnvironmentsWithBodyWithResponse request with arbitrary body returning *PatchEnvironmentsResponse
-func (c *ClientWithResponses) PatchEnvironmentsWithBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error) {
-	rsp, err := c.PatchEnvironmentsWithBody(ctx, params, contentType, body, reqEditors...)
+// PostVariablesWithBodyWithResponse request with arbitrary body returning *PostVariablesResponse
+func (c *ClientWithResponses) PostVariablesWithBodyWithResponse(ctx context.Context, params *PostVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error) {
+	rsp, err := c.PostVariablesWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchEnvironmentsResponse(rsp)
+	return ParsePostVariablesResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchEnvironmentsWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error) {
-	rsp, err := c.PatchEnvironments(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostVariablesWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error) {
+	rsp, err := c.PostVariables(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchEnvironmentsResponse(rsp)
+	return ParsePostVariablesResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error) {
-	rsp, err := c.PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error) {
+	rsp, err := c.PostVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchEnvironmentsResponse(rsp)
+	return ParsePostVariablesResponse(rsp)
 }
 
-func (c *ClientWithResponses) PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchEnvironmentsParams, body PatchEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchEnvironmentsResponse, error) {
-	rsp, err := c.PatchEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+func (c *ClientWithResponses) PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error) {
+	rsp, err := c.PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchEnvironmentsResponse(rsp)
+	return ParsePostVariablesResponse(rsp)
 }
 
-// PostEnvironmentsWithBodyWithResponse request with arbitrary body returning *PostEnvironmentsResponse
-func (c *ClientWithResponses) PostEnvironmentsWithBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error) {
-	rsp, err := c.PostEnvironmentsWithBody(ctx, params, contentType, body, reqEditors...)
+// ParseGetResponse parses an HTTP response from a GetWithResponse call
+func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostEnvironmentsResponse(rsp)
+
+	response := &GetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostEnvironmentsWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error) {
-	rsp, err := c.PostEnvironments(ctx, params, body, reqEditors...)
+// ParseDeleteClientsResponse parses an HTTP response from a DeleteClientsWithResponse call
+func ParseDeleteClientsResponse(rsp *http.Response) (*DeleteClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostEnvironmentsResponse(rsp)
+
+	response := &DeleteClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error) {
-	rsp, err := c.PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+// ParseGetClientsResponse parses an HTTP response from a GetClientsWithResponse call
+func ParseGetClientsResponse(rsp *http.Response) (*GetClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostEnvironmentsResponse(rsp)
+
+	response := &GetClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
+		var dest []Clients
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json" && rsp.StatusCode == 200:
+		var dest []Clients
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndPgrstObjectJSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json;nulls=stripped" && rsp.StatusCode == 200:
+		var dest []Clients
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndPgrstObjectJSONNullsStripped200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostEnvironmentsParams, body PostEnvironmentsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostEnvironmentsResponse, error) {
-	rsp, err := c.PostEnvironmentsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+// ParsePatchClientsResponse parses an HTTP response from a PatchClientsWithResponse call
+func ParsePatchClientsResponse(rsp *http.Response) (*PatchClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostEnvironmentsResponse(rsp)
+
+	response := &PatchClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// DeleteProjectsWithResponse request returning *DeleteProjectsResponse
-func (c *ClientWithResponses) DeleteProjectsWithResponse(ctx context.Context, params *DeleteProjectsParams, reqEditors ...RequestEditorFn) (*DeleteProjectsResponse, error) {
-	rsp, err := c.DeleteProjects(ctx, params, reqEditors...)
+// ParsePostClientsResponse parses an HTTP response from a PostClientsWithResponse call
+func ParsePostClientsResponse(rsp *http.Response) (*PostClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectsResponse(rsp)
+
+	response := &PostClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// GetProjectsWithResponse request returning *GetProjectsResponse
-func (c *ClientWithResponses) GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error) {
-	rsp, err := c.GetProjects(ctx, params, reqEditors...)
+// ParseDeleteClientsSecretsResponse parses an HTTP response from a DeleteClientsSecretsWithResponse call
+func ParseDeleteClientsSecretsResponse(rsp *http.Response) (*DeleteClientsSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProjectsResponse(rsp)
+
+	response := &DeleteClientsSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// PatchProjectsWithBodyWithResponse request with arbitrary body returning *PatchProjectsResponse
-func (c *ClientWithResponses) PatchProjectsWithBodyWithResponse(ctx context.Context, params *PatchProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error) {
-	rsp, err := c.PatchProjectsWithBody(ctx, params, contentType, body, reqEditors...)
+// ParseGetClientsSecretsResponse parses an HTTP response from a GetClientsSecretsWithResponse call
+func ParseGetClientsSecretsResponse(rsp *http.Response) (*GetClientsSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchProjectsResponse(rsp)
+
+	response := &GetClientsSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
+		var dest []ClientsSecrets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json" && rsp.StatusCode == 200:
+		var dest []ClientsSecrets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndPgrstObjectJSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json;nulls=stripped" && rsp.StatusCode == 200:
+		var dest []ClientsSecrets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndPgrstObjectJSONNullsStripped200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchProjectsWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error) {
-	rsp, err := c.PatchProjects(ctx, params, body, reqEditors...)
+// ParsePatchClientsSecretsResponse parses an HTTP response from a PatchClientsSecretsWithResponse call
+func ParsePatchClientsSecretsResponse(rsp *http.Response) (*PatchClientsSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchProjectsResponse(rsp)
+
+	response := &PatchClientsSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchProjectsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error) {
-	rsp, err := c.PatchProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+// ParsePostClientsSecretsResponse parses an HTTP response from a PostClientsSecretsWithResponse call
+func ParsePostClientsSecretsResponse(rsp *http.Response) (*PostClientsSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchProjectsResponse(rsp)
+
+	response := &PostClientsSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchProjectsParams, body PatchProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsResponse, error) {
-	rsp, err := c.PatchProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+// ParseDeleteEnvironmentsResponse parses an HTTP response from a DeleteEnvironmentsWithResponse call
+func ParseDeleteEnvironmentsResponse(rsp *http.Response) (*DeleteEnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchProjectsResponse(rsp)
-}
 
-// PostProjectsWithBodyWithResponse request with arbitrary body returning *PostProjectsResponse
-func (c *ClientWithResponses) PostProjectsWithBodyWithResponse(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
-	rsp, err := c.PostProjectsWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteEnvironmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostProjectsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostProjectsWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
-	rsp, err := c.PostProjects(ctx, params, body, reqEditors...)
+// ParseGetEnvironmentsResponse parses an HTTP response from a GetEnvironmentsWithResponse call
+func ParseGetEnvironmentsResponse(rsp *http.Response) (*GetEnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostProjectsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostProjectsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
-	rsp, err := c.PostProjectsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetEnvironmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostProjectsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
-	rsp, err := c.PostProjectsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
+		var dest []Environments
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json" && rsp.StatusCode == 200:
+		var dest []Environments
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndPgrstObjectJSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json;nulls=stripped" && rsp.StatusCode == 200:
+		var dest []Environments
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndPgrstObjectJSONNullsStripped200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
 	}
-	return ParsePostProjectsResponse(rsp)
+
+	return response, nil
 }
 
-// GetRpcSecretsWithResponse request returning *GetRpcSecretsResponse
-func (c *ClientWithResponses) GetRpcSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRpcSecretsResponse, error) {
-	rsp, err := c.GetRpcSecrets(ctx, reqEditors...)
+// ParsePatchEnvironmentsResponse parses an HTTP response from a PatchEnvironmentsWithResponse call
+func ParsePatchEnvironmentsResponse(rsp *http.Response) (*PatchEnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRpcSecretsResponse(rsp)
-}
 
-// PostRpcSecretsWithBodyWithResponse request with arbitrary body returning *PostRpcSecretsResponse
-func (c *ClientWithResponses) PostRpcSecretsWithBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error) {
-	rsp, err := c.PostRpcSecretsWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchEnvironmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostRpcSecretsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostRpcSecretsWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error) {
-	rsp, err := c.PostRpcSecrets(ctx, params, body, reqEditors...)
+// ParsePostEnvironmentsResponse parses an HTTP response from a PostEnvironmentsWithResponse call
+func ParsePostEnvironmentsResponse(rsp *http.Response) (*PostEnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostRpcSecretsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error) {
-	rsp, err := c.PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostEnvironmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostRpcSecretsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostRpcSecretsParams, body PostRpcSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostRpcSecretsResponse, error) {
-	rsp, err := c.PostRpcSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+// ParseDeleteProjectsResponse parses an HTTP response from a DeleteProjectsWithResponse call
+func ParseDeleteProjectsResponse(rsp *http.Response) (*DeleteProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostRpcSecretsResponse(rsp)
-}
 
-// DeleteSecretsWithResponse request returning *DeleteSecretsResponse
-func (c *ClientWithResponses) DeleteSecretsWithResponse(ctx context.Context, params *DeleteSecretsParams, reqEditors ...RequestEditorFn) (*DeleteSecretsResponse, error) {
-	rsp, err := c.DeleteSecrets(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteSecretsResponse(rsp)
+
+	return response, nil
 }
 
-// GetSecretsWithResponse request returning *GetSecretsResponse
-func (c *ClientWithResponses) GetSecretsWithResponse(ctx context.Context, params *GetSecretsParams, reqEditors ...RequestEditorFn) (*GetSecretsResponse, error) {
-	rsp, err := c.GetSecrets(ctx, params, reqEditors...)
+// ParseGetProjectsResponse parses an HTTP response from a GetProjectsWithResponse call
+func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSecretsResponse(rsp)
-}
 
-// PatchSecretsWithBodyWithResponse request with arbitrary body returning *PatchSecretsResponse
-func (c *ClientWithResponses) PatchSecretsWithBodyWithResponse(ctx context.Context, params *PatchSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error) {
-	rsp, err := c.PatchSecretsWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchSecretsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchSecretsWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error) {
-	rsp, err := c.PatchSecrets(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
+		var dest []Projects
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json" && rsp.StatusCode == 200:
+		var dest []Projects
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndPgrstObjectJSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json;nulls=stripped" && rsp.StatusCode == 200:
+		var dest []Projects
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndPgrstObjectJSONNullsStripped200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
 	}
-	return ParsePatchSecretsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error) {
-	rsp, err := c.PatchSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+// ParsePatchProjectsResponse parses an HTTP response from a PatchProjectsWithResponse call
+func ParsePatchProjectsResponse(rsp *http.Response) (*PatchProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchSecretsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchSecretsParams, body PatchSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchSecretsResponse, error) {
-	rsp, err := c.PatchSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchSecretsResponse(rsp)
+
+	return response, nil
 }
 
-// PostSecretsWithBodyWithResponse request with arbitrary body returning *PostSecretsResponse
-func (c *ClientWithResponses) PostSecretsWithBodyWithResponse(ctx context.Context, params *PostSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error) {
-	rsp, err := c.PostSecretsWithBody(ctx, params, contentType, body, reqEditors...)
+// ParsePostProjectsResponse parses an HTTP response from a PostProjectsWithResponse call
+func ParsePostProjectsResponse(rsp *http.Response) (*PostProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostSecretsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostSecretsWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error) {
-	rsp, err := c.PostSecrets(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostSecretsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostSecretsWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error) {
-	rsp, err := c.PostSecretsWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+// ParsePostRpcAdminApiKeysResponse parses an HTTP response from a PostRpcAdminApiKeysWithResponse call
+func ParsePostRpcAdminApiKeysResponse(rsp *http.Response) (*PostRpcAdminApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostSecretsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostSecretsParams, body PostSecretsApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostSecretsResponse, error) {
-	rsp, err := c.PostSecretsWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostRpcAdminApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostSecretsResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteVariablesWithResponse request returning *DeleteVariablesResponse
-func (c *ClientWithResponses) DeleteVariablesWithResponse(ctx context.Context, params *DeleteVariablesParams, reqEditors ...RequestEditorFn) (*DeleteVariablesResponse, error) {
-	rsp, err := c.DeleteVariables(ctx, params, reqEditors...)
+// ParsePostRpcAuditLogResponse parses an HTTP response from a PostRpcAuditLogWithResponse call
+func ParsePostRpcAuditLogResponse(rsp *http.Response) (*PostRpcAuditLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteVariablesResponse(rsp)
-}
 
-// GetVariablesWithResponse request returning *GetVariablesResponse
-func (c *ClientWithResponses) GetVariablesWithResponse(ctx context.Context, params *GetVariablesParams, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error) {
-	rsp, err := c.GetVariables(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostRpcAuditLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetVariablesResponse(rsp)
+
+	return response, nil
 }
 
-// PatchVariablesWithBodyWithResponse request with arbitrary body returning *PatchVariablesResponse
-func (c *ClientWithResponses) PatchVariablesWithBodyWithResponse(ctx context.Context, params *PatchVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error) {
-	rsp, err := c.PatchVariablesWithBody(ctx, params, contentType, body, reqEditors...)
+// ParsePostRpcAuditRequestResponse parses an HTTP response from a PostRpcAuditRequestWithResponse call
+func ParsePostRpcAuditRequestResponse(rsp *http.Response) (*PostRpcAuditRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchVariablesResponse(rsp)
+
+	response := &PostRpcAuditRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchVariablesWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error) {
-	rsp, err := c.PatchVariables(ctx, params, body, reqEditors...)
+// ParsePostRpcCertificatesToRotateResponse parses an HTTP response from a PostRpcCertificatesToRotateWithResponse call
+func ParsePostRpcCertificatesToRotateResponse(rsp *http.Response) (*PostRpcCertificatesToRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchVariablesResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error) {
-	rsp, err := c.PatchVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostRpcCertificatesToRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchVariablesResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PatchVariablesParams, body PatchVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PatchVariablesResponse, error) {
-	rsp, err := c.PatchVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+// ParsePostRpcCreateAdminApiKeyResponse parses an HTTP response from a PostRpcCreateAdminApiKeyWithResponse call
+func ParsePostRpcCreateAdminApiKeyResponse(rsp *http.Response) (*PostRpcCreateAdminApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchVariablesResponse(rsp)
-}
 
-// PostVariablesWithBodyWithResponse request with arbitrary body returning *PostVariablesResponse
-func (c *ClientWithResponses) PostVariablesWithBodyWithResponse(ctx context.Context, params *PostVariablesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error) {
-	rsp, err := c.PostVariablesWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostRpcCreateAdminApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostVariablesResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostVariablesWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error) {
-	rsp, err := c.PostVariables(ctx, params, body, reqEditors...)
+// ParsePostRpcCreateClientResponse parses an HTTP response from a PostRpcCreateClientWithResponse call
+func ParsePostRpcCreateClientResponse(rsp *http.Response) (*PostRpcCreateClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostVariablesResponse(rsp)
+
+	response := &PostRpcCreateClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostVariablesWithApplicationVndPgrstObjectPlusJSONBodyWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error) {
-	rsp, err := c.PostVariablesWithApplicationVndPgrstObjectPlusJSONBody(ctx, params, body, reqEditors...)
+// ParsePostRpcCreateClientSecretResponse parses an HTTP response from a PostRpcCreateClientSecretWithResponse call
+func ParsePostRpcCreateClientSecretResponse(rsp *http.Response) (*PostRpcCreateClientSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostVariablesResponse(rsp)
+
+	response := &PostRpcCreateClientSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBodyWithResponse(ctx context.Context, params *PostVariablesParams, body PostVariablesApplicationVndPgrstObjectPlusJSONNullsStrippedRequestBody, reqEditors ...RequestEditorFn) (*PostVariablesResponse, error) {
-	rsp, err := c.PostVariablesWithApplicationVndPgrstObjectPlusJSONNullsStrippedBody(ctx, params, body, reqEditors...)
+// ParsePostRpcImportVariablesResponse parses an HTTP response from a PostRpcImportVariablesWithResponse call
+func ParsePostRpcImportVariablesResponse(rsp *http.Response) (*PostRpcImportVariablesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostVariablesResponse(rsp)
+
+	response := &PostRpcImportVariablesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// ParseGetResponse parses an HTTP response from a GetWithResponse call
-func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
+// ParsePostRpcIsAdminResponse parses an HTTP response from a PostRpcIsAdminWithResponse call
+func ParsePostRpcIsAdminResponse(rsp *http.Response) (*PostRpcIsAdminResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetResponse{
+	response := &PostRpcIsAdminResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6700,15 +14443,15 @@ func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteClientsResponse parses an HTTP response from a DeleteClientsWithResponse call
-func ParseDeleteClientsResponse(rsp *http.Response) (*DeleteClientsResponse, error) {
+// ParsePostRpcKeypairsToRotateResponse parses an HTTP response from a PostRpcKeypairsToRotateWithResponse call
+func ParsePostRpcKeypairsToRotateResponse(rsp *http.Response) (*PostRpcKeypairsToRotateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClientsResponse{
+	response := &PostRpcKeypairsToRotateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6716,58 +14459,47 @@ func ParseDeleteClientsResponse(rsp *http.Response) (*DeleteClientsResponse, err
 	return response, nil
 }
 
-// ParseGetClientsResponse parses an HTTP response from a GetClientsWithResponse call
-func ParseGetClientsResponse(rsp *http.Response) (*GetClientsResponse, error) {
+// ParsePostRpcPendingCertificatesResponse parses an HTTP response from a PostRpcPendingCertificatesWithResponse call
+func ParsePostRpcPendingCertificatesResponse(rsp *http.Response) (*PostRpcPendingCertificatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClientsResponse{
+	response := &PostRpcPendingCertificatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
-		var dest []Clients
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json" && rsp.StatusCode == 200:
-		var dest []Clients
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationvndPgrstObjectJSON200 = &dest
-
-	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json;nulls=stripped" && rsp.StatusCode == 200:
-		var dest []Clients
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationvndPgrstObjectJSONNullsStripped200 = &dest
+	return response, nil
+}
 
-	case rsp.StatusCode == 200:
-		// Content-type (text/csv) unsupported
+// ParsePostRpcPendingKeypairsResponse parses an HTTP response from a PostRpcPendingKeypairsWithResponse call
+func ParsePostRpcPendingKeypairsResponse(rsp *http.Response) (*PostRpcPendingKeypairsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &PostRpcPendingKeypairsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParsePatchClientsResponse parses an HTTP response from a PatchClientsWithResponse call
-func ParsePatchClientsResponse(rsp *http.Response) (*PatchClientsResponse, error) {
+// ParsePostRpcRegenerateSecretsResponse parses an HTTP response from a PostRpcRegenerateSecretsWithResponse call
+func ParsePostRpcRegenerateSecretsResponse(rsp *http.Response) (*PostRpcRegenerateSecretsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchClientsResponse{
+	response := &PostRpcRegenerateSecretsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6775,15 +14507,15 @@ func ParsePatchClientsResponse(rsp *http.Response) (*PatchClientsResponse, error
 	return response, nil
 }
 
-// ParsePostClientsResponse parses an HTTP response from a PostClientsWithResponse call
-func ParsePostClientsResponse(rsp *http.Response) (*PostClientsResponse, error) {
+// ParsePostRpcResolveSecretsResponse parses an HTTP response from a PostRpcResolveSecretsWithResponse call
+func ParsePostRpcResolveSecretsResponse(rsp *http.Response) (*PostRpcResolveSecretsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostClientsResponse{
+	response := &PostRpcResolveSecretsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6791,15 +14523,15 @@ func ParsePostClientsResponse(rsp *http.Response) (*PostClientsResponse, error)
 	return response, nil
 }
 
-// ParseDeleteClientsSecretsResponse parses an HTTP response from a DeleteClientsSecretsWithResponse call
-func ParseDeleteClientsSecretsResponse(rsp *http.Response) (*DeleteClientsSecretsResponse, error) {
+// ParsePostRpcRevealSecretsResponse parses an HTTP response from a PostRpcRevealSecretsWithResponse call
+func ParsePostRpcRevealSecretsResponse(rsp *http.Response) (*PostRpcRevealSecretsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteClientsSecretsResponse{
+	response := &PostRpcRevealSecretsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6807,58 +14539,47 @@ func ParseDeleteClientsSecretsResponse(rsp *http.Response) (*DeleteClientsSecret
 	return response, nil
 }
 
-// ParseGetClientsSecretsResponse parses an HTTP response from a GetClientsSecretsWithResponse call
-func ParseGetClientsSecretsResponse(rsp *http.Response) (*GetClientsSecretsResponse, error) {
+// ParsePostRpcRevokeAdminApiKeyResponse parses an HTTP response from a PostRpcRevokeAdminApiKeyWithResponse call
+func ParsePostRpcRevokeAdminApiKeyResponse(rsp *http.Response) (*PostRpcRevokeAdminApiKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClientsSecretsResponse{
+	response := &PostRpcRevokeAdminApiKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
-		var dest []ClientsSecrets
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json" && rsp.StatusCode == 200:
-		var dest []ClientsSecrets
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationvndPgrstObjectJSON200 = &dest
-
-	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json;nulls=stripped" && rsp.StatusCode == 200:
-		var dest []ClientsSecrets
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationvndPgrstObjectJSONNullsStripped200 = &dest
+	return response, nil
+}
 
-	case rsp.StatusCode == 200:
-		// Content-type (text/csv) unsupported
+// ParsePostRpcRevokeClientSecretResponse parses an HTTP response from a PostRpcRevokeClientSecretWithResponse call
+func ParsePostRpcRevokeClientSecretResponse(rsp *http.Response) (*PostRpcRevokeClientSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &PostRpcRevokeClientSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParsePatchClientsSecretsResponse parses an HTTP response from a PatchClientsSecretsWithResponse call
-func ParsePatchClientsSecretsResponse(rsp *http.Response) (*PatchClientsSecretsResponse, error) {
+// ParsePostRpcRollbackSecretResponse parses an HTTP response from a PostRpcRollbackSecretWithResponse call
+func ParsePostRpcRollbackSecretResponse(rsp *http.Response) (*PostRpcRollbackSecretResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchClientsSecretsResponse{
+	response := &PostRpcRollbackSecretResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6866,15 +14587,15 @@ func ParsePatchClientsSecretsResponse(rsp *http.Response) (*PatchClientsSecretsR
 	return response, nil
 }
 
-// ParsePostClientsSecretsResponse parses an HTTP response from a PostClientsSecretsWithResponse call
-func ParsePostClientsSecretsResponse(rsp *http.Response) (*PostClientsSecretsResponse, error) {
+// ParsePostRpcRotateSecretsResponse parses an HTTP response from a PostRpcRotateSecretsWithResponse call
+func ParsePostRpcRotateSecretsResponse(rsp *http.Response) (*PostRpcRotateSecretsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostClientsSecretsResponse{
+	response := &PostRpcRotateSecretsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6882,15 +14603,15 @@ func ParsePostClientsSecretsResponse(rsp *http.Response) (*PostClientsSecretsRes
 	return response, nil
 }
 
-// ParseDeleteEnvironmentsResponse parses an HTTP response from a DeleteEnvironmentsWithResponse call
-func ParseDeleteEnvironmentsResponse(rsp *http.Response) (*DeleteEnvironmentsResponse, error) {
+// ParsePostRpcSecretEventsResponse parses an HTTP response from a PostRpcSecretEventsWithResponse call
+func ParsePostRpcSecretEventsResponse(rsp *http.Response) (*PostRpcSecretEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEnvironmentsResponse{
+	response := &PostRpcSecretEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6898,58 +14619,47 @@ func ParseDeleteEnvironmentsResponse(rsp *http.Response) (*DeleteEnvironmentsRes
 	return response, nil
 }
 
-// ParseGetEnvironmentsResponse parses an HTTP response from a GetEnvironmentsWithResponse call
-func ParseGetEnvironmentsResponse(rsp *http.Response) (*GetEnvironmentsResponse, error) {
+// ParsePostRpcSecretEventsCursorResponse parses an HTTP response from a PostRpcSecretEventsCursorWithResponse call
+func ParsePostRpcSecretEventsCursorResponse(rsp *http.Response) (*PostRpcSecretEventsCursorResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentsResponse{
+	response := &PostRpcSecretEventsCursorResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
-		var dest []Environments
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json" && rsp.StatusCode == 200:
-		var dest []Environments
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationvndPgrstObjectJSON200 = &dest
-
-	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json;nulls=stripped" && rsp.StatusCode == 200:
-		var dest []Environments
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationvndPgrstObjectJSONNullsStripped200 = &dest
+	return response, nil
+}
 
-	case rsp.StatusCode == 200:
-		// Content-type (text/csv) unsupported
+// ParsePostRpcSecretVersionsResponse parses an HTTP response from a PostRpcSecretVersionsWithResponse call
+func ParsePostRpcSecretVersionsResponse(rsp *http.Response) (*PostRpcSecretVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &PostRpcSecretVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParsePatchEnvironmentsResponse parses an HTTP response from a PatchEnvironmentsWithResponse call
-func ParsePatchEnvironmentsResponse(rsp *http.Response) (*PatchEnvironmentsResponse, error) {
+// ParseGetRpcSecretsResponse parses an HTTP response from a GetRpcSecretsWithResponse call
+func ParseGetRpcSecretsResponse(rsp *http.Response) (*GetRpcSecretsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchEnvironmentsResponse{
+	response := &GetRpcSecretsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6957,15 +14667,15 @@ func ParsePatchEnvironmentsResponse(rsp *http.Response) (*PatchEnvironmentsRespo
 	return response, nil
 }
 
-// ParsePostEnvironmentsResponse parses an HTTP response from a PostEnvironmentsWithResponse call
-func ParsePostEnvironmentsResponse(rsp *http.Response) (*PostEnvironmentsResponse, error) {
+// ParsePostRpcSecretsResponse parses an HTTP response from a PostRpcSecretsWithResponse call
+func ParsePostRpcSecretsResponse(rsp *http.Response) (*PostRpcSecretsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostEnvironmentsResponse{
+	response := &PostRpcSecretsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6973,15 +14683,15 @@ func ParsePostEnvironmentsResponse(rsp *http.Response) (*PostEnvironmentsRespons
 	return response, nil
 }
 
-// ParseDeleteProjectsResponse parses an HTTP response from a DeleteProjectsWithResponse call
-func ParseDeleteProjectsResponse(rsp *http.Response) (*DeleteProjectsResponse, error) {
+// ParsePostRpcSetCertificateResponse parses an HTTP response from a PostRpcSetCertificateWithResponse call
+func ParsePostRpcSetCertificateResponse(rsp *http.Response) (*PostRpcSetCertificateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectsResponse{
+	response := &PostRpcSetCertificateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6989,58 +14699,47 @@ func ParseDeleteProjectsResponse(rsp *http.Response) (*DeleteProjectsResponse, e
 	return response, nil
 }
 
-// ParseGetProjectsResponse parses an HTTP response from a GetProjectsWithResponse call
-func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error) {
+// ParsePostRpcSetCertificateAuthorityResponse parses an HTTP response from a PostRpcSetCertificateAuthorityWithResponse call
+func ParsePostRpcSetCertificateAuthorityResponse(rsp *http.Response) (*PostRpcSetCertificateAuthorityResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectsResponse{
+	response := &PostRpcSetCertificateAuthorityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 200:
-		var dest []Projects
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json" && rsp.StatusCode == 200:
-		var dest []Projects
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationvndPgrstObjectJSON200 = &dest
-
-	case rsp.Header.Get("Content-Type") == "application/vnd.pgrst.object+json;nulls=stripped" && rsp.StatusCode == 200:
-		var dest []Projects
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationvndPgrstObjectJSONNullsStripped200 = &dest
+	return response, nil
+}
 
-	case rsp.StatusCode == 200:
-		// Content-type (text/csv) unsupported
+// ParsePostRpcSetKeypairResponse parses an HTTP response from a PostRpcSetKeypairWithResponse call
+func ParsePostRpcSetKeypairResponse(rsp *http.Response) (*PostRpcSetKeypairResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &PostRpcSetKeypairResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParsePatchProjectsResponse parses an HTTP response from a PatchProjectsWithResponse call
-func ParsePatchProjectsResponse(rsp *http.Response) (*PatchProjectsResponse, error) {
+// ParsePostRpcSetSecretResponse parses an HTTP response from a PostRpcSetSecretWithResponse call
+func ParsePostRpcSetSecretResponse(rsp *http.Response) (*PostRpcSetSecretResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchProjectsResponse{
+	response := &PostRpcSetSecretResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7048,15 +14747,15 @@ func ParsePatchProjectsResponse(rsp *http.Response) (*PatchProjectsResponse, err
 	return response, nil
 }
 
-// ParsePostProjectsResponse parses an HTTP response from a PostProjectsWithResponse call
-func ParsePostProjectsResponse(rsp *http.Response) (*PostProjectsResponse, error) {
+// ParsePostRpcUnsetSecretResponse parses an HTTP response from a PostRpcUnsetSecretWithResponse call
+func ParsePostRpcUnsetSecretResponse(rsp *http.Response) (*PostRpcUnsetSecretResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostProjectsResponse{
+	response := &PostRpcUnsetSecretResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7064,15 +14763,15 @@ func ParsePostProjectsResponse(rsp *http.Response) (*PostProjectsResponse, error
 	return response, nil
 }
 
-// ParseGetRpcSecretsResponse parses an HTTP response from a GetRpcSecretsWithResponse call
-func ParseGetRpcSecretsResponse(rsp *http.Response) (*GetRpcSecretsResponse, error) {
+// ParsePostRpcValidateSecretsResponse parses an HTTP response from a PostRpcValidateSecretsWithResponse call
+func ParsePostRpcValidateSecretsResponse(rsp *http.Response) (*PostRpcValidateSecretsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRpcSecretsResponse{
+	response := &PostRpcValidateSecretsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7080,15 +14779,15 @@ func ParseGetRpcSecretsResponse(rsp *http.Response) (*GetRpcSecretsResponse, err
 	return response, nil
 }
 
-// ParsePostRpcSecretsResponse parses an HTTP response from a PostRpcSecretsWithResponse call
-func ParsePostRpcSecretsResponse(rsp *http.Response) (*PostRpcSecretsResponse, error) {
+// ParsePostRpcVariableMetadataResponse parses an HTTP response from a PostRpcVariableMetadataWithResponse call
+func ParsePostRpcVariableMetadataResponse(rsp *http.Response) (*PostRpcVariableMetadataResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostRpcSecretsResponse{
+	response := &PostRpcVariableMetadataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -31,6 +31,8 @@ func GetAuthenticatedClient(baseURL string, apiKey string, c *gin.Context) (*Cli
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 		req.Header.Add("apikey", apiKey)
 		if c != nil {
+			// only forwarded for trusted proxies (see server.TrustedProxies); otherwise the remote ip
+			req.Header.Add(consts.X_PROJCONF_SOURCE_IP, c.ClientIP())
 			if c.Request.Header.Get(consts.X_ADMIN_API_KEY) != "" {
 				req.Header.Add(consts.X_ADMIN_API_KEY, c.Request.Header.Get(consts.X_ADMIN_API_KEY))
 			} else {
@@ -41,3 +43,14 @@ func GetAuthenticatedClient(baseURL string, apiKey string, c *gin.Context) (*Cli
 		return nil
 	}))
 }
+
+// GetAdminClient returns a client acting as the (bootstrap) admin, for the server's own requests
+// outside of those it serves
+func GetAdminClient(baseURL string, apiKey string, adminApiKey string) (*ClientWithResponses, error) {
+	return NewClientWithResponses(strings.TrimSuffix(baseURL, "/"), WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+		req.Header.Add("apikey", apiKey)
+		req.Header.Add(consts.X_ADMIN_API_KEY, adminApiKey)
+		return nil
+	}))
+}
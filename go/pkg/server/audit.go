@@ -0,0 +1,39 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package server
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/train360-corp/projconf/go/pkg/postgrest"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"github.com/train360-corp/supago"
+	"net/http"
+)
+
+// auditRequest records an (authenticated) request in the audit log, once it has been handled;
+// the actor is resolved by the database from the forwarded credentials
+func auditRequest(config *supago.Config, c *gin.Context) {
+	args := postgrest.PostRpcAuditRequestJSONRequestBody{
+		"p_method": c.Request.Method,
+		"p_path":   c.Request.URL.Path,
+		"p_status": c.Writer.Status(),
+	}
+	if environmentId, err := uuid.Parse(c.Param("environment_id")); err == nil {
+		args["p_environment_id"] = environmentId
+	}
+
+	if supabase, err := postgrest.GetAuthenticatedClient("http://127.0.0.1:8000/rest/v1/", config.Keys.PublicJwt, c); err != nil {
+		state.Get().GetLogger().Errorf("[%s] unable to audit request: %v", c.Request.URL.Path, err)
+	} else if response, err := supabase.PostRpcAuditRequestWithResponse(context.Background(), &postgrest.PostRpcAuditRequestParams{}, args); err != nil {
+		state.Get().GetLogger().Errorf("[%s] unable to audit request: %v", c.Request.URL.Path, err)
+	} else if response.StatusCode() != http.StatusOK && response.StatusCode() != http.StatusNoContent {
+		state.Get().GetLogger().Errorf("[%s] unable to audit request: [%d] %s", c.Request.URL.Path, response.StatusCode(), response.Body)
+	}
+}
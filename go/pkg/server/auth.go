@@ -43,15 +43,30 @@ func authHandler(config *supago.Config) gin.HandlerFunc {
 					token = strings.TrimSpace(raw[7:])
 				}
 
-				// constant-time compare (same length)
-				if len(token) != len(AdminApiKey) ||
-					subtle.ConstantTimeCompare([]byte(token), []byte(AdminApiKey)) != 1 {
+				// bootstrap key: constant-time compare (same length)
+				if len(token) == len(AdminApiKey) &&
+					subtle.ConstantTimeCompare([]byte(token), []byte(AdminApiKey)) == 1 {
+					c.Next()
+					auditRequest(config, c)
+				} else if supabase, err := postgrest.GetAuthenticatedClient("http://127.0.0.1:8000/rest/v1/", config.Keys.PublicJwt, c); err != nil {
+					c.AbortWithStatusJSON(http.StatusInternalServerError, api.Error{
+						Error:       "client error",
+						Description: "unable to create a client",
+					})
+				} else if response, err := supabase.PostRpcIsAdminWithResponse(context.Background(), &postgrest.PostRpcIsAdminParams{}, postgrest.PostRpcIsAdminJSONRequestBody{}); err != nil {
+					c.AbortWithStatusJSON(http.StatusInternalServerError, api.Error{
+						Error:       "query error",
+						Description: "unable to verify admin api key",
+					})
+				} else if response.StatusCode() != http.StatusOK || strings.TrimSpace(string(response.Body)) != "true" {
+					// named keys are stored hashed and checked by the database
 					c.AbortWithStatusJSON(http.StatusUnauthorized, api.Unauthorized{
 						Error:       "Unauthorized",
 						Description: "invalid 'x-admin-api-key' header",
 					})
 				} else {
 					c.Next()
+					auditRequest(config, c)
 				}
 			}
 		} else {
@@ -86,6 +101,7 @@ func authHandler(config *supago.Config) gin.HandlerFunc {
 				})
 			} else {
 				c.Next()
+				auditRequest(config, c)
 			}
 		}
 	}
@@ -0,0 +1,156 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/client"
+	"github.com/jackc/pgx/v5"
+	"github.com/train360-corp/projconf/go/internal/utils"
+	"github.com/train360-corp/projconf/go/internal/utils/random"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"net"
+	"net/url"
+)
+
+// listenerRole is the role the listener connects as: LISTEN needs no grants, so it has none,
+// and it may only log in while the listener (re)connects
+const listenerRole = "projconf_listener"
+
+// prepareRole (re)creates the listener's role without a login, allows it a single connection and
+// revokes what it could otherwise do through PUBLIC's grants, including reading the bootstrap
+// admin key (masked by a role setting, which takes precedence over the server's)
+var prepareRole = fmt.Sprintf(`DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = '%[1]s') THEN
+        CREATE ROLE %[1]s NOLOGIN NOINHERIT;
+    END IF;
+END
+$$;
+ALTER ROLE %[1]s WITH NOLOGIN NOINHERIT CONNECTION LIMIT 1 PASSWORD NULL;
+ALTER ROLE %[1]s SET projconf.x_admin_api_key TO '';
+REVOKE ALL ON SCHEMA public, private FROM %[1]s;
+REVOKE ALL ON ALL FUNCTIONS IN SCHEMA public, private FROM %[1]s;`, listenerRole)
+
+// lockRole takes the listener's login away again once it is connected (or failed to)
+var lockRole = fmt.Sprintf(`ALTER ROLE %s WITH NOLOGIN PASSWORD NULL;`, listenerRole)
+
+// Connector prepares the listener's role inside the database container and returns how to
+// connect as it (see Listen)
+func Connector(ctx context.Context, docker *client.Client, containerID string) (func(context.Context) (*pgx.Conn, error), error) {
+	if err := psql(ctx, docker, containerID, prepareRole); err != nil {
+		return nil, fmt.Errorf("unable to create the listener role: %v", err)
+	}
+	return func(ctx context.Context) (*pgx.Conn, error) {
+		return connect(ctx, docker, containerID)
+	}, nil
+}
+
+// connect lets the listener's role log in with a fresh password (valid for a minute, by the
+// database's clock) for as long as it takes to connect: over the container's network if it is
+// reachable, otherwise through the published port
+func connect(ctx context.Context, docker *client.Client, containerID string) (*pgx.Conn, error) {
+
+	// alphanumeric only, so it can be inlined safely
+	password := random.String(48)
+	if err := psql(ctx, docker, containerID, fmt.Sprintf(`DO $$
+BEGIN
+    EXECUTE format('ALTER ROLE %%I WITH LOGIN PASSWORD %%L VALID UNTIL %%L', '%s', '%s', now() + interval '1 minute');
+END
+$$`, listenerRole, password)); err != nil {
+		return nil, fmt.Errorf("unable to let the listener role log in: %v", err)
+	}
+	defer func() {
+		if err := psql(context.Background(), docker, containerID, lockRole); err != nil {
+			state.Get().GetLogger().Warnf("unable to take the listener role's login away: %v", err)
+		}
+	}()
+
+	addresses, err := addresses(ctx, docker, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, address := range addresses {
+		conn, err := pgx.Connect(ctx, (&url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(listenerRole, password),
+			Host:     address.host,
+			Path:     "/postgres",
+			RawQuery: url.Values{"sslmode": {address.sslmode}, "connect_timeout": {"5"}, "application_name": {"projconf-listener"}}.Encode(),
+		}).String())
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", address.host, err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+// address is where the database container can be reached, and how to secure the connection
+type address struct {
+	host    string
+	sslmode string
+}
+
+// addresses returns where to reach the database container, in order of preference: its networks
+// first (not reachable from every host, e.g. with Docker Desktop), as the traffic never leaves the
+// machine; then its published port, over TLS if the database offers it
+func addresses(ctx context.Context, docker *client.Client, containerID string) ([]address, error) {
+	inspect, err := docker.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to inspect the database container: %v", err)
+	} else if inspect.NetworkSettings == nil {
+		return nil, errors.New("the database container has no network settings")
+	}
+
+	var addresses []address
+	for _, network := range inspect.NetworkSettings.Networks {
+		if network != nil && network.IPAddress != "" {
+			addresses = append(addresses, address{host: net.JoinHostPort(network.IPAddress, "5432"), sslmode: "disable"})
+		}
+	}
+	for port, bindings := range inspect.NetworkSettings.Ports {
+		if port.Port() != "5432" || port.Proto() != "tcp" {
+			continue
+		}
+		for _, binding := range bindings {
+			if binding.HostPort == "" {
+				continue
+			}
+			ip := binding.HostIP
+			if ip == "" || ip == "0.0.0.0" || ip == "::" {
+				ip = "127.0.0.1"
+			}
+			addresses = append(addresses, address{host: net.JoinHostPort(ip, binding.HostPort), sslmode: "prefer"})
+			break
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, errors.New("the database container has no reachable address")
+	}
+	return addresses, nil
+}
+
+// psql runs sql as supabase_admin inside the database container
+func psql(ctx context.Context, docker *client.Client, containerID string, sql string) error {
+	if out, err := utils.ExecInContainer(ctx, docker, containerID, []string{
+		"psql",
+		"-h", "127.0.0.1",
+		"-U", "supabase_admin",
+		"-d", "postgres",
+		"-v", "ON_ERROR_STOP=1",
+		"-c", sql,
+	}); err != nil {
+		return fmt.Errorf("%v (output=%q)", err, out)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/train360-corp/projconf/go/pkg/server/state"
+	"sync"
+	"time"
+)
+
+// Channel is what private.secret_events_after_actions notifies on
+const Channel = "projconf_secret_events"
+
+const (
+	// minBackoff and maxBackoff bound how long to wait before reconnecting the listener
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+)
+
+// subscriber is woken up when an event of its environment (or, without one, of any) is committed
+type subscriber struct {
+	environmentId *uuid.UUID
+	wake          chan struct{}
+}
+
+var (
+	mutex       sync.Mutex
+	subscribers = map[*subscriber]struct{}{}
+)
+
+// Subscribe returns a channel that receives (coalesced) wake-ups whenever secret events of an
+// environment may have been committed, and a function to stop receiving them; the events
+// themselves are read with the watcher's own credentials, wake-ups carry nothing
+func Subscribe(environmentId *uuid.UUID) (<-chan struct{}, func()) {
+	sub := &subscriber{environmentId: environmentId, wake: make(chan struct{}, 1)}
+
+	mutex.Lock()
+	subscribers[sub] = struct{}{}
+	mutex.Unlock()
+
+	return sub.wake, func() {
+		mutex.Lock()
+		delete(subscribers, sub)
+		mutex.Unlock()
+	}
+}
+
+// publish wakes up the subscribers of an environment (or, without one, every subscriber)
+func publish(environmentId *uuid.UUID) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for sub := range subscribers {
+		if environmentId != nil && sub.environmentId != nil && *sub.environmentId != *environmentId {
+			continue
+		}
+		select {
+		case sub.wake <- struct{}{}:
+		default: // already pending
+		}
+	}
+}
+
+// Listen holds a single LISTEN connection to the database and fans its notifications out to the
+// subscribers, (re)connecting (with backoff) with connect until the context is done
+func Listen(ctx context.Context, connect func(context.Context) (*pgx.Conn, error)) {
+	backoff := minBackoff
+	for {
+		connected, err := listen(ctx, connect)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = minBackoff
+		}
+		state.Get().GetLogger().Warnf("secret event listener disconnected (reconnecting in %v): %v", backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+}
+
+// listen runs one connection until it fails, reporting whether it got as far as listening
+func listen(ctx context.Context, connect func(context.Context) (*pgx.Conn, error)) (bool, error) {
+	conn, err := connect(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize()); err != nil {
+		return false, err
+	}
+	state.Get().GetLogger().Debugf("listening for secret events on %s", Channel)
+
+	// anything committed while (re)connecting was not notified
+	publish(nil)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		var payload struct {
+			EnvironmentId *uuid.UUID `json:"environment_id"`
+		}
+		if err := json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+			state.Get().GetLogger().Debugf("unexpected secret event notification: %s", notification.Payload)
+		}
+		publish(payload.EnvironmentId)
+	}
+}
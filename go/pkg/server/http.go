@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 	"net/http"
 	"runtime/debug"
+	"strings"
 	"sync"
 	"time"
 )
@@ -32,11 +33,18 @@ var (
 	Host        = defaults.ServerHost
 	Port        = defaults.ServerPort
 
+	// TrustedProxies are the proxies (ips or cidrs) whose X-Forwarded-For header
+	// is believed when recording the ip of a request (none, by default)
+	TrustedProxies []string
+
 	server *ProjConfServer
 	once   sync.Once
 	mu     sync.Mutex
 )
 
+// postgrestURL is where the route handlers reach PostgREST (through kong)
+const postgrestURL = "http://127.0.0.1:8000/rest/v1/"
+
 type ProjConfServer struct {
 	router *gin.Engine
 	http   *http.Server
@@ -70,6 +78,10 @@ func Init(logger *zap.SugaredLogger, config *supago.Config) (err error) {
 		gin.SetMode(gin.ReleaseMode)
 
 		router := gin.New()
+		if err = router.SetTrustedProxies(TrustedProxies); err != nil {
+			err = fmt.Errorf("invalid trusted proxies: %v", err)
+			return
+		}
 		router.Use(gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, recovered any) {
 			logger.Errorf("panic recovered: %v\n%s", recovered, debug.Stack())
 			c.AbortWithStatusJSON(http.StatusInternalServerError, api.Error{
@@ -98,7 +110,7 @@ func Init(logger *zap.SugaredLogger, config *supago.Config) (err error) {
 		}))
 
 		// response validation
-		router.Use(ginvalidator.OapiResponseValidatorWithOptions(swagger, &ginvalidator.Options{
+		validateResponse := ginvalidator.OapiResponseValidatorWithOptions(swagger, &ginvalidator.Options{
 			ErrorHandler: func(c *gin.Context, message string, statusCode int) {
 				logger.Errorf("%s: %s", c.Request.URL.Path, message)
 				c.AbortWithStatusJSON(statusCode, api.Error{
@@ -110,10 +122,18 @@ func Init(logger *zap.SugaredLogger, config *supago.Config) (err error) {
 				AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
 				IncludeResponseStatus: true,
 			},
-		}))
+		})
+		router.Use(func(c *gin.Context) {
+			// event streams never end (so they can't be buffered for validation)
+			if strings.HasSuffix(c.Request.URL.Path, "/watch") {
+				c.Next()
+			} else {
+				validateResponse(c)
+			}
+		})
 
 		// use route handlers
-		api.RegisterHandlers(router, handlers.GetRouteHandlers("http://127.0.0.1:8000/rest/v1/", config.Keys.PublicJwt))
+		api.RegisterHandlers(router, handlers.GetRouteHandlers(postgrestURL, config.Keys.PublicJwt))
 
 		// configure logger
 		log := logger
@@ -170,3 +190,21 @@ func Start(parentCtx context.Context) context.Context {
 
 	return ctx
 }
+
+// GeneratePendingSecrets generates the keys and certificates left waiting for the server (see
+// handlers.GeneratePendingSecrets), retrying (with backoff) while PostgREST is still starting
+func GeneratePendingSecrets(ctx context.Context, config *supago.Config) (err error) {
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		if err = handlers.GeneratePendingSecrets(ctx, postgrestURL, config.Keys.PublicJwt, AdminApiKey); err == nil || attempt == 5 {
+			return
+		}
+		state.Get().GetLogger().Debugf("generating pending keys and certificates failed (attempt %d, retrying in %v): %v", attempt, backoff, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
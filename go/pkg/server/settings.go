@@ -0,0 +1,101 @@
+/*
+ * Use of this software is governed by the Business Source License
+ * included in the LICENSE file. Production use is permitted, but
+ * offering this software as a managed service requires a separate
+ * commercial license.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/train360-corp/projconf/go/internal/utils/random"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// settingsFile is the name of the settings file inside the system-wide data directory
+const settingsFile = "settings.json"
+
+// Settings are generated on first run and persisted in the system-wide data directory,
+// so that credentials (e.g., the bootstrap admin api key) survive a restart
+type Settings struct {
+	AdminApiKey string    `json:"admin_api_key"`
+	Host        string    `json:"host"`
+	Port        uint16    `json:"port"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Url of the server, as last served
+func (s *Settings) Url() string {
+	return fmt.Sprintf("http://%s:%d", s.Host, s.Port)
+}
+
+// SettingsPath returns the path of the settings file inside a data directory
+func SettingsPath(dir string) string {
+	return filepath.Join(dir, settingsFile)
+}
+
+// LoadSettings reads the settings persisted in a data directory.
+// Returns an error wrapping os.ErrNotExist if no settings have been persisted yet.
+func LoadSettings(dir string) (*Settings, error) {
+	data, err := os.ReadFile(SettingsPath(dir))
+	if err != nil {
+		if errors.Is(err, os.ErrPermission) {
+			return nil, fmt.Errorf("unable to read %s (are you running as the user that runs the server?): %w", SettingsPath(dir), err)
+		}
+		return nil, err
+	}
+
+	var settings Settings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %v", SettingsPath(dir), err)
+	}
+	return &settings, nil
+}
+
+// SaveSettings writes settings to a data directory, readable only by the current user (0600)
+func SaveSettings(dir string, settings *Settings) error {
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := SettingsPath(dir)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("unable to write %s: %v", path, err)
+	}
+
+	// WriteFile only applies the mode on create; tighten a pre-existing file too
+	if err := os.Chmod(path, 0o600); err != nil {
+		return fmt.Errorf("unable to set permissions of %s: %v", path, err)
+	}
+	return nil
+}
+
+// EnsureSettings loads the settings persisted in a data directory, generating them on first run
+func EnsureSettings(dir string) (*Settings, error) {
+	settings, err := LoadSettings(dir)
+	if err == nil {
+		if settings.AdminApiKey != "" {
+			return settings, nil
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	} else {
+		settings = &Settings{
+			Host:      Host,
+			Port:      Port,
+			CreatedAt: time.Now().UTC(),
+		}
+	}
+
+	settings.AdminApiKey = random.String(32)
+	if err := SaveSettings(dir, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
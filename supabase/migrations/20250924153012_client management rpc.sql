@@ -0,0 +1,35 @@
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.create_client_and_secret(p_display text, p_env_id uuid)
+    RETURNS TABLE(client_id uuid, secret_id uuid, secret text)
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    -- must be admin
+    IF NOT (private.is_admin_client()) THEN
+        RAISE EXCEPTION 'unauthorized';
+    END IF;
+
+    -- create client
+    INSERT INTO public.clients (display, environment_id)
+    VALUES (p_display, p_env_id)
+    RETURNING id INTO client_id;
+
+    -- create the secret with a random password
+    secret := encode(extensions.gen_random_bytes(24), 'base64'); -- ~32 chars, base64-safe
+    secret_id := private.create_client_secret(client_id, secret);
+    RETURN NEXT;
+END;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.create_client(p_display text, p_environment_id uuid)
+    RETURNS TABLE(client_id uuid, secret_id uuid, secret text)
+    LANGUAGE sql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$
+SELECT * FROM private.create_client_and_secret(p_display, p_environment_id);
+$function$
+;
@@ -0,0 +1,71 @@
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.can_read_environment(p_environment_id uuid)
+    RETURNS boolean
+    LANGUAGE sql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$
+SELECT private.is_admin_client() OR EXISTS (
+    SELECT 1
+    FROM public.clients_secrets cs
+    JOIN public.clients c ON c.id = cs.client_id
+    WHERE private.is_uuid((current_setting('request.headers', true))::json ->> 'x-client-secret-id')
+      AND cs.id = ((current_setting('request.headers', true))::json ->> 'x-client-secret-id')::uuid
+      AND private.verify_client_secret(cs.id, (current_setting('request.headers', true))::json ->> 'x-client-secret')
+      AND c.environment_id = p_environment_id
+);
+$function$
+;
+
+CREATE OR REPLACE FUNCTION private.decrypt_secret(p_secret_id uuid)
+    RETURNS text
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid;
+begin
+
+    select s.environment_id from public.secrets s where s.id = p_secret_id into env_id;
+    if env_id is null then
+        raise exception 'secret (id=%) not found', p_secret_id;
+    end if;
+
+    -- callers must be able to read the environment the secret belongs to
+    if not private.can_read_environment(env_id) then
+        raise exception 'unauthorized';
+    end if;
+
+    return (
+        SELECT ds.decrypted_secret
+        FROM vault.decrypted_secrets ds
+        WHERE ds.id = p_secret_id
+    );
+end;$function$
+;
+
+-- runs with the privileges of the caller, so the RLS policies on secrets,
+-- variables, environments and projects decide which rows are resolved
+CREATE OR REPLACE FUNCTION public.resolve_secrets(p_environment_id uuid DEFAULT NULL)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text)
+    LANGUAGE sql
+    STABLE
+    SET search_path TO ''
+AS $function$
+SELECT s.id,
+       private.decrypt_secret(s.id),
+       v.id,
+       v.key,
+       e.id,
+       e.display,
+       p.id,
+       p.display
+FROM public.secrets s
+JOIN public.variables v ON v.id = s.variable_id
+JOIN public.environments e ON e.id = s.environment_id
+JOIN public.projects p ON p.id = e.project_id
+WHERE p_environment_id IS NULL OR s.environment_id = p_environment_id
+ORDER BY v.key;
+$function$
+;
@@ -0,0 +1,109 @@
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.variables_before_actions()
+ RETURNS trigger
+ LANGUAGE plpgsql
+ SECURITY DEFINER
+ SET SEARCH_PATH = ''
+AS $function$BEGIN
+
+  IF TG_OP = 'UPDATE' THEN
+
+    -- a variable can never move between projects
+    NEW.id := OLD.id;
+    NEW.project_id := OLD.project_id;
+
+    -- nothing else to do if the generator was left untouched
+    IF NEW.generator_type = OLD.generator_type AND NEW.generator_data = OLD.generator_data THEN
+      RETURN NEW;
+    END IF;
+
+  END IF;
+
+  IF TG_OP = 'INSERT' OR TG_OP = 'UPDATE' THEN
+
+    -- for static, need to protect the secret by encrypting it
+    IF NEW.generator_type = 'STATIC'::public.generator THEN
+
+      -- force request type
+      if not extensions.jsonb_matches_schema(
+        schema := '{
+          "type": "object",
+          "properties": {
+            "secret": {
+              "type": "string"
+            }
+          },
+          "required": [
+            "secret"
+          ],
+          "additionalProperties": false
+        }'::json,
+        instance := NEW.generator_data
+      ) then
+        raise exception 'invalid format: must be an object with key "secret" of type "string"';
+      end if;
+
+      IF TG_OP = 'UPDATE' AND OLD.generator_type = 'STATIC'::public.generator THEN
+        -- re-use the existing vault secret
+        PERFORM vault.update_secret((OLD.generator_data->>'secret-id')::uuid, NEW.generator_data->>'secret');
+        NEW.generator_data := OLD.generator_data;
+      ELSE
+        -- fix the secret to only store the encrypted id
+        NEW.generator_data := jsonb_build_object(
+          'secret-id', vault.create_secret(NEW.generator_data->>'secret')::text
+        );
+      END IF;
+
+    END IF;
+
+  END IF;
+
+  -- a static secret that is no longer referenced should not linger in the vault
+  IF (TG_OP = 'UPDATE' OR TG_OP = 'DELETE')
+       AND OLD.generator_type = 'STATIC'::public.generator
+       AND (TG_OP = 'DELETE' OR NEW.generator_type <> 'STATIC'::public.generator) THEN
+    DELETE FROM vault.secrets WHERE id = (OLD.generator_data->>'secret-id')::uuid;
+  END IF;
+
+  RETURN COALESCE(NEW, OLD);
+
+END;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.regenerate_variable_secrets(p_variable_id uuid)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    total  int := 0;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    for secret in select * from public.secrets s where s.variable_id = p_variable_id loop
+        perform vault.update_secret(
+            secret.id,
+            private.get_default_secret(variable_id := p_variable_id)
+        );
+        total := total + 1;
+    end loop;
+
+    return total;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.regenerate_secrets(p_variable_id uuid)
+    RETURNS integer
+    LANGUAGE sql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$
+SELECT private.regenerate_variable_secrets(p_variable_id);
+$function$
+;
@@ -0,0 +1,43 @@
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.set_secret(p_environment_id uuid, p_variable_id uuid, p_value text)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret_id uuid;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into secret_id;
+
+    if secret_id is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    perform vault.update_secret(secret_id, p_value);
+
+    return secret_id;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.set_secret(p_environment_id uuid, p_variable_id uuid, p_value text)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text)
+    LANGUAGE sql
+    SET search_path TO ''
+AS $function$
+SELECT private.set_secret(p_environment_id, p_variable_id, p_value);
+SELECT * FROM public.resolve_secrets(p_environment_id) rs WHERE rs.variable_id = p_variable_id;
+$function$
+;
@@ -0,0 +1,71 @@
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL)
+    RETURNS SETOF uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    var    public.variables%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    -- a single variable must exist and be rotatable
+    if p_variable_id is not null then
+        select v.* from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        where v.id = p_variable_id and s.environment_id = p_environment_id
+        limit 1
+        into var;
+
+        if var.id is null then
+            raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+                using errcode = 'P0002';
+        end if;
+
+        if var.generator_type <> 'RANDOM'::public.generator then
+            raise exception 'only variables with a RANDOM generator can be rotated (generator=%)', var.generator_type
+                using errcode = '22023';
+        end if;
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        join public.variables v on v.id = s.variable_id
+        where s.environment_id = p_environment_id
+          and v.generator_type = 'RANDOM'::public.generator
+          and (p_variable_id is null or v.id = p_variable_id)
+        for update of s
+    loop
+        perform vault.update_secret(
+            secret.id,
+            private.get_default_secret(variable_id := secret.variable_id)
+        );
+        return next secret.id;
+    end loop;
+
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text)
+    LANGUAGE plpgsql
+    SET search_path TO ''
+AS $function$declare
+    rotated uuid[];
+begin
+
+    rotated := array(select private.rotate_secrets(p_environment_id, p_variable_id));
+
+    return query
+        select rs.*
+        from public.resolve_secrets(p_environment_id) rs
+        where rs.id = any(rotated);
+end;$function$
+;
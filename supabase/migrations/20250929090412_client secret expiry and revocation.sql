@@ -0,0 +1,82 @@
+alter table "public"."clients_secrets" add column "expires_at" timestamp with time zone;
+
+alter table "public"."clients_secrets" add column "revoked_at" timestamp with time zone;
+
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.verify_client_secret(p_secret_id uuid, p_secret text)
+    RETURNS boolean
+    LANGUAGE sql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$
+SELECT EXISTS (
+    SELECT 1
+    FROM public.clients_secrets cs
+    WHERE cs.id = p_secret_id
+      AND cs.revoked_at IS NULL
+      AND (cs.expires_at IS NULL OR cs.expires_at > now())
+      AND cs.hash = extensions.crypt(p_secret, cs.hash)
+);
+$function$
+;
+
+CREATE OR REPLACE FUNCTION public.create_client_secret(p_client_id uuid, p_expires_at timestamp with time zone DEFAULT NULL)
+    RETURNS TABLE(secret_id uuid, secret text, expires_at timestamp with time zone)
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    -- must be admin
+    IF NOT (private.is_admin_client()) THEN
+        RAISE EXCEPTION 'unauthorized';
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM public.clients c WHERE c.id = p_client_id) THEN
+        RAISE EXCEPTION 'client (id=%) not found', p_client_id
+            USING errcode = 'P0002';
+    END IF;
+
+    IF p_expires_at IS NOT NULL AND p_expires_at <= now() THEN
+        RAISE EXCEPTION 'expires_at must be in the future';
+    END IF;
+
+    -- create the secret with a random password
+    secret := encode(extensions.gen_random_bytes(24), 'base64'); -- ~32 chars, base64-safe
+    secret_id := private.create_client_secret(p_client_id, secret);
+    expires_at := p_expires_at;
+
+    UPDATE public.clients_secrets cs
+    SET expires_at = p_expires_at
+    WHERE cs.id = secret_id;
+
+    RETURN NEXT;
+END;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.revoke_client_secret(p_client_id uuid, p_secret_id uuid)
+    RETURNS TABLE(id uuid, created_at timestamp with time zone, client_id uuid, expires_at timestamp with time zone, revoked_at timestamp with time zone)
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    -- must be admin
+    IF NOT (private.is_admin_client()) THEN
+        RAISE EXCEPTION 'unauthorized';
+    END IF;
+
+    RETURN QUERY
+        UPDATE public.clients_secrets cs
+        SET revoked_at = coalesce(cs.revoked_at, now())
+        WHERE cs.id = p_secret_id
+          AND cs.client_id = p_client_id
+        RETURNING cs.id, cs.created_at, cs.client_id, cs.expires_at, cs.revoked_at;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'client secret (id=%) not found', p_secret_id
+            USING errcode = 'P0002';
+    END IF;
+END;$function$
+;
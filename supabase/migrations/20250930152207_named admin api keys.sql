@@ -0,0 +1,167 @@
+create table "private"."admin_api_keys" (
+    "id" uuid not null default gen_random_uuid(),
+    "created_at" timestamp with time zone not null default now(),
+    "display" text not null,
+    "hash" text not null,
+    "revoked_at" timestamp with time zone
+);
+
+CREATE UNIQUE INDEX admin_api_keys_pkey ON private.admin_api_keys USING btree (id);
+
+alter table "private"."admin_api_keys" add constraint "admin_api_keys_pkey" PRIMARY KEY using index "admin_api_keys_pkey";
+
+CREATE UNIQUE INDEX admin_api_keys_display_key ON private.admin_api_keys USING btree (display);
+
+alter table "private"."admin_api_keys" add constraint "admin_api_keys_display_key" UNIQUE using index "admin_api_keys_display_key";
+
+CREATE UNIQUE INDEX admin_api_keys_hash_key ON private.admin_api_keys USING btree (hash);
+
+alter table "private"."admin_api_keys" add constraint "admin_api_keys_hash_key" UNIQUE using index "admin_api_keys_hash_key";
+
+alter table "private"."admin_api_keys" add constraint "admin_api_keys_display_check" CHECK ((display ~ '^[[:alnum:] _-]+$'::text));
+
+-- keys are long and random, so a (fast) sha256 is sufficient; this is checked on every request
+alter table "private"."admin_api_keys" add constraint "admin_api_keys_hash_check" CHECK ((hash ~ '^[0-9a-f]{64}$'::text));
+
+alter table "private"."admin_api_keys" enable row level security;
+
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.hash_admin_api_key(p_key text)
+    RETURNS text
+    LANGUAGE sql
+    IMMUTABLE
+    SET search_path TO ''
+AS $function$
+SELECT encode(extensions.digest(p_key, 'sha256'::text), 'hex');
+$function$
+;
+
+CREATE OR REPLACE FUNCTION private.is_admin_client()
+    RETURNS boolean
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    hdr text := trim(coalesce(((current_setting('request.headers', true))::json ->> 'x-admin-api-key'), ''));
+    guc text := coalesce(current_setting('projconf.x_admin_api_key', true), '');
+
+    a bytea;
+    b bytea;
+    diff int := 0;
+    i int;
+begin
+
+    -- accept case-insensitive Bearer (as the api server does)
+    if lower(left(hdr, 7)) = 'bearer ' then
+        hdr := trim(substr(hdr, 8));
+    end if;
+
+    if hdr = '' then
+        return false;
+    end if;
+
+    -- named keys (never stored in plaintext)
+    if exists (
+        select 1
+        from private.admin_api_keys k
+        where k.hash = private.hash_admin_api_key(hdr)
+          and k.revoked_at is null
+    ) then
+        return true;
+    end if;
+
+    -- bootstrap key (set when the server starts)
+    if guc = '' then
+        raise WARNING 'configuration parameter projconf.x_admin_api_key is not set';
+        return false;
+    end if;
+
+    -- hash both to fixed length
+    a := extensions.digest(hdr, 'sha256'::text);
+    b := extensions.digest(guc, 'sha256'::text);
+
+    -- constant-time comparison
+    for i in 0 .. length(a)-1 loop
+            diff := diff | (get_byte(a,i) # get_byte(b,i));
+        end loop;
+
+    return diff = 0;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.is_admin()
+    RETURNS boolean
+    LANGUAGE sql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$
+SELECT private.is_admin_client();
+$function$
+;
+
+CREATE OR REPLACE FUNCTION public.admin_api_keys()
+    RETURNS TABLE(id uuid, created_at timestamp with time zone, display text, revoked_at timestamp with time zone)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    -- must be admin
+    IF NOT (private.is_admin_client()) THEN
+        RAISE EXCEPTION 'unauthorized';
+    END IF;
+
+    RETURN QUERY
+        SELECT k.id, k.created_at, k.display, k.revoked_at
+        FROM private.admin_api_keys k
+        ORDER BY k.created_at;
+END;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.create_admin_api_key(p_display text)
+    RETURNS TABLE(id uuid, key text)
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    -- must be admin
+    IF NOT (private.is_admin_client()) THEN
+        RAISE EXCEPTION 'unauthorized';
+    END IF;
+
+    key := encode(extensions.gen_random_bytes(32), 'hex');
+
+    INSERT INTO private.admin_api_keys (display, hash)
+    VALUES (p_display, private.hash_admin_api_key(key))
+    RETURNING admin_api_keys.id INTO id;
+
+    RETURN NEXT;
+END;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.revoke_admin_api_key(p_id uuid)
+    RETURNS TABLE(id uuid, created_at timestamp with time zone, display text, revoked_at timestamp with time zone)
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    -- must be admin
+    IF NOT (private.is_admin_client()) THEN
+        RAISE EXCEPTION 'unauthorized';
+    END IF;
+
+    RETURN QUERY
+        UPDATE private.admin_api_keys k
+        SET revoked_at = coalesce(k.revoked_at, now())
+        WHERE k.id = p_id
+        RETURNING k.id, k.created_at, k.display, k.revoked_at;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'admin api key (id=%) not found', p_id
+            USING errcode = 'P0002';
+    END IF;
+END;$function$
+;
@@ -0,0 +1,222 @@
+create table "private"."audit_log" (
+    "id" bigint generated always as identity not null,
+    "created_at" timestamp with time zone not null default now(),
+    "actor_type" text not null,
+    "actor" text not null,
+    "action" text not null,
+    "resource" text not null,
+    "environment_id" uuid,
+    "source_ip" inet,
+    "details" jsonb
+);
+
+CREATE UNIQUE INDEX audit_log_pkey ON private.audit_log USING btree (id);
+
+alter table "private"."audit_log" add constraint "audit_log_pkey" PRIMARY KEY using index "audit_log_pkey";
+
+alter table "private"."audit_log" add constraint "audit_log_actor_type_check" CHECK ((actor_type = ANY (ARRAY['admin'::text, 'client'::text, 'system'::text])));
+
+CREATE INDEX audit_log_created_at_idx ON private.audit_log USING btree (created_at);
+
+CREATE INDEX audit_log_actor_idx ON private.audit_log USING btree (actor);
+
+-- not a foreign key: entries must outlive the environments they refer to
+CREATE INDEX audit_log_environment_id_idx ON private.audit_log USING btree (environment_id);
+
+alter table "private"."audit_log" enable row level security;
+
+set check_function_bodies = off;
+
+-- the audit log is append-only
+CREATE OR REPLACE FUNCTION private.audit_log_append_only()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SET search_path TO ''
+AS $function$BEGIN
+    RAISE EXCEPTION 'the audit log is append-only (% is not allowed)', TG_OP;
+END;$function$
+;
+
+CREATE TRIGGER audit_log_append_only BEFORE DELETE OR UPDATE ON private.audit_log FOR EACH ROW EXECUTE FUNCTION private.audit_log_append_only();
+
+CREATE TRIGGER audit_log_append_only_truncate BEFORE TRUNCATE ON private.audit_log FOR EACH STATEMENT EXECUTE FUNCTION private.audit_log_append_only();
+
+-- who is making the current request: a named admin key (by name), the bootstrap
+-- admin key, a client (by id) or, outside a request, the database itself
+CREATE OR REPLACE FUNCTION private.audit_actor(OUT actor_type text, OUT actor text)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    headers json := coalesce(current_setting('request.headers', true), '{}')::json;
+    hdr     text := trim(coalesce(headers ->> 'x-admin-api-key', ''));
+    sec_id  text := headers ->> 'x-client-secret-id';
+begin
+
+    if hdr <> '' and private.is_admin_client() then
+        if lower(left(hdr, 7)) = 'bearer ' then
+            hdr := trim(substr(hdr, 8));
+        end if;
+
+        actor_type := 'admin';
+        actor := coalesce((
+            select k.display
+            from private.admin_api_keys k
+            where k.hash = private.hash_admin_api_key(hdr)
+        ), 'bootstrap');
+        return;
+    end if;
+
+    if private.is_uuid(sec_id) and private.verify_client_secret(sec_id::uuid, headers ->> 'x-client-secret') then
+        actor_type := 'client';
+        actor := (select cs.client_id::text from public.clients_secrets cs where cs.id = sec_id::uuid);
+        return;
+    end if;
+
+    actor_type := 'system';
+    actor := session_user;
+end;$function$
+;
+
+-- the source ip is forwarded by the api server (if the request came through it)
+CREATE OR REPLACE FUNCTION private.audit_source_ip()
+    RETURNS inet
+    LANGUAGE plpgsql
+    STABLE
+    SET search_path TO ''
+AS $function$begin
+    return nullif(trim(coalesce(current_setting('request.headers', true), '{}')::json ->> 'x-projconf-source-ip'), '')::inet;
+exception
+    when others then
+        return null;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.audit(p_action text, p_resource text, p_environment_id uuid DEFAULT NULL, p_details jsonb DEFAULT NULL)
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    who record;
+begin
+    who := private.audit_actor();
+    insert into private.audit_log (actor_type, actor, action, resource, environment_id, source_ip, details)
+    values (who.actor_type, who.actor, p_action, p_resource, p_environment_id, private.audit_source_ip(), p_details);
+end;$function$
+;
+
+-- records every change to an audited table (values are never recorded)
+CREATE OR REPLACE FUNCTION private.audit_changes()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    rec    jsonb := to_jsonb(coalesce(NEW, OLD));
+    env_id uuid;
+begin
+
+    if TG_TABLE_NAME = 'environments' then
+        env_id := (rec ->> 'id')::uuid;
+    elsif TG_TABLE_NAME = 'clients_secrets' then
+        select c.environment_id from public.clients c where c.id = (rec ->> 'client_id')::uuid into env_id;
+    else
+        env_id := (rec ->> 'environment_id')::uuid;
+    end if;
+
+    perform private.audit(
+        lower(TG_OP),
+        TG_TABLE_NAME || '/' || (rec ->> 'id'),
+        env_id
+    );
+
+    return null;
+end;$function$
+;
+
+CREATE TRIGGER projects_audit AFTER INSERT OR DELETE OR UPDATE ON public.projects FOR EACH ROW EXECUTE FUNCTION private.audit_changes();
+
+CREATE TRIGGER environments_audit AFTER INSERT OR DELETE OR UPDATE ON public.environments FOR EACH ROW EXECUTE FUNCTION private.audit_changes();
+
+CREATE TRIGGER variables_audit AFTER INSERT OR DELETE OR UPDATE ON public.variables FOR EACH ROW EXECUTE FUNCTION private.audit_changes();
+
+CREATE TRIGGER secrets_audit AFTER INSERT OR DELETE OR UPDATE ON public.secrets FOR EACH ROW EXECUTE FUNCTION private.audit_changes();
+
+CREATE TRIGGER clients_audit AFTER INSERT OR DELETE OR UPDATE ON public.clients FOR EACH ROW EXECUTE FUNCTION private.audit_changes();
+
+CREATE TRIGGER clients_secrets_audit AFTER INSERT OR DELETE OR UPDATE ON public.clients_secrets FOR EACH ROW EXECUTE FUNCTION private.audit_changes();
+
+CREATE TRIGGER admin_api_keys_audit AFTER INSERT OR DELETE OR UPDATE ON private.admin_api_keys FOR EACH ROW EXECUTE FUNCTION private.audit_changes();
+
+-- called by the api server for every authenticated request (including reads)
+CREATE OR REPLACE FUNCTION public.audit_request(p_method text, p_path text, p_status integer, p_environment_id uuid DEFAULT NULL)
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    who    record;
+    env_id uuid := p_environment_id;
+begin
+
+    who := private.audit_actor();
+    if who.actor_type = 'system' then
+        raise exception 'unauthorized';
+    end if;
+
+    -- clients can only ever act on their own environment
+    if env_id is null and who.actor_type = 'client' then
+        select c.environment_id from public.clients c where c.id = who.actor::uuid into env_id;
+    end if;
+
+    perform private.audit(
+        'http.' || lower(p_method),
+        p_path,
+        env_id,
+        jsonb_build_object('status', p_status)
+    );
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.audit_log(
+    p_since timestamp with time zone DEFAULT NULL,
+    p_until timestamp with time zone DEFAULT NULL,
+    p_actor text DEFAULT NULL,
+    p_action text DEFAULT NULL,
+    p_environment_id uuid DEFAULT NULL,
+    p_limit integer DEFAULT 1000
+)
+    RETURNS TABLE(id bigint, created_at timestamp with time zone, actor_type text, actor text, action text, resource text, environment_id uuid, source_ip text, details jsonb)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    -- must be admin
+    IF NOT (private.is_admin_client()) THEN
+        RAISE EXCEPTION 'unauthorized';
+    END IF;
+
+    IF p_limit IS NULL OR p_limit < 1 THEN
+        RAISE EXCEPTION 'limit must be positive'
+            USING errcode = '22023';
+    END IF;
+
+    -- the most recent entries, oldest first
+    RETURN QUERY
+        SELECT l.id, l.created_at, l.actor_type, l.actor, l.action, l.resource, l.environment_id, host(l.source_ip), l.details
+        FROM (
+            SELECT *
+            FROM private.audit_log a
+            WHERE (p_since IS NULL OR a.created_at >= p_since)
+              AND (p_until IS NULL OR a.created_at < p_until)
+              AND (p_actor IS NULL OR a.actor = p_actor)
+              AND (p_action IS NULL OR a.action = p_action)
+              AND (p_environment_id IS NULL OR a.environment_id = p_environment_id)
+            ORDER BY a.id DESC
+            LIMIT p_limit
+        ) l
+        ORDER BY l.id;
+END;$function$
+;
@@ -0,0 +1,323 @@
+create table "private"."secret_versions" (
+    "id" uuid not null default gen_random_uuid(),
+    "created_at" timestamp with time zone not null default now(),
+    "secret_id" uuid not null,
+    "version" integer not null,
+    "vault_secret_id" uuid not null,
+    "actor_type" text not null,
+    "actor" text not null,
+    "rolled_back_from" integer
+);
+
+CREATE UNIQUE INDEX secret_versions_pkey ON private.secret_versions USING btree (id);
+
+alter table "private"."secret_versions" add constraint "secret_versions_pkey" PRIMARY KEY using index "secret_versions_pkey";
+
+CREATE UNIQUE INDEX secret_versions_secret_id_version_key ON private.secret_versions USING btree (secret_id, version);
+
+alter table "private"."secret_versions" add constraint "secret_versions_secret_id_version_key" UNIQUE using index "secret_versions_secret_id_version_key";
+
+alter table "private"."secret_versions" add constraint "secret_versions_secret_id_fkey" FOREIGN KEY (secret_id) REFERENCES public.secrets(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+alter table "private"."secret_versions" add constraint "secret_versions_version_check" CHECK ((version > 0));
+
+alter table "private"."secret_versions" enable row level security;
+
+set check_function_bodies = off;
+
+-- every version keeps a copy of its value in its own vault secret
+CREATE OR REPLACE FUNCTION private.secret_versions_after_actions()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+    DELETE FROM vault.secrets WHERE id = OLD.vault_secret_id;
+    RETURN OLD;
+END;$function$
+;
+
+CREATE TRIGGER secret_versions_after_actions AFTER DELETE ON private.secret_versions FOR EACH ROW EXECUTE FUNCTION private.secret_versions_after_actions();
+
+-- records the current value of a secret as its next version
+CREATE OR REPLACE FUNCTION private.record_secret_version(p_secret_id uuid, p_rolled_back_from integer DEFAULT NULL)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    who          record;
+    next_version integer;
+begin
+
+    -- serialize versions of the same secret
+    perform 1 from public.secrets s where s.id = p_secret_id for update;
+
+    select coalesce(max(sv.version), 0) + 1
+    from private.secret_versions sv
+    where sv.secret_id = p_secret_id
+    into next_version;
+
+    who := private.audit_actor();
+
+    insert into private.secret_versions (secret_id, version, vault_secret_id, actor_type, actor, rolled_back_from)
+    values (
+        p_secret_id,
+        next_version,
+        vault.create_secret((select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = p_secret_id)),
+        who.actor_type,
+        who.actor,
+        p_rolled_back_from
+    );
+
+    return next_version;
+end;$function$
+;
+
+-- the only way the value of a secret should be changed (keeps the history)
+CREATE OR REPLACE FUNCTION private.write_secret(p_secret_id uuid, p_value text, p_rolled_back_from integer DEFAULT NULL)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    version integer;
+begin
+
+    perform vault.update_secret(p_secret_id, p_value);
+    version := private.record_secret_version(p_secret_id, p_rolled_back_from);
+
+    perform private.audit(
+        'update',
+        'secrets/' || p_secret_id,
+        (select s.environment_id from public.secrets s where s.id = p_secret_id),
+        jsonb_build_object('version', version, 'rolled_back_from', p_rolled_back_from)
+    );
+
+    return version;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.secrets_after_actions()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+    PERFORM private.record_secret_version(NEW.id);
+    RETURN NEW;
+END;$function$
+;
+
+CREATE TRIGGER secrets_after_actions AFTER INSERT ON public.secrets FOR EACH ROW EXECUTE FUNCTION private.secrets_after_actions();
+
+-- existing secrets start their history at their current value
+SELECT private.record_secret_version(s.id) FROM public.secrets s;
+
+CREATE OR REPLACE FUNCTION private.set_secret(p_environment_id uuid, p_variable_id uuid, p_value text)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret_id uuid;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into secret_id;
+
+    if secret_id is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    perform private.write_secret(secret_id, p_value);
+
+    return secret_id;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL)
+    RETURNS SETOF uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    var    public.variables%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    -- a single variable must exist and be rotatable
+    if p_variable_id is not null then
+        select v.* from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        where v.id = p_variable_id and s.environment_id = p_environment_id
+        limit 1
+        into var;
+
+        if var.id is null then
+            raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+                using errcode = 'P0002';
+        end if;
+
+        if var.generator_type <> 'RANDOM'::public.generator then
+            raise exception 'only variables with a RANDOM generator can be rotated (generator=%)', var.generator_type
+                using errcode = '22023';
+        end if;
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        join public.variables v on v.id = s.variable_id
+        where s.environment_id = p_environment_id
+          and v.generator_type = 'RANDOM'::public.generator
+          and (p_variable_id is null or v.id = p_variable_id)
+        for update of s
+    loop
+        perform private.write_secret(
+            secret.id,
+            private.get_default_secret(variable_id := secret.variable_id)
+        );
+        return next secret.id;
+    end loop;
+
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.regenerate_variable_secrets(p_variable_id uuid)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    total  int := 0;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    for secret in select * from public.secrets s where s.variable_id = p_variable_id loop
+        perform private.write_secret(
+            secret.id,
+            private.get_default_secret(variable_id := p_variable_id)
+        );
+        total := total + 1;
+    end loop;
+
+    return total;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.rollback_secret(p_environment_id uuid, p_variable_id uuid, p_version integer)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    target uuid;
+    value     text;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into target;
+
+    if target is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    select ds.decrypted_secret
+    from private.secret_versions sv
+    join vault.decrypted_secrets ds on ds.id = sv.vault_secret_id
+    where sv.secret_id = target
+      and sv.version = p_version
+    into value;
+
+    if value is null then
+        raise exception 'version % of secret (environment_id=%, variable_id=%) not found', p_version, p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    -- a rollback is a new version (with the value of the old one), so it can be undone too
+    perform private.write_secret(target, value, p_version);
+
+    return target;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.rollback_secret(p_environment_id uuid, p_variable_id uuid, p_version integer)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text)
+    LANGUAGE sql
+    SET search_path TO ''
+AS $function$
+SELECT private.rollback_secret(p_environment_id, p_variable_id, p_version);
+SELECT * FROM public.resolve_secrets(p_environment_id) rs WHERE rs.variable_id = p_variable_id;
+$function$
+;
+
+-- the history of a secret (newest first); values are never returned
+CREATE OR REPLACE FUNCTION public.secret_versions(p_environment_id uuid, p_variable_id uuid)
+    RETURNS TABLE(version integer, created_at timestamp with time zone, actor_type text, actor text, rolled_back_from integer, current boolean)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    target uuid;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into target;
+
+    if target is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    return query
+        select sv.version,
+               sv.created_at,
+               sv.actor_type,
+               sv.actor,
+               sv.rolled_back_from,
+               sv.version = max(sv.version) over ()
+        from private.secret_versions sv
+        where sv.secret_id = target
+        order by sv.version desc;
+end;$function$
+;
@@ -0,0 +1,208 @@
+create table "private"."secret_events" (
+    "id" bigint generated always as identity not null,
+    "created_at" timestamp with time zone not null default now(),
+    "environment_id" uuid not null,
+    "variable_id" uuid not null,
+    "variable_key" text not null,
+    "action" text not null
+);
+
+CREATE UNIQUE INDEX secret_events_pkey ON private.secret_events USING btree (id);
+
+alter table "private"."secret_events" add constraint "secret_events_pkey" PRIMARY KEY using index "secret_events_pkey";
+
+alter table "private"."secret_events" add constraint "secret_events_action_check" CHECK ((action = ANY (ARRAY['insert'::text, 'update'::text, 'delete'::text])));
+
+-- not foreign keys: events must outlive the secrets they refer to (so a watcher can see the delete)
+CREATE INDEX secret_events_environment_id_id_idx ON private.secret_events USING btree (environment_id, id);
+
+alter table "private"."secret_events" enable row level security;
+
+set check_function_bodies = off;
+
+-- every event is also broadcast, for anything LISTENing on the database directly
+CREATE OR REPLACE FUNCTION private.secret_events_after_actions()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+    PERFORM pg_notify('projconf_secret_events', json_build_object(
+        'id', NEW.id,
+        'environment_id', NEW.environment_id,
+        'variable_id', NEW.variable_id,
+        'action', NEW.action
+    )::text);
+    RETURN NEW;
+END;$function$
+;
+
+CREATE TRIGGER secret_events_after_actions AFTER INSERT ON private.secret_events FOR EACH ROW EXECUTE FUNCTION private.secret_events_after_actions();
+
+CREATE OR REPLACE FUNCTION private.secret_event(p_environment_id uuid, p_variable_id uuid, p_variable_key text, p_action text)
+    RETURNS void
+    LANGUAGE sql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$
+INSERT INTO private.secret_events (environment_id, variable_id, variable_key, action)
+VALUES (p_environment_id, p_variable_id, p_variable_key, p_action);
+$function$
+;
+
+-- secrets are created and deleted with their environment or variable
+CREATE OR REPLACE FUNCTION private.secrets_notify()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    rec     public.secrets%rowtype := coalesce(NEW, OLD);
+    var_key text;
+begin
+
+    select v.key from public.variables v where v.id = rec.variable_id into var_key;
+
+    -- the variable itself was deleted (private.variables_notify reports it)
+    if var_key is null then
+        return null;
+    end if;
+
+    perform private.secret_event(rec.environment_id, rec.variable_id, var_key, lower(TG_OP));
+    return null;
+end;$function$
+;
+
+CREATE TRIGGER secrets_notify AFTER INSERT OR DELETE ON public.secrets FOR EACH ROW EXECUTE FUNCTION private.secrets_notify();
+
+-- renaming or deleting a variable changes the secrets of every environment of its project
+CREATE OR REPLACE FUNCTION private.variables_notify()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id  uuid;
+    var_key text := OLD.key;
+begin
+
+    if TG_OP = 'UPDATE' then
+        if NEW.key = OLD.key then
+            return null;
+        end if;
+        var_key := NEW.key;
+    end if;
+
+    for env_id in select e.id from public.environments e where e.project_id = OLD.project_id loop
+        perform private.secret_event(env_id, OLD.id, var_key, lower(TG_OP));
+    end loop;
+
+    return null;
+end;$function$
+;
+
+CREATE TRIGGER variables_notify AFTER DELETE OR UPDATE ON public.variables FOR EACH ROW EXECUTE FUNCTION private.variables_notify();
+
+-- values live in the vault (no row of public.secrets changes), so writes report themselves
+CREATE OR REPLACE FUNCTION private.write_secret(p_secret_id uuid, p_value text, p_rolled_back_from integer DEFAULT NULL)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    version integer;
+    secret  public.secrets%rowtype;
+begin
+
+    perform vault.update_secret(p_secret_id, p_value);
+    version := private.record_secret_version(p_secret_id, p_rolled_back_from);
+
+    select * from public.secrets s where s.id = p_secret_id into secret;
+
+    perform private.audit(
+        'update',
+        'secrets/' || p_secret_id,
+        secret.environment_id,
+        jsonb_build_object('version', version, 'rolled_back_from', p_rolled_back_from)
+    );
+
+    perform private.secret_event(
+        secret.environment_id,
+        secret.variable_id,
+        (select v.key from public.variables v where v.id = secret.variable_id),
+        'update'
+    );
+
+    return version;
+end;$function$
+;
+
+-- the environment a watcher may see events of: clients may omit it (their own is used), admins
+-- must provide it; the visibility is the same as that of the secrets themselves
+CREATE OR REPLACE FUNCTION private.secret_events_environment(p_environment_id uuid)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    who    record;
+    env_id uuid := p_environment_id;
+begin
+
+    if env_id is null then
+        who := private.audit_actor();
+        if who.actor_type = 'client' then
+            select c.environment_id from public.clients c where c.id = who.actor::uuid into env_id;
+        end if;
+    end if;
+
+    if env_id is null then
+        raise exception 'an environment is required'
+            using errcode = '22023';
+    end if;
+
+    if not (private.can_read_environment(env_id)) then
+        raise exception 'unauthorized';
+    end if;
+
+    return env_id;
+end;$function$
+;
+
+-- the events of an environment after a given event id (oldest first)
+CREATE OR REPLACE FUNCTION public.secret_events(p_environment_id uuid DEFAULT NULL, p_after bigint DEFAULT 0, p_limit integer DEFAULT 1000)
+    RETURNS TABLE(id bigint, created_at timestamp with time zone, action text, environment_id uuid, variable_id uuid, variable_key text)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+begin
+
+    if p_limit is null or p_limit < 1 then
+        raise exception 'limit must be positive'
+            using errcode = '22023';
+    end if;
+
+    return query
+        select se.id, se.created_at, se.action, se.environment_id, se.variable_id, se.variable_key
+        from private.secret_events se
+        where se.environment_id = env_id
+          and se.id > coalesce(p_after, 0)
+        order by se.id
+        limit p_limit;
+end;$function$
+;
+
+-- the id of the most recent event of an environment (where a new watcher starts from)
+CREATE OR REPLACE FUNCTION public.secret_events_cursor(p_environment_id uuid DEFAULT NULL)
+    RETURNS bigint
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+begin
+    return (select coalesce(max(se.id), 0) from private.secret_events se where se.environment_id = env_id);
+end;$function$
+;
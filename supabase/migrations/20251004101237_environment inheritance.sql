@@ -0,0 +1,485 @@
+alter table "public"."environments" add column "parent_id" uuid;
+
+-- no action (rather than restrict): a project, and all of its environments, can still be deleted at once
+alter table "public"."environments" add constraint "environments_parent_id_fkey" FOREIGN KEY (parent_id) REFERENCES public.environments(id) ON UPDATE CASCADE not valid;
+
+alter table "public"."environments" validate constraint "environments_parent_id_fkey";
+
+alter table "public"."environments" add constraint "environments_parent_id_check" CHECK ((parent_id <> id));
+
+CREATE INDEX environments_parent_id_idx ON public.environments USING btree (parent_id);
+
+-- an inherited secret falls through to the same variable's secret in the parent environment
+alter table "public"."secrets" add column "inherited" boolean not null default false;
+
+set check_function_bodies = off;
+
+-- a parent must be in the same project, and must not (eventually) be its own parent
+CREATE OR REPLACE FUNCTION private.environments_check_parent()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    IF NEW.parent_id IS NULL THEN
+        RETURN NEW;
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM public.environments e WHERE e.id = NEW.parent_id AND e.project_id = NEW.project_id) THEN
+        RAISE EXCEPTION 'parent environment (id=%) not found in project (id=%)', NEW.parent_id, NEW.project_id
+            USING errcode = '22023';
+    END IF;
+
+    IF EXISTS (
+        WITH RECURSIVE ancestors AS (
+            SELECT e.id, e.parent_id FROM public.environments e WHERE e.id = NEW.parent_id
+            UNION
+            SELECT e.id, e.parent_id FROM public.environments e JOIN ancestors a ON e.id = a.parent_id
+        )
+        SELECT 1 FROM ancestors a WHERE a.id = NEW.id
+    ) THEN
+        RAISE EXCEPTION 'environment (id=%) cannot inherit from its own descendant (id=%)', NEW.id, NEW.parent_id
+            USING errcode = '22023';
+    END IF;
+
+    RETURN NEW;
+END;$function$
+;
+
+CREATE TRIGGER environments_check_parent BEFORE INSERT OR UPDATE OF parent_id ON public.environments FOR EACH ROW EXECUTE FUNCTION private.environments_check_parent();
+
+-- the secret a value is resolved from: itself, unless it is inherited (then that of the parent, recursively)
+CREATE OR REPLACE FUNCTION private.secret_source(p_secret_id uuid)
+    RETURNS uuid
+    LANGUAGE sql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$
+WITH RECURSIVE chain AS (
+    SELECT s.id, s.variable_id, s.inherited, e.parent_id, 0 AS depth
+    FROM public.secrets s
+    JOIN public.environments e ON e.id = s.environment_id
+    WHERE s.id = p_secret_id
+    UNION ALL
+    SELECT s.id, s.variable_id, s.inherited, e.parent_id, c.depth + 1
+    FROM chain c
+    JOIN public.secrets s ON s.environment_id = c.parent_id AND s.variable_id = c.variable_id
+    JOIN public.environments e ON e.id = s.environment_id
+    WHERE c.inherited
+)
+SELECT c.id FROM chain c ORDER BY c.depth DESC LIMIT 1;
+$function$
+;
+
+-- secrets of an environment with a parent start out inherited
+CREATE OR REPLACE FUNCTION private.secrets_inherit()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+    NEW.inherited := EXISTS (SELECT 1 FROM public.environments e WHERE e.id = NEW.environment_id AND e.parent_id IS NOT NULL);
+    RETURN NEW;
+END;$function$
+;
+
+CREATE TRIGGER secrets_inherit BEFORE INSERT ON public.secrets FOR EACH ROW EXECUTE FUNCTION private.secrets_inherit();
+
+-- a value seen through inheritance changes with the value it is inherited from
+CREATE OR REPLACE FUNCTION private.secret_changed(p_secret_id uuid)
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+    PERFORM private.secret_event(h.environment_id, h.variable_id, v.key, 'update')
+    FROM (
+        WITH RECURSIVE heirs AS (
+            SELECT s.id, s.environment_id, s.variable_id
+            FROM public.secrets s
+            WHERE s.id = p_secret_id
+            UNION
+            SELECT s.id, s.environment_id, s.variable_id
+            FROM heirs h
+            JOIN public.environments e ON e.parent_id = h.environment_id
+            JOIN public.secrets s ON s.environment_id = e.id AND s.variable_id = h.variable_id
+            WHERE s.inherited
+        )
+        SELECT * FROM heirs
+    ) h
+    JOIN public.variables v ON v.id = h.variable_id;
+END;$function$
+;
+
+-- an environment without a parent keeps the values it was inheriting
+CREATE OR REPLACE FUNCTION private.environments_after_parent_change()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+begin
+
+    for secret in select * from public.secrets s where s.environment_id = NEW.id and s.inherited loop
+        if NEW.parent_id is null then
+            perform private.write_secret(secret.id, (
+                select ds.decrypted_secret
+                from public.secrets ps
+                join vault.decrypted_secrets ds on ds.id = private.secret_source(ps.id)
+                where ps.environment_id = OLD.parent_id
+                  and ps.variable_id = secret.variable_id
+            ));
+            update public.secrets s set inherited = false where s.id = secret.id;
+        else
+            perform private.secret_changed(secret.id);
+        end if;
+    end loop;
+
+    return null;
+end;$function$
+;
+
+CREATE TRIGGER environments_after_parent_change AFTER UPDATE OF parent_id ON public.environments FOR EACH ROW WHEN (OLD.parent_id IS DISTINCT FROM NEW.parent_id) EXECUTE FUNCTION private.environments_after_parent_change();
+
+-- values are resolved through inheritance; the caller only needs access to the environment it asked for
+CREATE OR REPLACE FUNCTION private.decrypt_secret(p_secret_id uuid)
+    RETURNS text
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid;
+begin
+
+    select s.environment_id from public.secrets s where s.id = p_secret_id into env_id;
+    if env_id is null then
+        raise exception 'secret (id=%) not found', p_secret_id;
+    end if;
+
+    -- callers must be able to read the environment the secret belongs to
+    if not private.can_read_environment(env_id) then
+        raise exception 'unauthorized';
+    end if;
+
+    return (
+        SELECT ds.decrypted_secret
+        FROM vault.decrypted_secrets ds
+        WHERE ds.id = private.secret_source(p_secret_id)
+    );
+end;$function$
+;
+
+-- the environment a secret's value is resolved from (see private.decrypt_secret for access)
+CREATE OR REPLACE FUNCTION private.secret_source_environment(p_secret_id uuid, OUT id uuid, OUT display text)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid;
+begin
+
+    select s.environment_id from public.secrets s where s.id = p_secret_id into env_id;
+    if env_id is null or not private.can_read_environment(env_id) then
+        return;
+    end if;
+
+    select e.id, e.display
+    from public.secrets s
+    join public.environments e on e.id = s.environment_id
+    where s.id = private.secret_source(p_secret_id)
+    into id, display;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.write_secret(p_secret_id uuid, p_value text, p_rolled_back_from integer DEFAULT NULL)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    version integer;
+begin
+
+    perform vault.update_secret(p_secret_id, p_value);
+    version := private.record_secret_version(p_secret_id, p_rolled_back_from);
+
+    perform private.audit(
+        'update',
+        'secrets/' || p_secret_id,
+        (select s.environment_id from public.secrets s where s.id = p_secret_id),
+        jsonb_build_object('version', version, 'rolled_back_from', p_rolled_back_from)
+    );
+
+    perform private.secret_changed(p_secret_id);
+
+    return version;
+end;$function$
+;
+
+-- setting a value in an environment overrides the value it inherits
+CREATE OR REPLACE FUNCTION private.set_secret(p_environment_id uuid, p_variable_id uuid, p_value text)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret_id uuid;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into secret_id;
+
+    if secret_id is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    update public.secrets s set inherited = false where s.id = secret_id;
+    perform private.write_secret(secret_id, p_value);
+
+    return secret_id;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.rollback_secret(p_environment_id uuid, p_variable_id uuid, p_version integer)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    target uuid;
+    value  text;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into target;
+
+    if target is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    select ds.decrypted_secret
+    from private.secret_versions sv
+    join vault.decrypted_secrets ds on ds.id = sv.vault_secret_id
+    where sv.secret_id = target
+      and sv.version = p_version
+    into value;
+
+    if value is null then
+        raise exception 'version % of secret (environment_id=%, variable_id=%) not found', p_version, p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    -- a rollback is a new version (with the value of the old one), so it can be undone too
+    update public.secrets s set inherited = false where s.id = target;
+    perform private.write_secret(target, value, p_version);
+
+    return target;
+end;$function$
+;
+
+-- inherited secrets follow their parent, so they are rotated with it
+CREATE OR REPLACE FUNCTION private.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL)
+    RETURNS SETOF uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    var    public.variables%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    -- a single variable must exist and be rotatable
+    if p_variable_id is not null then
+        select v.* from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        where v.id = p_variable_id and s.environment_id = p_environment_id
+        limit 1
+        into var;
+
+        if var.id is null then
+            raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+                using errcode = 'P0002';
+        end if;
+
+        if var.generator_type <> 'RANDOM'::public.generator then
+            raise exception 'only variables with a RANDOM generator can be rotated (generator=%)', var.generator_type
+                using errcode = '22023';
+        end if;
+
+        if exists (select 1 from public.secrets s where s.variable_id = p_variable_id and s.environment_id = p_environment_id and s.inherited) then
+            raise exception 'secret (environment_id=%, variable_id=%) is inherited (rotate it in the parent environment)', p_environment_id, p_variable_id
+                using errcode = '22023';
+        end if;
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        join public.variables v on v.id = s.variable_id
+        where s.environment_id = p_environment_id
+          and v.generator_type = 'RANDOM'::public.generator
+          and not s.inherited
+          and (p_variable_id is null or v.id = p_variable_id)
+        for update of s
+    loop
+        perform private.write_secret(
+            secret.id,
+            private.get_default_secret(variable_id := secret.variable_id)
+        );
+        return next secret.id;
+    end loop;
+
+end;$function$
+;
+
+-- falls back to the value of the parent environment
+CREATE OR REPLACE FUNCTION private.unset_secret(p_environment_id uuid, p_variable_id uuid)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    target uuid;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into target;
+
+    if target is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    if not exists (select 1 from public.environments e where e.id = p_environment_id and e.parent_id is not null) then
+        raise exception 'environment (id=%) has no parent to inherit from', p_environment_id
+            using errcode = '22023';
+    end if;
+
+    update public.secrets s set inherited = true where s.id = target and not s.inherited;
+    if found then
+        perform private.secret_changed(target);
+    end if;
+
+    return target;
+end;$function$
+;
+
+-- the functions returning resolved secrets gain the environment each value came from
+DROP FUNCTION IF EXISTS public.set_secret(uuid, uuid, text);
+
+DROP FUNCTION IF EXISTS public.rotate_secrets(uuid, uuid);
+
+DROP FUNCTION IF EXISTS public.rollback_secret(uuid, uuid, integer);
+
+DROP FUNCTION IF EXISTS public.resolve_secrets(uuid);
+
+-- runs with the privileges of the caller, so the RLS policies on secrets,
+-- variables, environments and projects decide which rows are resolved
+CREATE OR REPLACE FUNCTION public.resolve_secrets(p_environment_id uuid DEFAULT NULL)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text, source_environment_id uuid, source_environment_display text)
+    LANGUAGE sql
+    STABLE
+    SET search_path TO ''
+AS $function$
+SELECT s.id,
+       private.decrypt_secret(s.id),
+       v.id,
+       v.key,
+       e.id,
+       e.display,
+       p.id,
+       p.display,
+       src.id,
+       src.display
+FROM public.secrets s
+JOIN public.variables v ON v.id = s.variable_id
+JOIN public.environments e ON e.id = s.environment_id
+JOIN public.projects p ON p.id = e.project_id
+CROSS JOIN LATERAL private.secret_source_environment(s.id) src
+WHERE p_environment_id IS NULL OR s.environment_id = p_environment_id
+ORDER BY v.key;
+$function$
+;
+
+CREATE OR REPLACE FUNCTION public.set_secret(p_environment_id uuid, p_variable_id uuid, p_value text)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text, source_environment_id uuid, source_environment_display text)
+    LANGUAGE sql
+    SET search_path TO ''
+AS $function$
+SELECT private.set_secret(p_environment_id, p_variable_id, p_value);
+SELECT * FROM public.resolve_secrets(p_environment_id) rs WHERE rs.variable_id = p_variable_id;
+$function$
+;
+
+CREATE OR REPLACE FUNCTION public.unset_secret(p_environment_id uuid, p_variable_id uuid)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text, source_environment_id uuid, source_environment_display text)
+    LANGUAGE sql
+    SET search_path TO ''
+AS $function$
+SELECT private.unset_secret(p_environment_id, p_variable_id);
+SELECT * FROM public.resolve_secrets(p_environment_id) rs WHERE rs.variable_id = p_variable_id;
+$function$
+;
+
+CREATE OR REPLACE FUNCTION public.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text, source_environment_id uuid, source_environment_display text)
+    LANGUAGE plpgsql
+    SET search_path TO ''
+AS $function$declare
+    rotated uuid[];
+begin
+
+    rotated := array(select private.rotate_secrets(p_environment_id, p_variable_id));
+
+    return query
+        select rs.*
+        from public.resolve_secrets(p_environment_id) rs
+        where rs.id = any(rotated);
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.rollback_secret(p_environment_id uuid, p_variable_id uuid, p_version integer)
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text, source_environment_id uuid, source_environment_display text)
+    LANGUAGE sql
+    SET search_path TO ''
+AS $function$
+SELECT private.rollback_secret(p_environment_id, p_variable_id, p_version);
+SELECT * FROM public.resolve_secrets(p_environment_id) rs WHERE rs.variable_id = p_variable_id;
+$function$
+;
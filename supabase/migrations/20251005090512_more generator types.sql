@@ -0,0 +1,4 @@
+-- on its own: new enum values cannot be used in the transaction that adds them
+alter type "public"."generator" add value if not exists 'UUID';
+alter type "public"."generator" add value if not exists 'HEX';
+alter type "public"."generator" add value if not exists 'BASE64';
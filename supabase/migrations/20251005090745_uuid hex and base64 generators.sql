@@ -0,0 +1,238 @@
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.is_valid_uuid_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT extensions.jsonb_matches_schema(
+      schema := '{
+        "type": "object",
+        "properties": {
+          "version": { "type": "integer", "enum": [4, 7] }
+        },
+        "required": ["version"],
+        "additionalProperties": false
+      }'::json,
+      instance := gdata
+    );$function$
+;
+
+-- gen_random_bytes returns at most 1024 bytes per call
+CREATE OR REPLACE FUNCTION private.is_valid_hex_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT extensions.jsonb_matches_schema(
+      schema := '{
+        "type": "object",
+        "properties": {
+          "bytes": { "type": "integer", "minimum": 1, "maximum": 1024 }
+        },
+        "required": ["bytes"],
+        "additionalProperties": false
+      }'::json,
+      instance := gdata
+    );$function$
+;
+
+CREATE OR REPLACE FUNCTION private.is_valid_base64_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT extensions.jsonb_matches_schema(
+      schema := '{
+        "type": "object",
+        "properties": {
+          "bytes": { "type": "integer", "minimum": 1, "maximum": 1024 },
+          "url": { "type": "boolean" }
+        },
+        "required": ["bytes", "url"],
+        "additionalProperties": false
+      }'::json,
+      instance := gdata
+    );$function$
+;
+
+CREATE OR REPLACE FUNCTION private.is_valid_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT CASE gtype
+  WHEN 'STATIC' THEN
+    private.is_valid_static_generator_data(gtype, gdata)
+  WHEN 'RANDOM' THEN
+    private.is_valid_random_generator_data(gtype, gdata)
+  WHEN 'UUID' THEN
+    private.is_valid_uuid_generator_data(gtype, gdata)
+  WHEN 'HEX' THEN
+    private.is_valid_hex_generator_data(gtype, gdata)
+  WHEN 'BASE64' THEN
+    private.is_valid_base64_generator_data(gtype, gdata)
+  ELSE
+    false
+  END;$function$
+;
+
+-- a version 7 uuid: a random (version 4) uuid with its first 48 bits replaced by the unix time in
+-- milliseconds and its version bits changed from 0100 to 0111
+CREATE OR REPLACE FUNCTION private.uuid_v7()
+ RETURNS uuid
+ LANGUAGE sql
+ VOLATILE
+ SET search_path TO ''
+AS $function$SELECT encode(
+    set_bit(
+      set_bit(
+        overlay(
+          uuid_send(gen_random_uuid())
+          placing substring(int8send(floor(extract(epoch from clock_timestamp()) * 1000)::bigint) from 3)
+          from 1 for 6
+        ),
+        52, 1
+      ),
+      53, 1
+    ),
+    'hex'
+  )::uuid;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.get_default_secret(variable_id uuid)
+ RETURNS text
+ LANGUAGE plpgsql
+ SET search_path TO ''
+AS $function$declare
+    -- shared
+    variable public.variables%rowtype;
+    val      text := '';
+
+    -- RANDOM
+    len      int;
+    charset  text := '';
+begin
+
+    select * from public.variables v where v.id = variable_id limit 1 into variable;
+    if variable.id is null then
+        raise exception 'variable (id=%) not found', variable_id;
+    end if;
+
+    case variable.generator_type
+        when 'STATIC'::public.generator then
+            val := (
+                SELECT ds.decrypted_secret
+                FROM vault.decrypted_secrets ds
+                WHERE ds.id = (variable.generator_data->>'secret-id')::uuid
+            );
+
+        when 'RANDOM'::public.generator then
+            IF (variable.generator_data ->> 'letters')::boolean THEN
+                charset := charset || 'abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ';
+            END IF;
+            IF (variable.generator_data ->> 'numbers')::boolean THEN
+                charset := charset || '0123456789';
+            END IF;
+            IF (variable.generator_data ->> 'symbols')::boolean THEN
+                charset := charset || '!@#$%^&*()-_=+[]{};:,.<>?';
+            END IF;
+
+            IF charset = '' THEN
+                RAISE EXCEPTION 'charset empty!';
+            END IF;
+
+            len := (variable.generator_data->>'length')::int;
+
+            FOR i IN 1..len LOOP
+                    val := val || substr(charset, floor(random() * length(charset) + 1)::int, 1);
+                END LOOP;
+
+        when 'UUID'::public.generator then
+            IF (variable.generator_data ->> 'version')::int = 7 THEN
+                val := private.uuid_v7()::text;
+            ELSE
+                val := gen_random_uuid()::text;
+            END IF;
+
+        when 'HEX'::public.generator then
+            val := encode(extensions.gen_random_bytes((variable.generator_data ->> 'bytes')::int), 'hex');
+
+        when 'BASE64'::public.generator then
+            -- encode() wraps base64 at 76 characters
+            val := replace(encode(extensions.gen_random_bytes((variable.generator_data ->> 'bytes')::int), 'base64'), E'\n', '');
+            IF (variable.generator_data ->> 'url')::boolean THEN
+                -- base64url (RFC 4648 section 5), unpadded
+                val := rtrim(translate(val, '+/', '-_'), '=');
+            END IF;
+
+        else raise exception 'unhandled generator type (%)', variable.generator_type;
+        end case;
+
+    if val is null then
+        raise exception 'val unexpectedly null (generator=%)', variable.generator_type;
+    end if;
+
+    return val;
+end;$function$
+;
+
+-- every generator except STATIC produces a new value each time it runs
+CREATE OR REPLACE FUNCTION private.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL)
+    RETURNS SETOF uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    var    public.variables%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    -- a single variable must exist and be rotatable
+    if p_variable_id is not null then
+        select v.* from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        where v.id = p_variable_id and s.environment_id = p_environment_id
+        limit 1
+        into var;
+
+        if var.id is null then
+            raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+                using errcode = 'P0002';
+        end if;
+
+        if var.generator_type = 'STATIC'::public.generator then
+            raise exception 'variables with a STATIC generator cannot be rotated'
+                using errcode = '22023';
+        end if;
+
+        if exists (select 1 from public.secrets s where s.variable_id = p_variable_id and s.environment_id = p_environment_id and s.inherited) then
+            raise exception 'secret (environment_id=%, variable_id=%) is inherited (rotate it in the parent environment)', p_environment_id, p_variable_id
+                using errcode = '22023';
+        end if;
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        join public.variables v on v.id = s.variable_id
+        where s.environment_id = p_environment_id
+          and v.generator_type <> 'STATIC'::public.generator
+          and not s.inherited
+          and (p_variable_id is null or v.id = p_variable_id)
+        for update of s
+    loop
+        perform private.write_secret(
+            secret.id,
+            private.get_default_secret(variable_id := secret.variable_id)
+        );
+        return next secret.id;
+    end loop;
+
+end;$function$
+;
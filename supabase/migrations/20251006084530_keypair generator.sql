@@ -0,0 +1,377 @@
+set check_function_bodies = off;
+
+-- the private key names (by id) the variable of its public key, and the public key its private key
+CREATE OR REPLACE FUNCTION private.is_valid_keypair_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT extensions.jsonb_matches_schema(
+      schema := '{
+        "oneOf": [
+          {
+            "type": "object",
+            "properties": {
+              "alg": { "enum": ["ed25519", "rsa", "ecdsa"] },
+              "bits": { "type": "integer" },
+              "format": { "enum": ["pem", "jwk"] },
+              "public-key-id": { "type": "string", "format": "uuid" }
+            },
+            "required": ["alg", "format", "public-key-id"],
+            "additionalProperties": false,
+            "allOf": [
+              {
+                "if": { "properties": { "alg": { "const": "ed25519" } } },
+                "then": { "not": { "required": ["bits"] } }
+              },
+              {
+                "if": { "properties": { "alg": { "const": "rsa" } } },
+                "then": { "properties": { "bits": { "enum": [2048, 3072, 4096] } }, "required": ["bits"] }
+              },
+              {
+                "if": { "properties": { "alg": { "const": "ecdsa" } } },
+                "then": { "properties": { "bits": { "enum": [256, 384, 521] } }, "required": ["bits"] }
+              }
+            ]
+          },
+          {
+            "type": "object",
+            "properties": {
+              "private-key-id": { "type": "string", "format": "uuid" }
+            },
+            "required": ["private-key-id"],
+            "additionalProperties": false
+          }
+        ]
+      }'::json,
+      instance := gdata
+    );$function$
+;
+
+CREATE OR REPLACE FUNCTION private.is_valid_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT CASE gtype
+  WHEN 'STATIC' THEN
+    private.is_valid_static_generator_data(gtype, gdata)
+  WHEN 'RANDOM' THEN
+    private.is_valid_random_generator_data(gtype, gdata)
+  WHEN 'UUID' THEN
+    private.is_valid_uuid_generator_data(gtype, gdata)
+  WHEN 'HEX' THEN
+    private.is_valid_hex_generator_data(gtype, gdata)
+  WHEN 'BASE64' THEN
+    private.is_valid_base64_generator_data(gtype, gdata)
+  WHEN 'KEYPAIR' THEN
+    private.is_valid_keypair_generator_data(gtype, gdata)
+  ELSE
+    false
+  END;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.get_default_secret(variable_id uuid)
+ RETURNS text
+ LANGUAGE plpgsql
+ SET search_path TO ''
+AS $function$declare
+    -- shared
+    variable public.variables%rowtype;
+    val      text := '';
+
+    -- RANDOM
+    len      int;
+    charset  text := '';
+begin
+
+    select * from public.variables v where v.id = variable_id limit 1 into variable;
+    if variable.id is null then
+        raise exception 'variable (id=%) not found', variable_id;
+    end if;
+
+    case variable.generator_type
+        when 'STATIC'::public.generator then
+            val := (
+                SELECT ds.decrypted_secret
+                FROM vault.decrypted_secrets ds
+                WHERE ds.id = (variable.generator_data->>'secret-id')::uuid
+            );
+
+        when 'RANDOM'::public.generator then
+            IF (variable.generator_data ->> 'letters')::boolean THEN
+                charset := charset || 'abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ';
+            END IF;
+            IF (variable.generator_data ->> 'numbers')::boolean THEN
+                charset := charset || '0123456789';
+            END IF;
+            IF (variable.generator_data ->> 'symbols')::boolean THEN
+                charset := charset || '!@#$%^&*()-_=+[]{};:,.<>?';
+            END IF;
+
+            IF charset = '' THEN
+                RAISE EXCEPTION 'charset empty!';
+            END IF;
+
+            len := (variable.generator_data->>'length')::int;
+
+            FOR i IN 1..len LOOP
+                    val := val || substr(charset, floor(random() * length(charset) + 1)::int, 1);
+                END LOOP;
+
+        when 'UUID'::public.generator then
+            IF (variable.generator_data ->> 'version')::int = 7 THEN
+                val := private.uuid_v7()::text;
+            ELSE
+                val := gen_random_uuid()::text;
+            END IF;
+
+        when 'HEX'::public.generator then
+            val := encode(extensions.gen_random_bytes((variable.generator_data ->> 'bytes')::int), 'hex');
+
+        when 'BASE64'::public.generator then
+            -- encode() wraps base64 at 76 characters
+            val := replace(encode(extensions.gen_random_bytes((variable.generator_data ->> 'bytes')::int), 'base64'), E'\n', '');
+            IF (variable.generator_data ->> 'url')::boolean THEN
+                -- base64url (RFC 4648 section 5), unpadded
+                val := rtrim(translate(val, '+/', '-_'), '=');
+            END IF;
+
+        when 'KEYPAIR'::public.generator then
+            -- keys cannot be generated here: the server generates both halves (see public.pending_keypairs)
+            val := '';
+
+        else raise exception 'unhandled generator type (%)', variable.generator_type;
+        end case;
+
+    if val is null then
+        raise exception 'val unexpectedly null (generator=%)', variable.generator_type;
+    end if;
+
+    return val;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.variables_before_actions()
+ RETURNS trigger
+ LANGUAGE plpgsql
+ SECURITY DEFINER
+ SET SEARCH_PATH = ''
+AS $function$DECLARE
+  public_id uuid;
+BEGIN
+
+  IF TG_OP = 'UPDATE' THEN
+
+    -- a variable can never move between projects
+    NEW.id := OLD.id;
+    NEW.project_id := OLD.project_id;
+
+    -- nothing else to do if the generator was left untouched
+    IF NEW.generator_type = OLD.generator_type AND NEW.generator_data = OLD.generator_data THEN
+      RETURN NEW;
+    END IF;
+
+  END IF;
+
+  IF TG_OP = 'INSERT' OR TG_OP = 'UPDATE' THEN
+
+    -- for static, need to protect the secret by encrypting it
+    IF NEW.generator_type = 'STATIC'::public.generator THEN
+
+      -- force request type
+      if not extensions.jsonb_matches_schema(
+        schema := '{
+          "type": "object",
+          "properties": {
+            "secret": {
+              "type": "string"
+            }
+          },
+          "required": [
+            "secret"
+          ],
+          "additionalProperties": false
+        }'::json,
+        instance := NEW.generator_data
+      ) then
+        raise exception 'invalid format: must be an object with key "secret" of type "string"';
+      end if;
+
+      IF TG_OP = 'UPDATE' AND OLD.generator_type = 'STATIC'::public.generator THEN
+        -- re-use the existing vault secret
+        PERFORM vault.update_secret((OLD.generator_data->>'secret-id')::uuid, NEW.generator_data->>'secret');
+        NEW.generator_data := OLD.generator_data;
+      ELSE
+        -- fix the secret to only store the encrypted id
+        NEW.generator_data := jsonb_build_object(
+          'secret-id', vault.create_secret(NEW.generator_data->>'secret')::text
+        );
+      END IF;
+
+    END IF;
+
+    -- for a keypair, the variable holding the public key is created (or renamed) with the private key
+    IF NEW.generator_type = 'KEYPAIR'::public.generator THEN
+
+      IF NEW.generator_data ? 'public_key' THEN
+
+        IF TG_OP = 'UPDATE'
+             AND OLD.generator_type = 'KEYPAIR'::public.generator
+             AND OLD.generator_data ? 'public-key-id' THEN
+          public_id := (OLD.generator_data->>'public-key-id')::uuid;
+          UPDATE public.variables v
+          SET key = NEW.generator_data->>'public_key'
+          WHERE v.id = public_id AND v.key <> NEW.generator_data->>'public_key';
+        ELSE
+          INSERT INTO public.variables (id, key, description, project_id, generator_type, generator_data)
+          VALUES (
+            gen_random_uuid(),
+            NEW.generator_data->>'public_key',
+            'the public key of ' || NEW.key,
+            NEW.project_id,
+            'KEYPAIR'::public.generator,
+            jsonb_build_object('private-key-id', NEW.id)
+          )
+          RETURNING id INTO public_id;
+        END IF;
+
+        NEW.generator_data := (NEW.generator_data - 'public_key') || jsonb_build_object('public-key-id', public_id::text);
+
+      ELSIF pg_trigger_depth() = 1 THEN
+        raise exception 'invalid format: a keypair must name the variable of its public key ("public_key")';
+      END IF;
+
+    END IF;
+
+    -- the public key of a keypair follows its private key
+    IF TG_OP = 'UPDATE'
+         AND OLD.generator_type = 'KEYPAIR'::public.generator
+         AND OLD.generator_data ? 'private-key-id'
+         AND pg_trigger_depth() = 1 THEN
+      raise exception 'the generator of a public key cannot be changed (change the generator of its private key)'
+        using errcode = '22023';
+    END IF;
+
+  END IF;
+
+  -- a static secret that is no longer referenced should not linger in the vault
+  IF (TG_OP = 'UPDATE' OR TG_OP = 'DELETE')
+       AND OLD.generator_type = 'STATIC'::public.generator
+       AND (TG_OP = 'DELETE' OR NEW.generator_type <> 'STATIC'::public.generator) THEN
+    DELETE FROM vault.secrets WHERE id = (OLD.generator_data->>'secret-id')::uuid;
+  END IF;
+
+  RETURN COALESCE(NEW, OLD);
+
+END;$function$
+;
+
+-- the two variables of a keypair are deleted together (and the public key goes with the keypair)
+CREATE OR REPLACE FUNCTION private.variables_after_keypair_actions()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    IF OLD.generator_type <> 'KEYPAIR'::public.generator THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'UPDATE'
+         AND NEW.generator_type = 'KEYPAIR'::public.generator
+         AND NEW.generator_data->'public-key-id' IS NOT DISTINCT FROM OLD.generator_data->'public-key-id' THEN
+        RETURN NULL;
+    END IF;
+
+    DELETE FROM public.variables v
+    WHERE v.generator_type = 'KEYPAIR'::public.generator
+      AND (
+        (v.id::text = OLD.generator_data->>'public-key-id' AND v.generator_data->>'private-key-id' = OLD.id::text)
+        OR (v.id::text = OLD.generator_data->>'private-key-id' AND v.generator_data->>'public-key-id' = OLD.id::text)
+      );
+
+    RETURN NULL;
+END;$function$
+;
+
+CREATE TRIGGER variables_after_keypair_actions AFTER DELETE OR UPDATE OF generator_type, generator_data ON public.variables FOR EACH ROW EXECUTE FUNCTION private.variables_after_keypair_actions();
+
+-- the keypairs (by their private key) that still need to be generated in an environment: a keypair
+-- is generated when either of its keys has no value of its own (when it is created or rotated)
+CREATE OR REPLACE FUNCTION public.pending_keypairs()
+    RETURNS TABLE(environment_id uuid, variable_id uuid, public_variable_id uuid, generator_data jsonb)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    return query
+        select s.environment_id, v.id, p.variable_id, v.generator_data
+        from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        join public.secrets p on p.variable_id = (v.generator_data->>'public-key-id')::uuid
+                             and p.environment_id = s.environment_id
+        where v.generator_type = 'KEYPAIR'::public.generator
+          and v.generator_data ? 'public-key-id'
+          and (
+            (not s.inherited and (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = s.id) = '')
+            or (not p.inherited and (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = p.id) = '')
+          );
+end;$function$
+;
+
+-- stores both keys of a keypair (generated by the server) in an environment
+CREATE OR REPLACE FUNCTION public.set_keypair(p_environment_id uuid, p_variable_id uuid, p_private_key text, p_public_key text)
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    var    public.variables%rowtype;
+    secret public.secrets%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select * from public.variables v where v.id = p_variable_id into var;
+    if var.id is null then
+        raise exception 'variable (id=%) not found', p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    if var.generator_type <> 'KEYPAIR'::public.generator or not (var.generator_data ? 'public-key-id') then
+        raise exception 'variable (id=%) is not the private key of a keypair', p_variable_id
+            using errcode = '22023';
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        where s.environment_id = p_environment_id
+          and s.variable_id in (var.id, (var.generator_data->>'public-key-id')::uuid)
+        for update
+    loop
+        update public.secrets s set inherited = false where s.id = secret.id;
+        perform private.write_secret(
+            secret.id,
+            case when secret.variable_id = var.id then p_private_key else p_public_key end
+        );
+    end loop;
+
+    if not found then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+end;$function$
+;
@@ -0,0 +1,2 @@
+-- on its own: new enum values cannot be used in the transaction that adds them
+alter type "public"."generator" add value if not exists 'CERTIFICATE';
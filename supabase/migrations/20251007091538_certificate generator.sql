@@ -0,0 +1,517 @@
+create table "private"."certificate_authorities" (
+    "project_id" uuid not null,
+    "created_at" timestamp with time zone not null default now(),
+    "certificate" text not null,
+    "private_key_id" uuid not null
+);
+
+CREATE UNIQUE INDEX certificate_authorities_pkey ON private.certificate_authorities USING btree (project_id);
+
+alter table "private"."certificate_authorities" add constraint "certificate_authorities_pkey" PRIMARY KEY using index "certificate_authorities_pkey";
+
+alter table "private"."certificate_authorities" add constraint "certificate_authorities_project_id_fkey" FOREIGN KEY (project_id) REFERENCES public.projects(id) ON UPDATE CASCADE ON DELETE CASCADE not valid;
+
+alter table "private"."certificate_authorities" validate constraint "certificate_authorities_project_id_fkey";
+
+alter table "private"."certificate_authorities" enable row level security;
+
+set check_function_bodies = off;
+
+-- the private key of a ca lives in the vault, and goes with it
+CREATE OR REPLACE FUNCTION private.certificate_authorities_after_delete()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+    DELETE FROM vault.secrets WHERE id = OLD.private_key_id;
+    RETURN NULL;
+END;$function$
+;
+
+CREATE TRIGGER certificate_authorities_after_delete AFTER DELETE ON private.certificate_authorities FOR EACH ROW EXECUTE FUNCTION private.certificate_authorities_after_delete();
+
+-- the certificate names (by id) the variables of its private key and ca bundle, and they the certificate
+CREATE OR REPLACE FUNCTION private.is_valid_certificate_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT extensions.jsonb_matches_schema(
+      schema := '{
+        "oneOf": [
+          {
+            "type": "object",
+            "properties": {
+              "common-name": { "type": "string", "minLength": 1, "maxLength": 64 },
+              "sans": { "type": "array", "items": { "type": "string", "minLength": 1 } },
+              "days": { "type": "integer", "minimum": 1, "maximum": 825 },
+              "private-key-id": { "type": "string", "format": "uuid" },
+              "ca-bundle-id": { "type": "string", "format": "uuid" }
+            },
+            "required": ["sans", "days", "private-key-id", "ca-bundle-id"],
+            "additionalProperties": false
+          },
+          {
+            "type": "object",
+            "properties": {
+              "certificate-id": { "type": "string", "format": "uuid" }
+            },
+            "required": ["certificate-id"],
+            "additionalProperties": false
+          }
+        ]
+      }'::json,
+      instance := gdata
+    );$function$
+;
+
+CREATE OR REPLACE FUNCTION private.is_valid_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT CASE gtype
+  WHEN 'STATIC' THEN
+    private.is_valid_static_generator_data(gtype, gdata)
+  WHEN 'RANDOM' THEN
+    private.is_valid_random_generator_data(gtype, gdata)
+  WHEN 'UUID' THEN
+    private.is_valid_uuid_generator_data(gtype, gdata)
+  WHEN 'HEX' THEN
+    private.is_valid_hex_generator_data(gtype, gdata)
+  WHEN 'BASE64' THEN
+    private.is_valid_base64_generator_data(gtype, gdata)
+  WHEN 'KEYPAIR' THEN
+    private.is_valid_keypair_generator_data(gtype, gdata)
+  WHEN 'CERTIFICATE' THEN
+    private.is_valid_certificate_generator_data(gtype, gdata)
+  ELSE
+    false
+  END;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.get_default_secret(variable_id uuid)
+ RETURNS text
+ LANGUAGE plpgsql
+ SET search_path TO ''
+AS $function$declare
+    -- shared
+    variable public.variables%rowtype;
+    val      text := '';
+
+    -- RANDOM
+    len      int;
+    charset  text := '';
+begin
+
+    select * from public.variables v where v.id = variable_id limit 1 into variable;
+    if variable.id is null then
+        raise exception 'variable (id=%) not found', variable_id;
+    end if;
+
+    case variable.generator_type
+        when 'STATIC'::public.generator then
+            val := (
+                SELECT ds.decrypted_secret
+                FROM vault.decrypted_secrets ds
+                WHERE ds.id = (variable.generator_data->>'secret-id')::uuid
+            );
+
+        when 'RANDOM'::public.generator then
+            IF (variable.generator_data ->> 'letters')::boolean THEN
+                charset := charset || 'abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ';
+            END IF;
+            IF (variable.generator_data ->> 'numbers')::boolean THEN
+                charset := charset || '0123456789';
+            END IF;
+            IF (variable.generator_data ->> 'symbols')::boolean THEN
+                charset := charset || '!@#$%^&*()-_=+[]{};:,.<>?';
+            END IF;
+
+            IF charset = '' THEN
+                RAISE EXCEPTION 'charset empty!';
+            END IF;
+
+            len := (variable.generator_data->>'length')::int;
+
+            FOR i IN 1..len LOOP
+                    val := val || substr(charset, floor(random() * length(charset) + 1)::int, 1);
+                END LOOP;
+
+        when 'UUID'::public.generator then
+            IF (variable.generator_data ->> 'version')::int = 7 THEN
+                val := private.uuid_v7()::text;
+            ELSE
+                val := gen_random_uuid()::text;
+            END IF;
+
+        when 'HEX'::public.generator then
+            val := encode(extensions.gen_random_bytes((variable.generator_data ->> 'bytes')::int), 'hex');
+
+        when 'BASE64'::public.generator then
+            -- encode() wraps base64 at 76 characters
+            val := replace(encode(extensions.gen_random_bytes((variable.generator_data ->> 'bytes')::int), 'base64'), E'\n', '');
+            IF (variable.generator_data ->> 'url')::boolean THEN
+                -- base64url (RFC 4648 section 5), unpadded
+                val := rtrim(translate(val, '+/', '-_'), '=');
+            END IF;
+
+        when 'KEYPAIR'::public.generator then
+            -- keys cannot be generated here: the server generates both halves (see public.pending_keypairs)
+            val := '';
+
+        when 'CERTIFICATE'::public.generator then
+            -- likewise issued by the server (see public.pending_certificates)
+            val := '';
+
+        else raise exception 'unhandled generator type (%)', variable.generator_type;
+        end case;
+
+    if val is null then
+        raise exception 'val unexpectedly null (generator=%)', variable.generator_type;
+    end if;
+
+    return val;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.variables_before_actions()
+ RETURNS trigger
+ LANGUAGE plpgsql
+ SECURITY DEFINER
+ SET SEARCH_PATH = ''
+AS $function$DECLARE
+  public_id uuid;
+  key_id    uuid;
+  ca_id     uuid;
+BEGIN
+
+  IF TG_OP = 'UPDATE' THEN
+
+    -- a variable can never move between projects
+    NEW.id := OLD.id;
+    NEW.project_id := OLD.project_id;
+
+    -- nothing else to do if the generator was left untouched
+    IF NEW.generator_type = OLD.generator_type AND NEW.generator_data = OLD.generator_data THEN
+      RETURN NEW;
+    END IF;
+
+  END IF;
+
+  IF TG_OP = 'INSERT' OR TG_OP = 'UPDATE' THEN
+
+    -- for static, need to protect the secret by encrypting it
+    IF NEW.generator_type = 'STATIC'::public.generator THEN
+
+      -- force request type
+      if not extensions.jsonb_matches_schema(
+        schema := '{
+          "type": "object",
+          "properties": {
+            "secret": {
+              "type": "string"
+            }
+          },
+          "required": [
+            "secret"
+          ],
+          "additionalProperties": false
+        }'::json,
+        instance := NEW.generator_data
+      ) then
+        raise exception 'invalid format: must be an object with key "secret" of type "string"';
+      end if;
+
+      IF TG_OP = 'UPDATE' AND OLD.generator_type = 'STATIC'::public.generator THEN
+        -- re-use the existing vault secret
+        PERFORM vault.update_secret((OLD.generator_data->>'secret-id')::uuid, NEW.generator_data->>'secret');
+        NEW.generator_data := OLD.generator_data;
+      ELSE
+        -- fix the secret to only store the encrypted id
+        NEW.generator_data := jsonb_build_object(
+          'secret-id', vault.create_secret(NEW.generator_data->>'secret')::text
+        );
+      END IF;
+
+    END IF;
+
+    -- for a keypair, the variable holding the public key is created (or renamed) with the private key
+    IF NEW.generator_type = 'KEYPAIR'::public.generator THEN
+
+      IF NEW.generator_data ? 'public_key' THEN
+
+        IF TG_OP = 'UPDATE'
+             AND OLD.generator_type = 'KEYPAIR'::public.generator
+             AND OLD.generator_data ? 'public-key-id' THEN
+          public_id := (OLD.generator_data->>'public-key-id')::uuid;
+          UPDATE public.variables v
+          SET key = NEW.generator_data->>'public_key'
+          WHERE v.id = public_id AND v.key <> NEW.generator_data->>'public_key';
+        ELSE
+          INSERT INTO public.variables (id, key, description, project_id, generator_type, generator_data)
+          VALUES (
+            gen_random_uuid(),
+            NEW.generator_data->>'public_key',
+            'the public key of ' || NEW.key,
+            NEW.project_id,
+            'KEYPAIR'::public.generator,
+            jsonb_build_object('private-key-id', NEW.id)
+          )
+          RETURNING id INTO public_id;
+        END IF;
+
+        NEW.generator_data := (NEW.generator_data - 'public_key') || jsonb_build_object('public-key-id', public_id::text);
+
+      ELSIF pg_trigger_depth() = 1 THEN
+        raise exception 'invalid format: a keypair must name the variable of its public key ("public_key")';
+      END IF;
+
+    END IF;
+
+    -- for a certificate, the variables holding its private key and ca bundle are created (or renamed)
+    -- with it
+    IF NEW.generator_type = 'CERTIFICATE'::public.generator THEN
+
+      IF NEW.generator_data ? 'private_key' AND NEW.generator_data ? 'ca_bundle' THEN
+
+        IF TG_OP = 'UPDATE'
+             AND OLD.generator_type = 'CERTIFICATE'::public.generator
+             AND OLD.generator_data ? 'private-key-id' THEN
+          key_id := (OLD.generator_data->>'private-key-id')::uuid;
+          ca_id := (OLD.generator_data->>'ca-bundle-id')::uuid;
+          UPDATE public.variables v
+          SET key = CASE WHEN v.id = key_id THEN NEW.generator_data->>'private_key' ELSE NEW.generator_data->>'ca_bundle' END
+          WHERE v.id IN (key_id, ca_id)
+            AND v.key <> CASE WHEN v.id = key_id THEN NEW.generator_data->>'private_key' ELSE NEW.generator_data->>'ca_bundle' END;
+        ELSE
+          INSERT INTO public.variables (id, key, description, project_id, generator_type, generator_data)
+          VALUES (
+            gen_random_uuid(),
+            NEW.generator_data->>'private_key',
+            'the private key of ' || NEW.key,
+            NEW.project_id,
+            'CERTIFICATE'::public.generator,
+            jsonb_build_object('certificate-id', NEW.id)
+          )
+          RETURNING id INTO key_id;
+          INSERT INTO public.variables (id, key, description, project_id, generator_type, generator_data)
+          VALUES (
+            gen_random_uuid(),
+            NEW.generator_data->>'ca_bundle',
+            'the ca bundle of ' || NEW.key,
+            NEW.project_id,
+            'CERTIFICATE'::public.generator,
+            jsonb_build_object('certificate-id', NEW.id)
+          )
+          RETURNING id INTO ca_id;
+        END IF;
+
+        NEW.generator_data := (NEW.generator_data - 'private_key' - 'ca_bundle')
+          || jsonb_build_object('private-key-id', key_id::text, 'ca-bundle-id', ca_id::text);
+
+      ELSIF pg_trigger_depth() = 1 THEN
+        raise exception 'invalid format: a certificate must name the variables of its private key ("private_key") and ca bundle ("ca_bundle")';
+      END IF;
+
+    END IF;
+
+    -- the public key of a keypair follows its private key
+    IF TG_OP = 'UPDATE'
+         AND OLD.generator_type = 'KEYPAIR'::public.generator
+         AND OLD.generator_data ? 'private-key-id'
+         AND pg_trigger_depth() = 1 THEN
+      raise exception 'the generator of a public key cannot be changed (change the generator of its private key)'
+        using errcode = '22023';
+    END IF;
+
+    -- as do the private key and ca bundle of a certificate
+    IF TG_OP = 'UPDATE'
+         AND OLD.generator_type = 'CERTIFICATE'::public.generator
+         AND OLD.generator_data ? 'certificate-id'
+         AND pg_trigger_depth() = 1 THEN
+      raise exception 'the generator of the private key or ca bundle of a certificate cannot be changed (change the generator of the certificate)'
+        using errcode = '22023';
+    END IF;
+
+  END IF;
+
+  -- a static secret that is no longer referenced should not linger in the vault
+  IF (TG_OP = 'UPDATE' OR TG_OP = 'DELETE')
+       AND OLD.generator_type = 'STATIC'::public.generator
+       AND (TG_OP = 'DELETE' OR NEW.generator_type <> 'STATIC'::public.generator) THEN
+    DELETE FROM vault.secrets WHERE id = (OLD.generator_data->>'secret-id')::uuid;
+  END IF;
+
+  RETURN COALESCE(NEW, OLD);
+
+END;$function$
+;
+
+-- the three variables of a certificate are deleted together
+CREATE OR REPLACE FUNCTION private.variables_after_certificate_actions()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+
+    IF OLD.generator_type <> 'CERTIFICATE'::public.generator THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'UPDATE'
+         AND NEW.generator_type = 'CERTIFICATE'::public.generator
+         AND NEW.generator_data->'private-key-id' IS NOT DISTINCT FROM OLD.generator_data->'private-key-id'
+         AND NEW.generator_data->'ca-bundle-id' IS NOT DISTINCT FROM OLD.generator_data->'ca-bundle-id' THEN
+        RETURN NULL;
+    END IF;
+
+    DELETE FROM public.variables v
+    WHERE v.generator_type = 'CERTIFICATE'::public.generator
+      AND (
+        (v.id::text IN (OLD.generator_data->>'private-key-id', OLD.generator_data->>'ca-bundle-id')
+          AND v.generator_data->>'certificate-id' = OLD.id::text)
+        OR (v.id::text = OLD.generator_data->>'certificate-id'
+          AND OLD.id::text IN (v.generator_data->>'private-key-id', v.generator_data->>'ca-bundle-id'))
+      );
+
+    RETURN NULL;
+END;$function$
+;
+
+CREATE TRIGGER variables_after_certificate_actions AFTER DELETE OR UPDATE OF generator_type, generator_data ON public.variables FOR EACH ROW EXECUTE FUNCTION private.variables_after_certificate_actions();
+
+-- the certificates that still need to be issued in an environment: a certificate is issued when any
+-- of its three variables has no value of its own (when it is created or rotated); the ca of its
+-- project is included (null until the first certificate of the project is issued)
+CREATE OR REPLACE FUNCTION public.pending_certificates()
+    RETURNS TABLE(environment_id uuid, project_id uuid, variable_id uuid, private_key_variable_id uuid, ca_bundle_variable_id uuid, generator_data jsonb, ca_certificate text, ca_private_key text)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    return query
+        select s.environment_id, v.project_id, v.id, k.variable_id, b.variable_id, v.generator_data, ca.certificate,
+               (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = ca.private_key_id)
+        from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        join public.secrets k on k.variable_id = (v.generator_data->>'private-key-id')::uuid
+                             and k.environment_id = s.environment_id
+        join public.secrets b on b.variable_id = (v.generator_data->>'ca-bundle-id')::uuid
+                             and b.environment_id = s.environment_id
+        left join private.certificate_authorities ca on ca.project_id = v.project_id
+        where v.generator_type = 'CERTIFICATE'::public.generator
+          and v.generator_data ? 'private-key-id'
+          and exists (
+            select 1
+            from public.secrets x
+            where x.id in (s.id, k.id, b.id)
+              and not x.inherited
+              and (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = x.id) = ''
+          );
+end;$function$
+;
+
+-- stores the ca of a project (generated by the server) unless it already has one; the ca that is
+-- kept is returned, so concurrent requests agree on it
+CREATE OR REPLACE FUNCTION public.set_certificate_authority(p_project_id uuid, p_certificate text, p_private_key text)
+    RETURNS TABLE(certificate text, private_key text)
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    key_id uuid;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    if not exists (select 1 from public.projects p where p.id = p_project_id) then
+        raise exception 'project (id=%) not found', p_project_id
+            using errcode = 'P0002';
+    end if;
+
+    -- serializes the first certificates of a project
+    perform pg_advisory_xact_lock(hashtext('certificate_authorities/' || p_project_id));
+
+    if not exists (select 1 from private.certificate_authorities ca where ca.project_id = p_project_id) then
+        key_id := vault.create_secret(p_private_key);
+        insert into private.certificate_authorities (project_id, certificate, private_key_id)
+        values (p_project_id, p_certificate, key_id);
+
+        perform private.audit(
+            'create',
+            'certificate_authorities/' || p_project_id
+        );
+    end if;
+
+    return query
+        select ca.certificate, ds.decrypted_secret
+        from private.certificate_authorities ca
+        join vault.decrypted_secrets ds on ds.id = ca.private_key_id
+        where ca.project_id = p_project_id;
+end;$function$
+;
+
+-- stores a certificate (issued by the server), its private key and ca bundle in an environment
+CREATE OR REPLACE FUNCTION public.set_certificate(p_environment_id uuid, p_variable_id uuid, p_certificate text, p_private_key text, p_ca_bundle text)
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    var    public.variables%rowtype;
+    secret public.secrets%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select * from public.variables v where v.id = p_variable_id into var;
+    if var.id is null then
+        raise exception 'variable (id=%) not found', p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    if var.generator_type <> 'CERTIFICATE'::public.generator or not (var.generator_data ? 'private-key-id') then
+        raise exception 'variable (id=%) is not a certificate', p_variable_id
+            using errcode = '22023';
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        where s.environment_id = p_environment_id
+          and s.variable_id in (var.id, (var.generator_data->>'private-key-id')::uuid, (var.generator_data->>'ca-bundle-id')::uuid)
+        for update
+    loop
+        update public.secrets s set inherited = false where s.id = secret.id;
+        perform private.write_secret(
+            secret.id,
+            case secret.variable_id
+                when var.id then p_certificate
+                when (var.generator_data->>'private-key-id')::uuid then p_private_key
+                else p_ca_bundle
+            end
+        );
+    end loop;
+
+    if not found then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+end;$function$
+;
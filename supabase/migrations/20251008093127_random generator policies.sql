@@ -0,0 +1,219 @@
+set check_function_bodies = off;
+
+-- the characters of a class (lowercase, uppercase, numbers or symbols) in a charset; anything that
+-- is not a letter or a number is a symbol
+CREATE OR REPLACE FUNCTION private.random_generator_class(p_charset text, p_class text)
+ RETURNS text
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT CASE p_class
+  WHEN 'lowercase' THEN regexp_replace(p_charset, '[^a-z]', '', 'g')
+  WHEN 'uppercase' THEN regexp_replace(p_charset, '[^A-Z]', '', 'g')
+  WHEN 'numbers' THEN regexp_replace(p_charset, '[^0-9]', '', 'g')
+  WHEN 'symbols' THEN regexp_replace(p_charset, '[a-zA-Z0-9]', '', 'g')
+  END;$function$
+;
+
+-- the characters a RANDOM generator draws from: its alphabet (or the classes it enables), without
+-- excluded (and, optionally, ambiguous) characters, each character once
+CREATE OR REPLACE FUNCTION private.random_generator_charset(gdata jsonb)
+ RETURNS text
+ LANGUAGE plpgsql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$declare
+    charset text := '';
+    exclude text := coalesce(gdata->>'exclude', '');
+    ch      text;
+    result  text := '';
+begin
+
+    if gdata ? 'alphabet' then
+        charset := gdata->>'alphabet';
+    else
+        if (gdata->>'letters')::boolean then
+            charset := charset || 'abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ';
+        end if;
+        if (gdata->>'numbers')::boolean then
+            charset := charset || '0123456789';
+        end if;
+        if (gdata->>'symbols')::boolean then
+            charset := charset || '!@#$%^&*()-_=+[]{};:,.<>?';
+        end if;
+    end if;
+
+    if coalesce((gdata->>'exclude_ambiguous')::boolean, false) then
+        exclude := exclude || '0Oo1lI|';
+    end if;
+
+    foreach ch in array regexp_split_to_array(charset, '') loop
+        if strpos(result, ch) = 0 and strpos(exclude, ch) = 0 then
+            result := result || ch;
+        end if;
+    end loop;
+
+    return result;
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.is_valid_random_generator_data(gtype public.generator, gdata jsonb)
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT CASE WHEN extensions.jsonb_matches_schema(
+      schema := '{
+        "type": "object",
+        "properties": {
+          "length": { "type": "number", "minimum": 1, "maximum": 4096 },
+          "letters": { "type": "boolean" },
+          "numbers": { "type": "boolean" },
+          "symbols": { "type": "boolean" },
+          "alphabet": { "type": "string", "minLength": 1, "maxLength": 1024 },
+          "exclude": { "type": "string", "maxLength": 1024 },
+          "exclude_ambiguous": { "type": "boolean" },
+          "min_lowercase": { "type": "integer", "minimum": 0 },
+          "min_uppercase": { "type": "integer", "minimum": 0 },
+          "min_numbers": { "type": "integer", "minimum": 0 },
+          "min_symbols": { "type": "integer", "minimum": 0 }
+        },
+        "required": ["length", "letters", "numbers", "symbols"],
+        "additionalProperties": false,
+        "anyOf": [
+          { "required": ["alphabet"] },
+          { "properties": { "letters": { "const": true } } },
+          { "properties": { "numbers": { "const": true } } },
+          { "properties": { "symbols": { "const": true } } }
+        ]
+      }'::json,
+      instance := gdata
+    ) THEN
+      -- what a schema cannot express: something must be left to draw from, every class with a
+      -- minimum must be drawable, and the minimums must fit in the length
+      private.random_generator_charset(gdata) <> ''
+      AND (coalesce((gdata->>'min_lowercase')::int, 0) = 0 OR private.random_generator_class(private.random_generator_charset(gdata), 'lowercase') <> '')
+      AND (coalesce((gdata->>'min_uppercase')::int, 0) = 0 OR private.random_generator_class(private.random_generator_charset(gdata), 'uppercase') <> '')
+      AND (coalesce((gdata->>'min_numbers')::int, 0) = 0 OR private.random_generator_class(private.random_generator_charset(gdata), 'numbers') <> '')
+      AND (coalesce((gdata->>'min_symbols')::int, 0) = 0 OR private.random_generator_class(private.random_generator_charset(gdata), 'symbols') <> '')
+      AND coalesce((gdata->>'min_lowercase')::int, 0)
+        + coalesce((gdata->>'min_uppercase')::int, 0)
+        + coalesce((gdata->>'min_numbers')::int, 0)
+        + coalesce((gdata->>'min_symbols')::int, 0) <= (gdata->>'length')::numeric
+    ELSE
+      false
+    END;$function$
+;
+
+-- a random integer in [0, p_max)
+CREATE OR REPLACE FUNCTION private.random_int(p_max integer)
+ RETURNS integer
+ LANGUAGE sql
+ SET search_path TO ''
+AS $function$SELECT floor(random() * p_max)::int;$function$
+;
+
+-- the value of a RANDOM generator: the minimum of every class first, the rest from the whole
+-- charset, then shuffled (so the minimums are not always first)
+CREATE OR REPLACE FUNCTION private.random_secret(gdata jsonb)
+ RETURNS text
+ LANGUAGE plpgsql
+ SET search_path TO ''
+AS $function$declare
+    charset text := private.random_generator_charset(gdata);
+    chars   text[] := '{}';
+    class   text;
+    pool    text;
+    tmp     text;
+    j       int;
+begin
+
+    if charset = '' then
+        raise exception 'charset empty!';
+    end if;
+
+    foreach class in array array['lowercase', 'uppercase', 'numbers', 'symbols'] loop
+        pool := private.random_generator_class(charset, class);
+        for i in 1..coalesce((gdata->>('min_' || class))::int, 0) loop
+            chars := chars || substr(pool, private.random_int(length(pool)) + 1, 1);
+        end loop;
+    end loop;
+
+    for i in coalesce(array_length(chars, 1), 0) + 1 .. (gdata->>'length')::int loop
+        chars := chars || substr(charset, private.random_int(length(charset)) + 1, 1);
+    end loop;
+
+    -- Fisher-Yates
+    for i in reverse array_length(chars, 1) .. 2 loop
+        j := private.random_int(i) + 1;
+        tmp := chars[i];
+        chars[i] := chars[j];
+        chars[j] := tmp;
+    end loop;
+
+    return array_to_string(chars, '');
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION private.get_default_secret(variable_id uuid)
+ RETURNS text
+ LANGUAGE plpgsql
+ SET search_path TO ''
+AS $function$declare
+    -- shared
+    variable public.variables%rowtype;
+    val      text := '';
+begin
+
+    select * from public.variables v where v.id = variable_id limit 1 into variable;
+    if variable.id is null then
+        raise exception 'variable (id=%) not found', variable_id;
+    end if;
+
+    case variable.generator_type
+        when 'STATIC'::public.generator then
+            val := (
+                SELECT ds.decrypted_secret
+                FROM vault.decrypted_secrets ds
+                WHERE ds.id = (variable.generator_data->>'secret-id')::uuid
+            );
+
+        when 'RANDOM'::public.generator then
+            val := private.random_secret(variable.generator_data);
+
+        when 'UUID'::public.generator then
+            IF (variable.generator_data ->> 'version')::int = 7 THEN
+                val := private.uuid_v7()::text;
+            ELSE
+                val := gen_random_uuid()::text;
+            END IF;
+
+        when 'HEX'::public.generator then
+            val := encode(extensions.gen_random_bytes((variable.generator_data ->> 'bytes')::int), 'hex');
+
+        when 'BASE64'::public.generator then
+            -- encode() wraps base64 at 76 characters
+            val := replace(encode(extensions.gen_random_bytes((variable.generator_data ->> 'bytes')::int), 'base64'), E'\n', '');
+            IF (variable.generator_data ->> 'url')::boolean THEN
+                -- base64url (RFC 4648 section 5), unpadded
+                val := rtrim(translate(val, '+/', '-_'), '=');
+            END IF;
+
+        when 'KEYPAIR'::public.generator then
+            -- keys cannot be generated here: the server generates both halves (see public.pending_keypairs)
+            val := '';
+
+        when 'CERTIFICATE'::public.generator then
+            -- likewise issued by the server (see public.pending_certificates)
+            val := '';
+
+        else raise exception 'unhandled generator type (%)', variable.generator_type;
+        end case;
+
+    if val is null then
+        raise exception 'val unexpectedly null (generator=%)', variable.generator_type;
+    end if;
+
+    return val;
+end;$function$
+;
@@ -0,0 +1,28 @@
+set check_function_bodies = off;
+
+-- a random integer in [0, p_max), from the CSPRNG of pgcrypto; draws at or above the largest
+-- multiple of p_max that fits in 32 bits are rejected, as they would favor the smallest results
+CREATE OR REPLACE FUNCTION private.random_int(p_max integer)
+ RETURNS integer
+ LANGUAGE plpgsql
+ SET search_path TO ''
+AS $function$declare
+    bound bigint;
+    val   bigint;
+begin
+
+    if p_max is null or p_max < 1 then
+        raise exception 'max must be positive'
+            using errcode = '22023';
+    end if;
+
+    bound := 4294967296 - (4294967296 % p_max);
+
+    loop
+        val := ('x' || lpad(encode(extensions.gen_random_bytes(4), 'hex'), 16, '0'))::bit(64)::bigint;
+        if val < bound then
+            return (val % p_max)::int;
+        end if;
+    end loop;
+end;$function$
+;
@@ -0,0 +1,285 @@
+create type "public"."value_type" as enum ('STRING', 'INT', 'BOOL', 'URL', 'ENUM', 'REGEX', 'JSON');
+
+alter table "public"."variables" add column "value_type" public.value_type not null default 'STRING'::public.value_type;
+
+alter table "public"."variables" add column "value_constraints" jsonb not null default '{}'::jsonb;
+
+set check_function_bodies = off;
+
+-- the constraints a value type accepts (every one is optional, except the values of an ENUM and the
+-- pattern of a REGEX)
+CREATE OR REPLACE FUNCTION private.is_valid_value_constraints(vtype public.value_type, vdata jsonb)
+ RETURNS boolean
+ LANGUAGE plpgsql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$declare
+    schema json;
+begin
+
+    schema := case vtype
+        when 'STRING'::public.value_type then '{
+          "type": "object",
+          "properties": {
+            "min_length": { "type": "integer", "minimum": 0 },
+            "max_length": { "type": "integer", "minimum": 0 }
+          },
+          "additionalProperties": false
+        }'
+        when 'INT'::public.value_type then '{
+          "type": "object",
+          "properties": {
+            "min": { "type": "integer" },
+            "max": { "type": "integer" }
+          },
+          "additionalProperties": false
+        }'
+        when 'URL'::public.value_type then '{
+          "type": "object",
+          "properties": {
+            "schemes": {
+              "type": "array",
+              "items": { "type": "string", "pattern": "^[a-z][a-z0-9+.-]*$" },
+              "minItems": 1
+            }
+          },
+          "additionalProperties": false
+        }'
+        when 'ENUM'::public.value_type then '{
+          "type": "object",
+          "properties": {
+            "values": {
+              "type": "array",
+              "items": { "type": "string" },
+              "minItems": 1
+            }
+          },
+          "required": ["values"],
+          "additionalProperties": false
+        }'
+        when 'REGEX'::public.value_type then '{
+          "type": "object",
+          "properties": {
+            "pattern": { "type": "string", "minLength": 1 }
+          },
+          "required": ["pattern"],
+          "additionalProperties": false
+        }'
+        else '{
+          "type": "object",
+          "additionalProperties": false
+        }'
+    end;
+
+    if vdata is null or not extensions.jsonb_matches_schema(schema := schema, instance := vdata) then
+        return false;
+    end if;
+
+    if (vdata->>'min_length')::bigint > (vdata->>'max_length')::bigint
+        or (vdata->>'min')::numeric > (vdata->>'max')::numeric then
+        return false;
+    end if;
+
+    -- the pattern must compile
+    if vtype = 'REGEX'::public.value_type then
+        begin
+            perform '' ~ (vdata->>'pattern');
+        exception when invalid_regular_expression then
+            return false;
+        end;
+    end if;
+
+    return true;
+end;$function$
+;
+
+alter table "public"."variables" add constraint "variables_value_constraints_check" CHECK (private.is_valid_value_constraints(value_type, value_constraints)) not valid;
+
+-- why a value does not satisfy a type and its constraints (null if it does)
+CREATE OR REPLACE FUNCTION private.value_violation(vtype public.value_type, vdata jsonb, val text)
+ RETURNS text
+ LANGUAGE plpgsql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$declare
+    scheme text;
+begin
+
+    if val is null then
+        return 'a value is required';
+    end if;
+
+    case vtype
+        when 'STRING'::public.value_type then
+            if length(val) < (vdata->>'min_length')::bigint then
+                return format('must be at least %s characters long', vdata->>'min_length');
+            elsif length(val) > (vdata->>'max_length')::bigint then
+                return format('must be at most %s characters long', vdata->>'max_length');
+            end if;
+
+        when 'INT'::public.value_type then
+            if val !~ '^[+-]?[0-9]+$' then
+                return 'must be an integer';
+            elsif val::numeric < (vdata->>'min')::numeric then
+                return format('must be at least %s', vdata->>'min');
+            elsif val::numeric > (vdata->>'max')::numeric then
+                return format('must be at most %s', vdata->>'max');
+            end if;
+
+        when 'BOOL'::public.value_type then
+            if val not in ('true', 'false') then
+                return 'must be true or false';
+            end if;
+
+        when 'URL'::public.value_type then
+            scheme := lower(substring(val from '^([A-Za-z][A-Za-z0-9+.-]*):'));
+            if scheme is null or val !~ '^[A-Za-z][A-Za-z0-9+.-]*://[^/?#[:space:]]+[^[:space:]]*$' then
+                return 'must be an absolute url';
+            elsif vdata ? 'schemes' and not (vdata->'schemes' ? scheme) then
+                return format('must use one of the schemes %s', vdata->'schemes');
+            end if;
+
+        when 'ENUM'::public.value_type then
+            if not (vdata->'values' ? val) then
+                return format('must be one of %s', vdata->'values');
+            end if;
+
+        when 'REGEX'::public.value_type then
+            if val !~ ('^(?:' || (vdata->>'pattern') || ')$') then
+                return format('must match %s', vdata->>'pattern');
+            end if;
+
+        when 'JSON'::public.value_type then
+            begin
+                perform val::jsonb;
+            exception when invalid_text_representation then
+                return 'must be valid json';
+            end;
+    end case;
+
+    return null;
+end;$function$
+;
+
+-- values with ${KEY} references are only known once resolved, so they are checked by
+-- public.validate_secrets instead
+CREATE OR REPLACE FUNCTION private.check_value(p_variable_id uuid, p_value text)
+ RETURNS void
+ LANGUAGE plpgsql
+ STABLE SECURITY DEFINER
+ SET search_path TO ''
+AS $function$declare
+    var     public.variables%rowtype;
+    message text;
+begin
+
+    if strpos(p_value, '${') > 0 then
+        return;
+    end if;
+
+    select * from public.variables v where v.id = p_variable_id into var;
+    message := private.value_violation(var.value_type, var.value_constraints, p_value);
+
+    if message is not null then
+        raise exception 'invalid value for %: %', var.key, message
+            using errcode = '22023';
+    end if;
+end;$function$
+;
+
+-- setting a value in an environment overrides the value it inherits
+CREATE OR REPLACE FUNCTION private.set_secret(p_environment_id uuid, p_variable_id uuid, p_value text)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret_id uuid;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into secret_id;
+
+    if secret_id is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    perform private.check_value(p_variable_id, p_value);
+
+    update public.secrets s set inherited = false where s.id = secret_id;
+    perform private.write_secret(secret_id, p_value);
+
+    return secret_id;
+end;$function$
+;
+
+-- the value of a STATIC variable is the default of every environment, so it is checked as well
+-- (also when the type or the constraints of the variable change)
+CREATE OR REPLACE FUNCTION private.variables_after_value_type_actions()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$begin
+
+    if NEW.generator_type <> 'STATIC'::public.generator then
+        return null;
+    end if;
+
+    if TG_OP = 'UPDATE'
+        and NEW.value_type = OLD.value_type
+        and NEW.value_constraints = OLD.value_constraints
+        and NEW.generator_type = OLD.generator_type
+        and NEW.generator_data = OLD.generator_data then
+        return null;
+    end if;
+
+    perform private.check_value(NEW.id, (
+        select ds.decrypted_secret
+        from vault.decrypted_secrets ds
+        where ds.id = (NEW.generator_data->>'secret-id')::uuid
+    ));
+
+    return null;
+end;$function$
+;
+
+CREATE TRIGGER variables_after_value_type_actions AFTER INSERT OR UPDATE ON public.variables FOR EACH ROW EXECUTE FUNCTION private.variables_after_value_type_actions();
+
+-- the violations of the values of an environment, given as an object of variable id to (resolved)
+-- value; variables without a value are not checked
+CREATE OR REPLACE FUNCTION public.validate_secrets(p_environment_id uuid, p_values jsonb)
+    RETURNS TABLE(variable_id uuid, variable_key text, value_type public.value_type, message text)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$begin
+
+    if not private.can_read_environment(p_environment_id) then
+        raise exception 'unauthorized';
+    end if;
+
+    return query
+        select checked.id, checked.key, checked.value_type, checked.message
+        from (
+            select v.id, v.key, v.value_type,
+                   private.value_violation(v.value_type, v.value_constraints, p_values->>(v.id::text)) as message
+            from public.variables v
+            join public.environments e on e.project_id = v.project_id
+            where e.id = p_environment_id
+              and p_values ? (v.id::text)
+        ) checked
+        where checked.message is not null
+        order by checked.key;
+end;$function$
+;
@@ -0,0 +1,44 @@
+alter table "public"."variables" add column "tags" text[] not null default '{}'::text[];
+
+alter table "public"."variables" add column "owner" text;
+
+set check_function_bodies = off;
+
+-- tags are short, lowercase labels (e.g. "billing" or "service:api"), each at most once
+CREATE OR REPLACE FUNCTION private.is_valid_variable_tags(p_tags text[])
+ RETURNS boolean
+ LANGUAGE sql
+ IMMUTABLE
+ SET search_path TO ''
+AS $function$SELECT coalesce(array_length(p_tags, 1), 0) <= 32
+  AND NOT EXISTS (
+    SELECT 1 FROM unnest(p_tags) t
+    WHERE t IS NULL OR t !~ '^[a-z0-9][a-z0-9_.:=/-]{0,62}$'
+  )
+  AND (SELECT count(DISTINCT t) FROM unnest(p_tags) t) = coalesce(array_length(p_tags, 1), 0);$function$
+;
+
+alter table "public"."variables" add constraint "variables_tags_check" CHECK (private.is_valid_variable_tags(tags)) not valid;
+
+alter table "public"."variables" add constraint "variables_owner_check" CHECK ((owner IS NULL) OR ((length(TRIM(BOTH FROM owner)) > 0) AND (length(owner) <= 256))) not valid;
+
+CREATE INDEX variables_tags_idx ON public.variables USING gin (tags);
+
+-- the tags of the variables of an environment, for callers that may read its secrets but not its
+-- variables (clients may omit the environment, as with public.secret_events)
+CREATE OR REPLACE FUNCTION public.variable_tags(p_environment_id uuid DEFAULT NULL)
+    RETURNS TABLE(variable_id uuid, variable_key text, tags text[])
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+begin
+    return query
+        select v.id, v.key, v.tags
+        from public.variables v
+        join public.environments e on e.project_id = v.project_id
+        where e.id = env_id
+        order by v.key;
+end;$function$
+;
@@ -0,0 +1,101 @@
+alter table "public"."variables" add column "sensitive" boolean not null default true;
+
+set check_function_bodies = off;
+
+-- the values of variables that are not sensitive (plain configuration) are recorded in the audit
+-- log, along with the value they replaced
+CREATE OR REPLACE FUNCTION private.write_secret(p_secret_id uuid, p_value text, p_rolled_back_from integer DEFAULT NULL)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    version      integer;
+    is_sensitive boolean;
+    previous     text;
+    details      jsonb;
+begin
+
+    select v.sensitive
+    from public.secrets s
+    join public.variables v on v.id = s.variable_id
+    where s.id = p_secret_id
+    into is_sensitive;
+
+    if not coalesce(is_sensitive, true) then
+        previous := private.decrypt_secret(p_secret_id);
+    end if;
+
+    perform vault.update_secret(p_secret_id, p_value);
+    version := private.record_secret_version(p_secret_id, p_rolled_back_from);
+
+    details := jsonb_build_object('version', version, 'rolled_back_from', p_rolled_back_from);
+    if not coalesce(is_sensitive, true) then
+        details := details || jsonb_build_object('previous_value', previous, 'value', p_value);
+    end if;
+
+    perform private.audit(
+        'update',
+        'secrets/' || p_secret_id,
+        (select s.environment_id from public.secrets s where s.id = p_secret_id),
+        details
+    );
+
+    perform private.secret_changed(p_secret_id);
+
+    return version;
+end;$function$
+;
+
+DROP FUNCTION IF EXISTS public.variable_tags(uuid);
+
+-- the tags and sensitivity of the variables of an environment, for callers that may read its
+-- secrets but not its variables (clients may omit the environment, as with public.secret_events)
+CREATE OR REPLACE FUNCTION public.variable_metadata(p_environment_id uuid DEFAULT NULL)
+    RETURNS TABLE(variable_id uuid, variable_key text, tags text[], sensitive boolean)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+begin
+    return query
+        select v.id, v.key, v.tags, v.sensitive
+        from public.variables v
+        join public.environments e on e.project_id = v.project_id
+        where e.id = env_id
+        order by v.key;
+end;$function$
+;
+
+-- records that the (sensitive) values of an environment were shown to the caller
+CREATE OR REPLACE FUNCTION public.reveal_secrets(p_environment_id uuid, p_variable_ids uuid[])
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+    keys   jsonb;
+begin
+
+    select jsonb_agg(v.key order by v.key)
+    from public.variables v
+    join public.environments e on e.project_id = v.project_id
+    where e.id = env_id
+      and v.id = any(p_variable_ids)
+      and v.sensitive
+    into keys;
+
+    if keys is null then
+        return;
+    end if;
+
+    perform private.audit(
+        'reveal',
+        'environments/' || env_id,
+        env_id,
+        jsonb_build_object('variables', keys)
+    );
+end;$function$
+;
@@ -0,0 +1,86 @@
+-- the transaction that wrote each entry: identity values are taken at insert but become visible at commit, so a
+-- plain "id >" cursor can step over an entry whose transaction commits after a later one
+alter table "private"."audit_log" add column "xact_id" xid8;
+
+alter table "private"."audit_log" alter column "xact_id" set default pg_current_xact_id();
+
+CREATE INDEX audit_log_xact_id_idx ON private.audit_log USING btree (COALESCE(xact_id, '0'::xid8), id);
+
+DROP FUNCTION IF EXISTS public.audit_log(timestamp with time zone, timestamp with time zone, text, text, uuid, integer);
+
+CREATE OR REPLACE FUNCTION public.audit_log(
+    p_since timestamp with time zone DEFAULT NULL,
+    p_until timestamp with time zone DEFAULT NULL,
+    p_actor text DEFAULT NULL,
+    p_action text DEFAULT NULL,
+    p_environment_id uuid DEFAULT NULL,
+    p_limit integer DEFAULT 1000,
+    p_after bigint DEFAULT NULL
+)
+    RETURNS TABLE(id bigint, created_at timestamp with time zone, actor_type text, actor text, action text, resource text, environment_id uuid, source_ip text, details jsonb)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$DECLARE
+    v_after_xact xid8 := '0'::xid8;
+BEGIN
+
+    -- must be admin
+    IF NOT (private.is_admin_client()) THEN
+        RAISE EXCEPTION 'unauthorized';
+    END IF;
+
+    IF p_limit IS NULL OR p_limit < 1 THEN
+        RAISE EXCEPTION 'limit must be positive'
+            USING errcode = '22023';
+    END IF;
+
+    -- without a cursor: the most recent entries, oldest first
+    IF p_after IS NULL THEN
+        RETURN QUERY
+            SELECT l.id, l.created_at, l.actor_type, l.actor, l.action, l.resource, l.environment_id, host(l.source_ip), l.details
+            FROM (
+                SELECT *
+                FROM private.audit_log a
+                WHERE (p_since IS NULL OR a.created_at >= p_since)
+                  AND (p_until IS NULL OR a.created_at < p_until)
+                  AND (p_actor IS NULL OR a.actor = p_actor)
+                  AND (p_action IS NULL OR a.action = p_action)
+                  AND (p_environment_id IS NULL OR a.environment_id = p_environment_id)
+                ORDER BY a.id DESC
+                LIMIT p_limit
+            ) l
+            ORDER BY l.id;
+        RETURN;
+    END IF;
+
+    -- with a cursor (0 for the start of the log): the entries after it, in commit order
+    IF p_after < 0 THEN
+        RAISE EXCEPTION 'after must not be negative'
+            USING errcode = '22023';
+    ELSIF p_after > 0 THEN
+        SELECT COALESCE(a.xact_id, '0'::xid8) INTO v_after_xact
+        FROM private.audit_log a
+        WHERE a.id = p_after;
+
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'audit event (id=%) not found', p_after
+                USING errcode = 'P0002';
+        END IF;
+    END IF;
+
+    -- only entries of transactions older than every one still running: nothing can commit before them later on
+    RETURN QUERY
+        SELECT a.id, a.created_at, a.actor_type, a.actor, a.action, a.resource, a.environment_id, host(a.source_ip), a.details
+        FROM private.audit_log a
+        WHERE (COALESCE(a.xact_id, '0'::xid8), a.id) > (v_after_xact, p_after)
+          AND COALESCE(a.xact_id, '0'::xid8) < pg_snapshot_xmin(pg_current_snapshot())
+          AND (p_since IS NULL OR a.created_at >= p_since)
+          AND (p_until IS NULL OR a.created_at < p_until)
+          AND (p_actor IS NULL OR a.actor = p_actor)
+          AND (p_action IS NULL OR a.action = p_action)
+          AND (p_environment_id IS NULL OR a.environment_id = p_environment_id)
+        ORDER BY COALESCE(a.xact_id, '0'::xid8), a.id
+        LIMIT p_limit;
+END;$function$
+;
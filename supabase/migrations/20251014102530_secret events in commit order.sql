@@ -0,0 +1,63 @@
+-- the transaction that wrote each event: identity values are taken at insert but become visible at commit, so a
+-- watcher reading "id >" its last event could step over an event whose transaction commits after a later one
+alter table "private"."secret_events" add column "xact_id" xid8;
+
+alter table "private"."secret_events" alter column "xact_id" set default pg_current_xact_id();
+
+CREATE INDEX secret_events_environment_id_xact_id_idx ON private.secret_events USING btree (environment_id, COALESCE(xact_id, '0'::xid8), id);
+
+-- the events of an environment after a given event, in commit order; only events of transactions older than
+-- every one still running are served, since nothing can commit before them any more
+CREATE OR REPLACE FUNCTION public.secret_events(p_environment_id uuid DEFAULT NULL, p_after bigint DEFAULT 0, p_limit integer DEFAULT 1000)
+    RETURNS TABLE(id bigint, created_at timestamp with time zone, action text, environment_id uuid, variable_id uuid, variable_key text)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id     uuid := private.secret_events_environment(p_environment_id);
+    after_xact xid8 := '0'::xid8;
+begin
+
+    if p_limit is null or p_limit < 1 then
+        raise exception 'limit must be positive'
+            using errcode = '22023';
+    end if;
+
+    if coalesce(p_after, 0) > 0 then
+        select coalesce(se.xact_id, '0'::xid8) from private.secret_events se where se.id = p_after into after_xact;
+        if not found then
+            raise exception 'event (id=%) not found', p_after
+                using errcode = '22023';
+        end if;
+    end if;
+
+    return query
+        select se.id, se.created_at, se.action, se.environment_id, se.variable_id, se.variable_key
+        from private.secret_events se
+        where se.environment_id = env_id
+          and (coalesce(se.xact_id, '0'::xid8), se.id) > (after_xact, coalesce(p_after, 0))
+          and coalesce(se.xact_id, '0'::xid8) < pg_snapshot_xmin(pg_current_snapshot())
+        order by coalesce(se.xact_id, '0'::xid8), se.id
+        limit p_limit;
+end;$function$
+;
+
+-- the id of the most recent event of an environment that is served (where a new watcher starts from)
+CREATE OR REPLACE FUNCTION public.secret_events_cursor(p_environment_id uuid DEFAULT NULL)
+    RETURNS bigint
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+begin
+    return coalesce((
+        select se.id
+        from private.secret_events se
+        where se.environment_id = env_id
+          and coalesce(se.xact_id, '0'::xid8) < pg_snapshot_xmin(pg_current_snapshot())
+        order by coalesce(se.xact_id, '0'::xid8) desc, se.id desc
+        limit 1
+    ), 0);
+end;$function$
+;
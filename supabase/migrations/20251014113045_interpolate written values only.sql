@@ -0,0 +1,238 @@
+-- whether the value was written by hand (set or imported) rather than generated: only values written by hand (and
+-- the defaults of STATIC variables) are interpolated, generated ones may well contain a "${" of their own
+alter table "public"."secrets" add column "overridden" boolean not null default false;
+
+alter table "private"."secret_versions" add column "overridden" boolean not null default false;
+
+set check_function_bodies = off;
+
+-- a version remembers whether its value was written by hand, so a rollback restores that too
+CREATE OR REPLACE FUNCTION private.record_secret_version(p_secret_id uuid, p_rolled_back_from integer DEFAULT NULL)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    who          record;
+    next_version integer;
+begin
+
+    -- serialize versions of the same secret
+    perform 1 from public.secrets s where s.id = p_secret_id for update;
+
+    select coalesce(max(sv.version), 0) + 1
+    from private.secret_versions sv
+    where sv.secret_id = p_secret_id
+    into next_version;
+
+    who := private.audit_actor();
+
+    insert into private.secret_versions (secret_id, version, vault_secret_id, actor_type, actor, rolled_back_from, overridden)
+    values (
+        p_secret_id,
+        next_version,
+        vault.create_secret((select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = p_secret_id)),
+        who.actor_type,
+        who.actor,
+        p_rolled_back_from,
+        (select s.overridden from public.secrets s where s.id = p_secret_id)
+    );
+
+    return next_version;
+end;$function$
+;
+
+-- callers that do not say otherwise (rotations, regenerations, generated keys) write generated values
+DROP FUNCTION IF EXISTS private.write_secret(uuid, text, integer);
+
+CREATE OR REPLACE FUNCTION private.write_secret(p_secret_id uuid, p_value text, p_rolled_back_from integer DEFAULT NULL, p_overridden boolean DEFAULT false)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    version      integer;
+    is_sensitive boolean;
+    previous     text;
+    details      jsonb;
+begin
+
+    select v.sensitive
+    from public.secrets s
+    join public.variables v on v.id = s.variable_id
+    where s.id = p_secret_id
+    into is_sensitive;
+
+    if not coalesce(is_sensitive, true) then
+        previous := private.decrypt_secret(p_secret_id);
+    end if;
+
+    update public.secrets s set overridden = coalesce(p_overridden, false) where s.id = p_secret_id;
+    perform vault.update_secret(p_secret_id, p_value);
+    version := private.record_secret_version(p_secret_id, p_rolled_back_from);
+
+    details := jsonb_build_object('version', version, 'rolled_back_from', p_rolled_back_from);
+    if not coalesce(is_sensitive, true) then
+        details := details || jsonb_build_object('previous_value', previous, 'value', p_value);
+    end if;
+
+    perform private.audit(
+        'update',
+        'secrets/' || p_secret_id,
+        (select s.environment_id from public.secrets s where s.id = p_secret_id),
+        details
+    );
+
+    perform private.secret_changed(p_secret_id);
+
+    return version;
+end;$function$
+;
+
+-- set (and imported) values are written by hand
+CREATE OR REPLACE FUNCTION private.set_secret(p_environment_id uuid, p_variable_id uuid, p_value text)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret_id uuid;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into secret_id;
+
+    if secret_id is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    perform private.check_value(p_variable_id, p_value);
+
+    update public.secrets s set inherited = false where s.id = secret_id;
+    perform private.write_secret(secret_id, p_value, p_overridden := true);
+
+    return secret_id;
+end;$function$
+;
+
+-- a rollback restores whether the value was written by hand along with the value
+CREATE OR REPLACE FUNCTION private.rollback_secret(p_environment_id uuid, p_variable_id uuid, p_version integer)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    target  uuid;
+    value   text;
+    by_hand boolean;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into target;
+
+    if target is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    select ds.decrypted_secret, sv.overridden
+    from private.secret_versions sv
+    join vault.decrypted_secrets ds on ds.id = sv.vault_secret_id
+    where sv.secret_id = target
+      and sv.version = p_version
+    into value, by_hand;
+
+    if value is null then
+        raise exception 'version % of secret (environment_id=%, variable_id=%) not found', p_version, p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    -- a rollback is a new version (with the value of the old one), so it can be undone too
+    update public.secrets s set inherited = false where s.id = target;
+    perform private.write_secret(target, value, p_version, by_hand);
+
+    return target;
+end;$function$
+;
+
+-- an environment without a parent keeps the values it was inheriting (and whether they were written by hand)
+CREATE OR REPLACE FUNCTION private.environments_after_parent_change()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    source public.secrets%rowtype;
+begin
+
+    for secret in select * from public.secrets s where s.environment_id = NEW.id and s.inherited loop
+        if NEW.parent_id is null then
+            select src.*
+            from public.secrets ps
+            join public.secrets src on src.id = private.secret_source(ps.id)
+            where ps.environment_id = OLD.parent_id
+              and ps.variable_id = secret.variable_id
+            into source;
+
+            perform private.write_secret(
+                secret.id,
+                (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = source.id),
+                p_overridden := source.overridden
+            );
+            update public.secrets s set inherited = false where s.id = secret.id;
+        else
+            perform private.secret_changed(secret.id);
+        end if;
+    end loop;
+
+    return null;
+end;$function$
+;
+
+DROP FUNCTION IF EXISTS public.variable_metadata(uuid);
+
+-- the tags and sensitivity of the variables of an environment, and whether their values are interpolated (those
+-- of STATIC variables and those written by hand, wherever an inherited value comes from), for callers that may read
+-- its secrets but not its variables (clients may omit the environment, as with public.secret_events)
+CREATE OR REPLACE FUNCTION public.variable_metadata(p_environment_id uuid DEFAULT NULL)
+    RETURNS TABLE(variable_id uuid, variable_key text, tags text[], sensitive boolean, interpolated boolean)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+begin
+    return query
+        select v.id,
+               v.key,
+               v.tags,
+               v.sensitive,
+               v.generator_type = 'STATIC'::public.generator or coalesce(src.overridden, false)
+        from public.variables v
+        join public.environments e on e.project_id = v.project_id
+        left join public.secrets s on s.environment_id = e.id and s.variable_id = v.id
+        left join public.secrets src on src.id = private.secret_source(s.id)
+        where e.id = env_id
+        order by v.key;
+end;$function$
+;
@@ -0,0 +1,94 @@
+-- rotating a whole environment re-runs the RANDOM generators only, unless the caller lists the generators to re-run
+-- (reissuing every key, certificate or id of an environment is rarely what a routine rotation means)
+DROP FUNCTION IF EXISTS public.rotate_secrets(uuid, uuid);
+
+DROP FUNCTION IF EXISTS private.rotate_secrets(uuid, uuid);
+
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL, p_generators public.generator[] DEFAULT '{RANDOM}')
+    RETURNS SETOF uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    var    public.variables%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    -- a single variable must exist and be rotatable (whatever its generator)
+    if p_variable_id is not null then
+        select v.* from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        where v.id = p_variable_id and s.environment_id = p_environment_id
+        limit 1
+        into var;
+
+        if var.id is null then
+            raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+                using errcode = 'P0002';
+        end if;
+
+        if var.generator_type = 'STATIC'::public.generator then
+            raise exception 'variables with a STATIC generator cannot be rotated'
+                using errcode = '22023';
+        end if;
+
+        if exists (select 1 from public.secrets s where s.variable_id = p_variable_id and s.environment_id = p_environment_id and s.inherited) then
+            raise exception 'secret (environment_id=%, variable_id=%) is inherited (rotate it in the parent environment)', p_environment_id, p_variable_id
+                using errcode = '22023';
+        end if;
+
+    -- the whole environment: only the generators asked for
+    elsif p_generators is null or cardinality(p_generators) = 0 then
+        raise exception 'at least one generator is required'
+            using errcode = '22023';
+    elsif 'STATIC'::public.generator = any(p_generators) then
+        raise exception 'variables with a STATIC generator cannot be rotated'
+            using errcode = '22023';
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        join public.variables v on v.id = s.variable_id
+        where s.environment_id = p_environment_id
+          and v.generator_type <> 'STATIC'::public.generator
+          and not s.inherited
+          and (
+            (p_variable_id is null and v.generator_type = any(p_generators))
+            or v.id = p_variable_id
+          )
+        for update of s
+    loop
+        perform private.write_secret(
+            secret.id,
+            private.get_default_secret(variable_id := secret.variable_id)
+        );
+        return next secret.id;
+    end loop;
+
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL, p_generators public.generator[] DEFAULT '{RANDOM}')
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text, source_environment_id uuid, source_environment_display text)
+    LANGUAGE plpgsql
+    SET search_path TO ''
+AS $function$declare
+    rotated uuid[];
+begin
+
+    rotated := array(select private.rotate_secrets(p_environment_id, p_variable_id, p_generators));
+
+    return query
+        select rs.*
+        from public.resolve_secrets(p_environment_id) rs
+        where rs.id = any(rotated);
+end;$function$
+;
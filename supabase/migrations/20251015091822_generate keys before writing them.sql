@@ -0,0 +1,486 @@
+-- the keys of a keypair and the certificates of a certificate are generated by the server: a secret still waiting for
+-- them is pending (it has no value, and so no version, of its own until they are written)
+alter table "public"."secrets" add column "pending" boolean not null default false;
+
+-- secrets written empty before pending existed are still waiting for their keys
+update public.secrets s
+set pending = true
+from public.variables v
+where v.id = s.variable_id
+  and v.generator_type in ('KEYPAIR'::public.generator, 'CERTIFICATE'::public.generator)
+  and not s.inherited
+  and (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = s.id) = '';
+
+set check_function_bodies = off;
+
+-- a new keypair or certificate (that is not inherited) waits for the server (runs after secrets_inherit)
+CREATE OR REPLACE FUNCTION private.secrets_pending()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+    NEW.pending := NOT NEW.inherited AND EXISTS (
+        SELECT 1
+        FROM public.variables v
+        WHERE v.id = NEW.variable_id
+          AND v.generator_type IN ('KEYPAIR'::public.generator, 'CERTIFICATE'::public.generator)
+    );
+    RETURN NEW;
+END;$function$
+;
+
+CREATE TRIGGER secrets_pending BEFORE INSERT ON public.secrets FOR EACH ROW EXECUTE FUNCTION private.secrets_pending();
+
+-- a pending secret starts its history with the keys written by the server
+CREATE OR REPLACE FUNCTION private.secrets_after_actions()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$BEGIN
+    IF NOT NEW.pending THEN
+        PERFORM private.record_secret_version(NEW.id);
+    END IF;
+    RETURN NEW;
+END;$function$
+;
+
+-- keypairs and certificates are left to the server (their current values are kept until it writes new ones)
+CREATE OR REPLACE FUNCTION private.regenerate_variable_secrets(p_variable_id uuid)
+    RETURNS integer
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    var    public.variables%rowtype;
+    total  int := 0;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select * from public.variables v where v.id = p_variable_id into var;
+
+    for secret in select * from public.secrets s where s.variable_id = p_variable_id loop
+        if var.generator_type in ('KEYPAIR'::public.generator, 'CERTIFICATE'::public.generator) then
+            update public.secrets s set pending = not s.inherited where s.id = secret.id;
+        else
+            perform private.write_secret(
+                secret.id,
+                private.get_default_secret(variable_id := p_variable_id)
+            );
+        end if;
+        total := total + 1;
+    end loop;
+
+    return total;
+end;$function$
+;
+
+-- an environment without a parent keeps the values it was inheriting (and whether they were written by hand), or
+-- waits for the keys its parent was still waiting for
+CREATE OR REPLACE FUNCTION private.environments_after_parent_change()
+    RETURNS trigger
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret public.secrets%rowtype;
+    source public.secrets%rowtype;
+begin
+
+    for secret in select * from public.secrets s where s.environment_id = NEW.id and s.inherited loop
+        if NEW.parent_id is null then
+            select src.*
+            from public.secrets ps
+            join public.secrets src on src.id = private.secret_source(ps.id)
+            where ps.environment_id = OLD.parent_id
+              and ps.variable_id = secret.variable_id
+            into source;
+
+            if source.pending then
+                update public.secrets s set inherited = false, pending = true where s.id = secret.id;
+                continue;
+            end if;
+
+            perform private.write_secret(
+                secret.id,
+                (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = source.id),
+                p_overridden := source.overridden
+            );
+            update public.secrets s set inherited = false where s.id = secret.id;
+        else
+            perform private.secret_changed(secret.id);
+        end if;
+    end loop;
+
+    return null;
+end;$function$
+;
+
+-- the keypairs (by their private key) that still need to be generated in an environment: a keypair is generated
+-- when either of its keys is pending (when it is created or regenerated)
+CREATE OR REPLACE FUNCTION public.pending_keypairs()
+    RETURNS TABLE(environment_id uuid, variable_id uuid, public_variable_id uuid, generator_data jsonb)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    return query
+        select s.environment_id, v.id, p.variable_id, v.generator_data
+        from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        join public.secrets p on p.variable_id = (v.generator_data->>'public-key-id')::uuid
+                             and p.environment_id = s.environment_id
+        where v.generator_type = 'KEYPAIR'::public.generator
+          and v.generator_data ? 'public-key-id'
+          and ((s.pending and not s.inherited) or (p.pending and not p.inherited));
+end;$function$
+;
+
+-- stores both keys of a keypair (generated by the server) in an environment
+CREATE OR REPLACE FUNCTION public.set_keypair(p_environment_id uuid, p_variable_id uuid, p_private_key text, p_public_key text)
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    var    public.variables%rowtype;
+    secret public.secrets%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select * from public.variables v where v.id = p_variable_id into var;
+    if var.id is null then
+        raise exception 'variable (id=%) not found', p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    if var.generator_type <> 'KEYPAIR'::public.generator or not (var.generator_data ? 'public-key-id') then
+        raise exception 'variable (id=%) is not the private key of a keypair', p_variable_id
+            using errcode = '22023';
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        where s.environment_id = p_environment_id
+          and s.variable_id in (var.id, (var.generator_data->>'public-key-id')::uuid)
+        for update
+    loop
+        update public.secrets s set inherited = false, pending = false where s.id = secret.id;
+        perform private.write_secret(
+            secret.id,
+            case when secret.variable_id = var.id then p_private_key else p_public_key end
+        );
+    end loop;
+
+    if not found then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+end;$function$
+;
+
+-- the certificates that still need to be issued in an environment: a certificate is issued when any of its three
+-- variables is pending (when it is created or regenerated); the ca of its project is included (null until the first
+-- certificate of the project is issued)
+CREATE OR REPLACE FUNCTION public.pending_certificates()
+    RETURNS TABLE(environment_id uuid, project_id uuid, variable_id uuid, private_key_variable_id uuid, ca_bundle_variable_id uuid, generator_data jsonb, ca_certificate text, ca_private_key text)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    return query
+        select s.environment_id, v.project_id, v.id, k.variable_id, b.variable_id, v.generator_data, ca.certificate,
+               (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = ca.private_key_id)
+        from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        join public.secrets k on k.variable_id = (v.generator_data->>'private-key-id')::uuid
+                             and k.environment_id = s.environment_id
+        join public.secrets b on b.variable_id = (v.generator_data->>'ca-bundle-id')::uuid
+                             and b.environment_id = s.environment_id
+        left join private.certificate_authorities ca on ca.project_id = v.project_id
+        where v.generator_type = 'CERTIFICATE'::public.generator
+          and v.generator_data ? 'private-key-id'
+          and ((s.pending and not s.inherited) or (k.pending and not k.inherited) or (b.pending and not b.inherited));
+end;$function$
+;
+
+-- stores a certificate (issued by the server), its private key and ca bundle in an environment
+CREATE OR REPLACE FUNCTION public.set_certificate(p_environment_id uuid, p_variable_id uuid, p_certificate text, p_private_key text, p_ca_bundle text)
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    var    public.variables%rowtype;
+    secret public.secrets%rowtype;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select * from public.variables v where v.id = p_variable_id into var;
+    if var.id is null then
+        raise exception 'variable (id=%) not found', p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    if var.generator_type <> 'CERTIFICATE'::public.generator or not (var.generator_data ? 'private-key-id') then
+        raise exception 'variable (id=%) is not a certificate', p_variable_id
+            using errcode = '22023';
+    end if;
+
+    for secret in
+        select s.*
+        from public.secrets s
+        where s.environment_id = p_environment_id
+          and s.variable_id in (var.id, (var.generator_data->>'private-key-id')::uuid, (var.generator_data->>'ca-bundle-id')::uuid)
+        for update
+    loop
+        update public.secrets s set inherited = false, pending = false where s.id = secret.id;
+        perform private.write_secret(
+            secret.id,
+            case secret.variable_id
+                when var.id then p_certificate
+                when (var.generator_data->>'private-key-id')::uuid then p_private_key
+                else p_ca_bundle
+            end
+        );
+    end loop;
+
+    if not found then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+end;$function$
+;
+
+-- the variables generated together with a variable: both keys of a keypair, a certificate with its private key and
+-- ca bundle, or else the variable alone
+CREATE OR REPLACE FUNCTION private.generated_together(p_variable_id uuid)
+    RETURNS SETOF uuid
+    LANGUAGE sql
+    STABLE
+    SET search_path TO ''
+AS $function$
+    select g.id
+    from public.variables v
+    join public.variables r on r.id = case v.generator_type
+        when 'KEYPAIR'::public.generator then coalesce((v.generator_data->>'private-key-id')::uuid, v.id)
+        when 'CERTIFICATE'::public.generator then coalesce((v.generator_data->>'certificate-id')::uuid, v.id)
+        else v.id
+    end
+    cross join lateral (
+        values (r.id),
+               ((r.generator_data->>'public-key-id')::uuid),
+               ((r.generator_data->>'private-key-id')::uuid),
+               ((r.generator_data->>'ca-bundle-id')::uuid)
+    ) g(id)
+    where v.id = p_variable_id
+      and g.id is not null
+      and (g.id = r.id or r.generator_type in ('KEYPAIR'::public.generator, 'CERTIFICATE'::public.generator));
+$function$
+;
+
+-- the secrets a rotation re-generates: those of a variable or, for the whole environment, of the variables with one of
+-- the generators
+CREATE OR REPLACE FUNCTION private.rotation_targets(p_environment_id uuid, p_variable_id uuid, p_generators public.generator[])
+    RETURNS SETOF public.secrets
+    LANGUAGE sql
+    STABLE
+    SET search_path TO ''
+AS $function$
+    select s.*
+    from public.secrets s
+    join public.variables v on v.id = s.variable_id
+    where s.environment_id = p_environment_id
+      and v.generator_type <> 'STATIC'::public.generator
+      and not s.inherited
+      and (
+        (p_variable_id is null and v.generator_type = any(p_generators))
+        or v.id = p_variable_id
+      );
+$function$
+;
+
+-- the keypairs (by their private key) a rotation re-generates, for the server to generate before it rotates
+CREATE OR REPLACE FUNCTION public.keypairs_to_rotate(p_environment_id uuid, p_variable_id uuid DEFAULT NULL, p_generators public.generator[] DEFAULT '{RANDOM}')
+    RETURNS TABLE(environment_id uuid, variable_id uuid, public_variable_id uuid, generator_data jsonb)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    return query
+        select distinct t.environment_id, k.id, (k.generator_data->>'public-key-id')::uuid, k.generator_data
+        from private.rotation_targets(p_environment_id, p_variable_id, p_generators) t
+        join public.variables v on v.id = t.variable_id
+        join public.variables k on k.id = coalesce((v.generator_data->>'private-key-id')::uuid, v.id)
+        where v.generator_type = 'KEYPAIR'::public.generator;
+end;$function$
+;
+
+-- the certificates a rotation re-issues (with the ca of their project), for the server to issue before it rotates
+CREATE OR REPLACE FUNCTION public.certificates_to_rotate(p_environment_id uuid, p_variable_id uuid DEFAULT NULL, p_generators public.generator[] DEFAULT '{RANDOM}')
+    RETURNS TABLE(environment_id uuid, project_id uuid, variable_id uuid, private_key_variable_id uuid, ca_bundle_variable_id uuid, generator_data jsonb, ca_certificate text, ca_private_key text)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    return query
+        select distinct t.environment_id, c.project_id, c.id, (c.generator_data->>'private-key-id')::uuid,
+               (c.generator_data->>'ca-bundle-id')::uuid, c.generator_data, ca.certificate,
+               (select ds.decrypted_secret from vault.decrypted_secrets ds where ds.id = ca.private_key_id)
+        from private.rotation_targets(p_environment_id, p_variable_id, p_generators) t
+        join public.variables v on v.id = t.variable_id
+        join public.variables c on c.id = coalesce((v.generator_data->>'certificate-id')::uuid, v.id)
+        left join private.certificate_authorities ca on ca.project_id = c.project_id
+        where v.generator_type = 'CERTIFICATE'::public.generator;
+end;$function$
+;
+
+-- keys and certificates are generated by the server before it rotates, and written with everything else rotated
+-- (p_values, by variable id); rotating either key of a keypair (or any variable of a certificate) rotates them all
+DROP FUNCTION IF EXISTS public.rotate_secrets(uuid, uuid, public.generator[]);
+
+DROP FUNCTION IF EXISTS private.rotate_secrets(uuid, uuid, public.generator[]);
+
+CREATE OR REPLACE FUNCTION private.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL, p_generators public.generator[] DEFAULT '{RANDOM}', p_values jsonb DEFAULT '{}')
+    RETURNS SETOF uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    secret  public.secrets%rowtype;
+    var     public.variables%rowtype;
+    rotated uuid[];
+    value   text;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    -- a single variable must exist and be rotatable (whatever its generator)
+    if p_variable_id is not null then
+        select v.* from public.variables v
+        join public.secrets s on s.variable_id = v.id
+        where v.id = p_variable_id and s.environment_id = p_environment_id
+        limit 1
+        into var;
+
+        if var.id is null then
+            raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+                using errcode = 'P0002';
+        end if;
+
+        if var.generator_type = 'STATIC'::public.generator then
+            raise exception 'variables with a STATIC generator cannot be rotated'
+                using errcode = '22023';
+        end if;
+
+        if exists (select 1 from public.secrets s where s.variable_id = p_variable_id and s.environment_id = p_environment_id and s.inherited) then
+            raise exception 'secret (environment_id=%, variable_id=%) is inherited (rotate it in the parent environment)', p_environment_id, p_variable_id
+                using errcode = '22023';
+        end if;
+
+    -- the whole environment: only the generators asked for
+    elsif p_generators is null or cardinality(p_generators) = 0 then
+        raise exception 'at least one generator is required'
+            using errcode = '22023';
+    elsif 'STATIC'::public.generator = any(p_generators) then
+        raise exception 'variables with a STATIC generator cannot be rotated'
+            using errcode = '22023';
+    end if;
+
+    rotated := array(select t.id from private.rotation_targets(p_environment_id, p_variable_id, p_generators) t);
+
+    for secret in
+        select s.*
+        from public.secrets s
+        where s.environment_id = p_environment_id
+          and s.variable_id in (
+            select private.generated_together(t.variable_id)
+            from public.secrets t
+            where t.id = any(rotated)
+          )
+        order by s.id
+        for update
+    loop
+        select * from public.variables v where v.id = secret.variable_id into var;
+
+        if var.generator_type in ('KEYPAIR'::public.generator, 'CERTIFICATE'::public.generator) then
+            value := coalesce(p_values, '{}'::jsonb) ->> secret.variable_id::text;
+            if value is null then
+                raise exception 'the value of variable (id=%) must be generated by the server', secret.variable_id
+                    using errcode = '22023';
+            end if;
+            update public.secrets s set inherited = false, pending = false where s.id = secret.id;
+        else
+            value := private.get_default_secret(variable_id := secret.variable_id);
+        end if;
+
+        perform private.write_secret(secret.id, value);
+
+        if secret.id = any(rotated) then
+            return next secret.id;
+        end if;
+    end loop;
+
+end;$function$
+;
+
+CREATE OR REPLACE FUNCTION public.rotate_secrets(p_environment_id uuid, p_variable_id uuid DEFAULT NULL, p_generators public.generator[] DEFAULT '{RANDOM}', p_values jsonb DEFAULT '{}')
+    RETURNS TABLE(id uuid, value text, variable_id uuid, variable_key text, environment_id uuid, environment_display text, project_id uuid, project_display text, source_environment_id uuid, source_environment_display text)
+    LANGUAGE plpgsql
+    SET search_path TO ''
+AS $function$declare
+    rotated uuid[];
+begin
+
+    rotated := array(select private.rotate_secrets(p_environment_id, p_variable_id, p_generators, p_values));
+
+    return query
+        select rs.*
+        from public.resolve_secrets(p_environment_id) rs
+        where rs.id = any(rotated);
+end;$function$
+;
@@ -0,0 +1,53 @@
+-- a rolled back value is checked against the type and constraints of its variable, like any value that is set
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION private.rollback_secret(p_environment_id uuid, p_variable_id uuid, p_version integer)
+    RETURNS uuid
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    target  uuid;
+    value   text;
+    by_hand boolean;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    select s.id
+    from public.secrets s
+    where s.environment_id = p_environment_id
+      and s.variable_id = p_variable_id
+    limit 1
+    into target;
+
+    if target is null then
+        raise exception 'secret (environment_id=%, variable_id=%) not found', p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    select ds.decrypted_secret, sv.overridden
+    from private.secret_versions sv
+    join vault.decrypted_secrets ds on ds.id = sv.vault_secret_id
+    where sv.secret_id = target
+      and sv.version = p_version
+    into value, by_hand;
+
+    if value is null then
+        raise exception 'version % of secret (environment_id=%, variable_id=%) not found', p_version, p_environment_id, p_variable_id
+            using errcode = 'P0002';
+    end if;
+
+    -- the type or constraints of the variable may have changed since the version was written
+    perform private.check_value(p_variable_id, value);
+
+    -- a rollback is a new version (with the value of the old one), so it can be undone too
+    update public.secrets s set inherited = false where s.id = target;
+    perform private.write_secret(target, value, p_version, by_hand);
+
+    return target;
+end;$function$
+;
@@ -0,0 +1,33 @@
+-- every value the server reveals is audited: besides the values of sensitive variables, that
+-- includes those interpolating one (the server works out which, see taintSensitive)
+set check_function_bodies = off;
+
+CREATE OR REPLACE FUNCTION public.reveal_secrets(p_environment_id uuid, p_variable_ids uuid[])
+    RETURNS void
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+    keys   jsonb;
+begin
+
+    select jsonb_agg(v.key order by v.key)
+    from public.variables v
+    join public.environments e on e.project_id = v.project_id
+    where e.id = env_id
+      and v.id = any(p_variable_ids)
+    into keys;
+
+    if keys is null then
+        return;
+    end if;
+
+    perform private.audit(
+        'reveal',
+        'environments/' || env_id,
+        env_id,
+        jsonb_build_object('variables', keys)
+    );
+end;$function$
+;
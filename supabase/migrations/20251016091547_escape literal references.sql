@@ -0,0 +1,36 @@
+-- values written before references were resolved may hold a literal "${", which now starts one: they are escaped (as
+-- "$${", which resolves to what was there before) wherever they are interpolated. That is the values of STATIC
+-- variables not written by hand since (values written by hand before are not interpolated, see "interpolate written
+-- values only"), their versions, so a rollback does not bring the literal back, and the defaults of the variables
+DO $escape$
+DECLARE
+    target record;
+BEGIN
+    FOR target IN
+        SELECT ds.id, ds.decrypted_secret
+        FROM public.variables v
+        JOIN public.secrets s ON s.variable_id = v.id
+        JOIN vault.decrypted_secrets ds ON ds.id = s.id
+        WHERE v.generator_type = 'STATIC'::public.generator
+          AND NOT s.overridden
+          AND ds.decrypted_secret LIKE '%${%'
+        UNION
+        SELECT ds.id, ds.decrypted_secret
+        FROM public.variables v
+        JOIN public.secrets s ON s.variable_id = v.id
+        JOIN private.secret_versions sv ON sv.secret_id = s.id
+        JOIN vault.decrypted_secrets ds ON ds.id = sv.vault_secret_id
+        WHERE v.generator_type = 'STATIC'::public.generator
+          AND NOT sv.overridden
+          AND ds.decrypted_secret LIKE '%${%'
+        UNION
+        SELECT ds.id, ds.decrypted_secret
+        FROM public.variables v
+        JOIN vault.decrypted_secrets ds ON ds.id = (v.generator_data->>'secret-id')::uuid
+        WHERE v.generator_type = 'STATIC'::public.generator
+          AND ds.decrypted_secret LIKE '%${%'
+    LOOP
+        PERFORM vault.update_secret(target.id, replace(target.decrypted_secret, '${', '$${'));
+    END LOOP;
+END
+$escape$;
@@ -0,0 +1,27 @@
+-- whether a value is still waiting for the keys (or certificate) the server generates, wherever an inherited value
+-- comes from, so it is reported as such rather than served empty
+DROP FUNCTION IF EXISTS public.variable_metadata(uuid);
+
+CREATE OR REPLACE FUNCTION public.variable_metadata(p_environment_id uuid DEFAULT NULL)
+    RETURNS TABLE(variable_id uuid, variable_key text, tags text[], sensitive boolean, interpolated boolean, pending boolean)
+    LANGUAGE plpgsql
+    STABLE SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    env_id uuid := private.secret_events_environment(p_environment_id);
+begin
+    return query
+        select v.id,
+               v.key,
+               v.tags,
+               v.sensitive,
+               v.generator_type = 'STATIC'::public.generator or coalesce(src.overridden, false),
+               coalesce(src.pending, false)
+        from public.variables v
+        join public.environments e on e.project_id = v.project_id
+        left join public.secrets s on s.environment_id = e.id and s.variable_id = v.id
+        left join public.secrets src on src.id = private.secret_source(s.id)
+        where e.id = env_id
+        order by v.key;
+end;$function$
+;
@@ -0,0 +1,128 @@
+set check_function_bodies = off;
+
+-- a dry run checks each value it would write against the type (and constraints) of its variable, as writing it
+-- would (new variables have the default type), and returns why it would fail per key rather than failing on the
+-- first one; the return type changes, so the function is recreated
+DROP FUNCTION IF EXISTS public.import_variables(uuid, uuid, jsonb, text, boolean, boolean);
+
+CREATE OR REPLACE FUNCTION public.import_variables(
+    p_project_id uuid,
+    p_environment_id uuid,
+    p_entries jsonb,
+    p_conflict text DEFAULT 'fail',
+    p_sensitive boolean DEFAULT true,
+    p_dry_run boolean DEFAULT false
+)
+    RETURNS TABLE(key text, action text, variable_id uuid, violation text)
+    LANGUAGE plpgsql
+    SECURITY DEFINER
+    SET search_path TO ''
+AS $function$declare
+    entry     record;
+    existing  public.variables%rowtype;
+    conflicts text[];
+    created   integer := 0;
+    replaced  integer := 0;
+    skipped   integer := 0;
+begin
+
+    -- must be admin
+    if not (private.is_admin_client()) then
+        raise exception 'unauthorized';
+    end if;
+
+    if not exists(select 1
+                  from public.environments e
+                  where e.id = p_environment_id
+                    and e.project_id = p_project_id) then
+        raise exception 'environment (id=%) not found in project (id=%)', p_environment_id, p_project_id
+            using errcode = 'P0002';
+    end if;
+
+    if p_conflict is null or p_conflict not in ('skip', 'overwrite', 'fail') then
+        raise exception 'invalid conflict policy: % (must be skip, overwrite or fail)', p_conflict
+            using errcode = '22023';
+    end if;
+
+    if p_entries is null or jsonb_typeof(p_entries) <> 'object' then
+        raise exception 'entries must be an object of keys to values'
+            using errcode = '22023';
+    end if;
+
+    for entry in select e.key, e.value from jsonb_each(p_entries) e loop
+        if entry.key !~ '^[A-Z_][A-Z0-9_]*$' then
+            raise exception 'invalid key: % (must match ^[A-Z_][A-Z0-9_]*$)', entry.key
+                using errcode = '22023';
+        elsif jsonb_typeof(entry.value) <> 'string' then
+            raise exception 'invalid value for %: must be a string', entry.key
+                using errcode = '22023';
+        end if;
+    end loop;
+
+    if p_conflict = 'fail' then
+        select array_agg(v.key order by v.key)
+        from public.variables v
+        where v.project_id = p_project_id
+          and p_entries ? v.key
+        into conflicts;
+
+        if conflicts is not null then
+            raise exception 'variables already exist: %', array_to_string(conflicts, ', ')
+                using errcode = '23505';
+        end if;
+    end if;
+
+    for entry in select e.key, e.value #>> '{}' as value from jsonb_each(p_entries) e order by e.key loop
+
+        select *
+        from public.variables v
+        where v.project_id = p_project_id
+          and v.key = entry.key
+        into existing;
+
+        key := entry.key;
+        violation := null;
+        if existing.id is null then
+            action := 'create';
+            variable_id := null;
+            created := created + 1;
+            if p_dry_run then
+                violation := private.value_violation('STRING'::public.value_type, '{}'::jsonb, entry.value);
+            else
+                insert into public.variables (id, key, project_id, generator_type, generator_data, sensitive)
+                values (gen_random_uuid(), entry.key, p_project_id, 'STATIC'::public.generator,
+                        jsonb_build_object('secret', ''), p_sensitive)
+                returning id into variable_id;
+                perform private.set_secret(p_environment_id, variable_id, entry.value);
+            end if;
+        elsif p_conflict = 'overwrite' then
+            action := 'overwrite';
+            variable_id := existing.id;
+            replaced := replaced + 1;
+            if p_dry_run then
+                -- as private.check_value: values with references are checked once resolved
+                if strpos(entry.value, '${') = 0 then
+                    violation := private.value_violation(existing.value_type, existing.value_constraints, entry.value);
+                end if;
+            else
+                perform private.set_secret(p_environment_id, existing.id, entry.value);
+            end if;
+        else
+            action := 'skip';
+            variable_id := existing.id;
+            skipped := skipped + 1;
+        end if;
+
+        return next;
+    end loop;
+
+    if not p_dry_run then
+        perform private.audit(
+            'import',
+            'environments/' || p_environment_id,
+            p_environment_id,
+            jsonb_build_object('created', created, 'overwritten', replaced, 'skipped', skipped)
+        );
+    end if;
+end;$function$
+;
@@ -0,0 +1,17 @@
+begin;
+
+select extensions.plan(9);
+select extensions.has_table('private', 'admin_api_keys', 'admin api keys are not exposed through postgrest');
+select extensions.has_column('private', 'admin_api_keys', 'hash', 'admin api keys are stored hashed');
+select extensions.hasnt_column('private', 'admin_api_keys', 'key', 'admin api keys are never stored in plaintext');
+select extensions.has_function('public', 'is_admin', array[]::text[]);
+select extensions.has_function('public', 'admin_api_keys', array[]::text[]);
+select extensions.has_function('public', 'create_admin_api_key', array['text']);
+select extensions.has_function('public', 'revoke_admin_api_key', array['uuid']);
+select extensions.is(private.hash_admin_api_key('projconf'), encode(extensions.digest('projconf', 'sha256'), 'hex'));
+
+-- without a request, nobody is an admin
+select extensions.is(private.is_admin_client(), false);
+
+select * from extensions.finish();
+rollback;
\ No newline at end of file
@@ -0,0 +1,34 @@
+begin;
+
+select extensions.plan(12);
+select extensions.has_table('private', 'audit_log', 'the audit log is not exposed through postgrest');
+select extensions.has_function('public', 'audit_log', array['timestamp with time zone', 'timestamp with time zone', 'text', 'text', 'uuid', 'integer', 'bigint']);
+select extensions.has_function('public', 'audit_request', array['text', 'text', 'integer', 'uuid']);
+select extensions.has_trigger('public', 'projects', 'projects_audit');
+select extensions.has_trigger('public', 'secrets', 'secrets_audit');
+
+-- changes are recorded (outside a request, the database is the actor)
+insert into public.projects (id, display) values ('00000000-0000-0000-0000-00000000a0d1', 'audit test');
+select extensions.results_eq(
+    $$ select actor_type, action from private.audit_log where resource = 'projects/00000000-0000-0000-0000-00000000a0d1' $$,
+    $$ values ('system'::text, 'insert'::text) $$
+);
+
+-- append-only
+select extensions.throws_ok($$ update private.audit_log set actor = 'someone else' $$);
+select extensions.throws_ok($$ delete from private.audit_log $$);
+
+-- requests must come from an authenticated caller
+select extensions.throws_ok($$ select public.audit_request('GET', '/v1/projects', 200) $$, 'unauthorized');
+
+-- paging is safe against commit order: entries of a transaction still running are held back
+select set_config('projconf.x_admin_api_key', 'audit-test-key', true);
+select set_config('request.headers', '{"x-admin-api-key": "audit-test-key"}', true);
+select extensions.is_empty(
+    $$ select * from public.audit_log(p_after => 0) where resource = 'projects/00000000-0000-0000-0000-00000000a0d1' $$
+);
+select extensions.throws_ok($$ select * from public.audit_log(p_after => -1) $$, '22023');
+select extensions.throws_ok($$ select * from public.audit_log(p_after => 9223372036854775807) $$, 'P0002');
+
+select * from extensions.finish();
+rollback;
@@ -0,0 +1,78 @@
+begin;
+
+select extensions.plan(18);
+select extensions.has_table('private', 'certificate_authorities');
+select extensions.has_function('public', 'pending_certificates', array[]::text[]);
+select extensions.has_function('public', 'set_certificate_authority', array['uuid', 'text', 'text']);
+select extensions.has_function('public', 'set_certificate', array['uuid', 'uuid', 'text', 'text', 'text']);
+select extensions.has_function('public', 'certificates_to_rotate', array['uuid', 'uuid', 'generator[]']);
+select extensions.has_trigger('public', 'variables', 'variables_after_certificate_actions');
+
+-- generator data
+select extensions.ok(private.is_valid_generator_data('CERTIFICATE', '{"sans": ["api.internal", "10.0.0.1"], "days": 90, "private-key-id": "00000000-0000-0000-0000-0000000000c2", "ca-bundle-id": "00000000-0000-0000-0000-0000000000c3"}'));
+select extensions.ok(not private.is_valid_generator_data('CERTIFICATE', '{"sans": [], "days": 0, "private-key-id": "00000000-0000-0000-0000-0000000000c2", "ca-bundle-id": "00000000-0000-0000-0000-0000000000c3"}'));
+
+-- the variables of the private key and ca bundle are created with the certificate
+insert into public.projects (id, display) values ('00000000-0000-0000-0000-0000000000c0', 'certificates test');
+insert into public.variables (id, key, description, project_id, generator_type, generator_data) values
+    ('00000000-0000-0000-0000-0000000000c1', 'TLS_CERT', '', '00000000-0000-0000-0000-0000000000c0', 'CERTIFICATE', '{"sans": ["api.internal"], "days": 90, "private_key": "TLS_KEY", "ca_bundle": "TLS_CA"}');
+
+select extensions.results_eq(
+    $$ select v.key, v.generator_data->>'certificate-id' from public.variables v where v.key in ('TLS_KEY', 'TLS_CA') order by v.key $$,
+    $$ values ('TLS_CA'::text, '00000000-0000-0000-0000-0000000000c1'::text), ('TLS_KEY', '00000000-0000-0000-0000-0000000000c1') $$
+);
+select extensions.is(private.get_default_secret('00000000-0000-0000-0000-0000000000c1'), '');
+
+-- a new certificate waits for the server, without a version of its own
+insert into public.environments (id, display, project_id) values ('00000000-0000-0000-0000-0000000000ce', 'certificates test', '00000000-0000-0000-0000-0000000000c0');
+select extensions.results_eq(
+    $$ select bool_and(s.pending), count(*) from public.secrets s where s.environment_id = '00000000-0000-0000-0000-0000000000ce' $$,
+    $$ values (true, 3::bigint) $$
+);
+select extensions.is_empty($$
+    select 1 from private.secret_versions sv
+    join public.secrets s on s.id = sv.secret_id
+    where s.environment_id = '00000000-0000-0000-0000-0000000000ce'
+$$);
+
+-- the certificate issued by the server is its first version
+select set_config('projconf.x_admin_api_key', 'certificates-test-key', true);
+select set_config('request.headers', '{"x-admin-api-key": "certificates-test-key"}', true);
+select public.set_certificate('00000000-0000-0000-0000-0000000000ce', '00000000-0000-0000-0000-0000000000c1', 'certificate', 'private key', 'ca bundle');
+select extensions.results_eq(
+    $$ select bool_or(s.pending), max(sv.version) from public.secrets s
+       join private.secret_versions sv on sv.secret_id = s.id
+       where s.environment_id = '00000000-0000-0000-0000-0000000000ce' $$,
+    $$ values (false, 1) $$
+);
+
+-- rotating any of its variables re-issues the certificate
+select extensions.results_eq(
+    $$ select c.variable_id from public.certificates_to_rotate('00000000-0000-0000-0000-0000000000ce', (select v.id from public.variables v where v.key = 'TLS_CA')) c $$,
+    $$ values ('00000000-0000-0000-0000-0000000000c1'::uuid) $$
+);
+
+-- and renamed with it
+update public.variables set generator_data = '{"sans": ["api.internal"], "days": 30, "private_key": "TLS_PRIVATE_KEY", "ca_bundle": "TLS_CA"}'
+where id = '00000000-0000-0000-0000-0000000000c1';
+select extensions.results_eq(
+    $$ select v.key from public.variables v where v.generator_data->>'certificate-id' = '00000000-0000-0000-0000-0000000000c1' order by v.key $$,
+    $$ values ('TLS_CA'::text), ('TLS_PRIVATE_KEY') $$
+);
+
+-- they are only created with their certificate
+select extensions.throws_ok($$
+    insert into public.variables (key, description, project_id, generator_type, generator_data) values
+        ('OTHER_KEY', '', '00000000-0000-0000-0000-0000000000c0', 'CERTIFICATE', '{"certificate-id": "00000000-0000-0000-0000-0000000000c1"}')
+$$);
+select extensions.throws_ok($$
+    update public.variables set generator_type = 'RANDOM', generator_data = '{"length": 8, "letters": true, "numbers": true, "symbols": false}'
+    where key = 'TLS_CA'
+$$);
+
+-- and are deleted with it
+delete from public.variables where key = 'TLS_PRIVATE_KEY';
+select extensions.is_empty($$ select 1 from public.variables v where v.project_id = '00000000-0000-0000-0000-0000000000c0' $$);
+
+select * from extensions.finish();
+rollback;
@@ -0,0 +1,26 @@
+begin;
+
+select extensions.plan(10);
+select extensions.has_column('clients_secrets', 'expires_at');
+select extensions.has_column('clients_secrets', 'revoked_at');
+select extensions.has_function('public', 'create_client_secret', array['uuid', 'timestamp with time zone']);
+select extensions.has_function('public', 'revoke_client_secret', array['uuid', 'uuid']);
+select extensions.is_definer('public', 'revoke_client_secret', array['uuid', 'uuid']);
+select extensions.is_definer('private', 'verify_client_secret', array['uuid', 'text']);
+
+-- a client with a live, an expired and a revoked secret
+insert into public.projects (id, display) values ('00000000-0000-0000-0000-0000000007c0', 'client secrets test');
+insert into public.environments (id, display, project_id) values ('00000000-0000-0000-0000-0000000007ce', 'client secrets test', '00000000-0000-0000-0000-0000000007c0');
+insert into public.clients (id, display, environment_id) values ('00000000-0000-0000-0000-0000000007cc', 'client secrets test', '00000000-0000-0000-0000-0000000007ce');
+insert into public.clients_secrets (id, client_id, hash, expires_at, revoked_at) values
+    ('00000000-0000-0000-0000-0000000007c1', '00000000-0000-0000-0000-0000000007cc', extensions.crypt('live', extensions.gen_salt('bf', 4)), now() + interval '1 day', null),
+    ('00000000-0000-0000-0000-0000000007c2', '00000000-0000-0000-0000-0000000007cc', extensions.crypt('expired', extensions.gen_salt('bf', 4)), now() - interval '1 second', null),
+    ('00000000-0000-0000-0000-0000000007c3', '00000000-0000-0000-0000-0000000007cc', extensions.crypt('revoked', extensions.gen_salt('bf', 4)), null, now() - interval '1 second');
+
+select extensions.ok(private.verify_client_secret('00000000-0000-0000-0000-0000000007c1', 'live'), 'a live secret is accepted');
+select extensions.ok(not private.verify_client_secret('00000000-0000-0000-0000-0000000007c1', 'not live'), 'a wrong secret is rejected');
+select extensions.ok(not private.verify_client_secret('00000000-0000-0000-0000-0000000007c2', 'expired'), 'an expired secret is rejected');
+select extensions.ok(not private.verify_client_secret('00000000-0000-0000-0000-0000000007c3', 'revoked'), 'a revoked secret is rejected');
+
+select * from extensions.finish();
+rollback;
\ No newline at end of file
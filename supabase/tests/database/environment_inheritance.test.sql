@@ -0,0 +1,17 @@
+begin;
+
+select extensions.plan(9);
+select extensions.has_column('public', 'environments', 'parent_id', 'environments can have a parent');
+select extensions.has_column('public', 'secrets', 'inherited', 'secrets can fall through to the parent');
+select extensions.has_function('public', 'unset_secret', array['uuid', 'uuid']);
+select extensions.has_function('private', 'secret_source', array['uuid']);
+select extensions.is_definer('private', 'secret_source', array['uuid']);
+select extensions.has_trigger('public', 'environments', 'environments_check_parent');
+select extensions.has_trigger('public', 'secrets', 'secrets_inherit');
+select extensions.function_returns('public', 'resolve_secrets', array['uuid'], 'setof record');
+
+-- an environment cannot be its own parent
+select extensions.col_has_check('public', 'environments', 'parent_id');
+
+select * from extensions.finish();
+rollback;
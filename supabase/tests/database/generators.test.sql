@@ -0,0 +1,38 @@
+begin;
+
+select extensions.plan(11);
+select extensions.enum_has_labels('public', 'generator', array['STATIC', 'RANDOM', 'UUID', 'HEX', 'BASE64']);
+
+-- generator data
+select extensions.ok(private.is_valid_generator_data('UUID', '{"version": 7}'));
+select extensions.ok(not private.is_valid_generator_data('UUID', '{"version": 5}'));
+select extensions.ok(not private.is_valid_generator_data('HEX', '{"bytes": 0}'));
+select extensions.ok(not private.is_valid_generator_data('BASE64', '{"bytes": 16}'));
+
+-- generated values
+insert into public.projects (id, display) values ('00000000-0000-0000-0000-0000000000e1', 'generators test');
+insert into public.variables (id, key, description, project_id, generator_type, generator_data) values
+    ('00000000-0000-0000-0000-0000000000f1', 'UUID_V4', '', '00000000-0000-0000-0000-0000000000e1', 'UUID', '{"version": 4}'),
+    ('00000000-0000-0000-0000-0000000000f2', 'UUID_V7', '', '00000000-0000-0000-0000-0000000000e1', 'UUID', '{"version": 7}'),
+    ('00000000-0000-0000-0000-0000000000f3', 'HEX', '', '00000000-0000-0000-0000-0000000000e1', 'HEX', '{"bytes": 32}'),
+    ('00000000-0000-0000-0000-0000000000f4', 'BASE64', '', '00000000-0000-0000-0000-0000000000e1', 'BASE64', '{"bytes": 100, "url": false}'),
+    ('00000000-0000-0000-0000-0000000000f5', 'BASE64_URL', '', '00000000-0000-0000-0000-0000000000e1', 'BASE64', '{"bytes": 32, "url": true}');
+
+select extensions.matches(private.get_default_secret('00000000-0000-0000-0000-0000000000f1'), '^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$');
+select extensions.matches(private.get_default_secret('00000000-0000-0000-0000-0000000000f2'), '^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$');
+select extensions.matches(private.get_default_secret('00000000-0000-0000-0000-0000000000f3'), '^[0-9a-f]{64}$');
+
+-- long values are not wrapped
+select extensions.matches(private.get_default_secret('00000000-0000-0000-0000-0000000000f4'), '^[A-Za-z0-9+/]{136}$');
+select extensions.matches(private.get_default_secret('00000000-0000-0000-0000-0000000000f5'), '^[A-Za-z0-9_-]{43}$');
+
+-- version 7 uuids start with the current unix time (in milliseconds)
+select extensions.cmp_ok(
+    abs(('x' || lpad(substr(replace(private.uuid_v7()::text, '-', ''), 1, 12), 16, '0'))::bit(64)::bigint
+        - (extract(epoch from clock_timestamp()) * 1000)::bigint),
+    '<',
+    1000::bigint
+);
+
+select * from extensions.finish();
+rollback;
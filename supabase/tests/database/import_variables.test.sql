@@ -0,0 +1,74 @@
+begin;
+
+select extensions.plan(16);
+select extensions.has_function('public', 'import_variables', array['uuid', 'uuid', 'jsonb', 'text', 'boolean', 'boolean']);
+select extensions.is_definer('public', 'import_variables', array['uuid', 'uuid', 'jsonb', 'text', 'boolean', 'boolean']);
+select extensions.function_returns('public', 'import_variables', array['uuid', 'uuid', 'jsonb', 'text', 'boolean', 'boolean'], 'setof record');
+
+-- a project with an environment and a variable
+insert into public.projects (id, display) values ('00000000-0000-0000-0000-0000000000f0', 'import test');
+insert into public.variables (id, key, description, project_id, generator_type, generator_data, value_type) values
+    ('00000000-0000-0000-0000-0000000000f1', 'PORT', '', '00000000-0000-0000-0000-0000000000f0', 'STATIC', '{"secret": "8080"}', 'INT');
+insert into public.environments (id, display, project_id) values ('00000000-0000-0000-0000-0000000000fe', 'import test', '00000000-0000-0000-0000-0000000000f0');
+
+select set_config('projconf.x_admin_api_key', 'import-test-key', true);
+select set_config('request.headers', '{"x-admin-api-key": "import-test-key"}', true);
+
+create function pg_temp.value_of(p_key text)
+    returns text
+    language sql
+as $$
+    select private.decrypt_secret(s.id)
+    from public.secrets s
+    join public.variables v on v.id = s.variable_id
+    where s.environment_id = '00000000-0000-0000-0000-0000000000fe' and v.key = p_key
+$$;
+
+-- a dry run returns what would be done, and writes nothing
+select extensions.results_eq(
+    $$ select i.key, i.action from public.import_variables('00000000-0000-0000-0000-0000000000f0', '00000000-0000-0000-0000-0000000000fe', '{"PORT": "9090", "NEW_KEY": "new"}', 'overwrite', p_dry_run := true) i $$,
+    $$ values ('NEW_KEY'::text, 'create'::text), ('PORT', 'overwrite') $$
+);
+select extensions.is_empty($$ select 1 from public.variables v where v.key = 'NEW_KEY' $$);
+select extensions.is(pg_temp.value_of('PORT'), '8080');
+
+-- and reports, per key, the values their variables' types would refuse
+select extensions.results_eq(
+    $$ select i.key, i.action, i.violation from public.import_variables('00000000-0000-0000-0000-0000000000f0', '00000000-0000-0000-0000-0000000000fe', '{"PORT": "not a port", "NEW_KEY": "new"}', 'overwrite', p_dry_run := true) i $$,
+    $$ values ('NEW_KEY'::text, 'create'::text, null::text), ('PORT', 'overwrite', 'must be an integer') $$
+);
+select extensions.is(pg_temp.value_of('PORT'), '8080');
+
+-- by default, an existing variable fails the whole import
+select extensions.throws_ok(
+    $$ select * from public.import_variables('00000000-0000-0000-0000-0000000000f0', '00000000-0000-0000-0000-0000000000fe', '{"PORT": "9090", "NEW_KEY": "new"}') $$,
+    '23505'
+);
+select extensions.is_empty($$ select 1 from public.variables v where v.key = 'NEW_KEY' $$);
+
+-- a value failing part way rolls back what was already imported
+select extensions.throws_ok(
+    $$ select * from public.import_variables('00000000-0000-0000-0000-0000000000f0', '00000000-0000-0000-0000-0000000000fe', '{"A_FIRST": "a", "PORT": "not a port"}', 'overwrite') $$,
+    '22023'
+);
+select extensions.is_empty($$ select 1 from public.variables v where v.key = 'A_FIRST' $$);
+
+-- skip keeps the values of existing variables
+select extensions.results_eq(
+    $$ select i.key, i.action from public.import_variables('00000000-0000-0000-0000-0000000000f0', '00000000-0000-0000-0000-0000000000fe', '{"PORT": "9090", "NEW_KEY": "new"}', 'skip') i $$,
+    $$ values ('NEW_KEY'::text, 'create'::text), ('PORT', 'skip') $$
+);
+select extensions.results_eq(
+    $$ select pg_temp.value_of('NEW_KEY'), pg_temp.value_of('PORT') $$,
+    $$ values ('new'::text, '8080'::text) $$
+);
+
+-- overwrite replaces them
+select extensions.results_eq(
+    $$ select i.key, i.action from public.import_variables('00000000-0000-0000-0000-0000000000f0', '00000000-0000-0000-0000-0000000000fe', '{"PORT": "9090"}', 'overwrite') i $$,
+    $$ values ('PORT'::text, 'overwrite'::text) $$
+);
+select extensions.is(pg_temp.value_of('PORT'), '9090');
+
+select * from extensions.finish();
+rollback;
@@ -0,0 +1,107 @@
+begin;
+
+select extensions.plan(24);
+select extensions.has_function('public', 'pending_keypairs', array[]::text[]);
+select extensions.has_function('public', 'set_keypair', array['uuid', 'uuid', 'text', 'text']);
+select extensions.is_definer('public', 'set_keypair', array['uuid', 'uuid', 'text', 'text']);
+select extensions.has_function('public', 'keypairs_to_rotate', array['uuid', 'uuid', 'generator[]']);
+select extensions.has_trigger('public', 'variables', 'variables_after_keypair_actions');
+select extensions.has_column('public', 'secrets', 'pending', 'secrets know whether they wait for the server');
+
+-- generator data
+select extensions.ok(private.is_valid_generator_data('KEYPAIR', '{"alg": "rsa", "bits": 4096, "format": "pem", "public-key-id": "00000000-0000-0000-0000-0000000000b2"}'));
+select extensions.ok(not private.is_valid_generator_data('KEYPAIR', '{"alg": "rsa", "bits": 256, "format": "pem", "public-key-id": "00000000-0000-0000-0000-0000000000b2"}'));
+select extensions.ok(not private.is_valid_generator_data('KEYPAIR', '{"alg": "ed25519", "bits": 256, "format": "jwk", "public-key-id": "00000000-0000-0000-0000-0000000000b2"}'));
+
+-- the variable of the public key is created with the private key
+insert into public.projects (id, display) values ('00000000-0000-0000-0000-0000000000b0', 'keypairs test');
+insert into public.variables (id, key, description, project_id, generator_type, generator_data) values
+    ('00000000-0000-0000-0000-0000000000b1', 'JWT_PRIVATE_KEY', '', '00000000-0000-0000-0000-0000000000b0', 'KEYPAIR', '{"alg": "ed25519", "format": "pem", "public_key": "JWT_PUBLIC_KEY"}');
+
+select extensions.results_eq(
+    $$ select v.generator_data->>'private-key-id' from public.variables v where v.key = 'JWT_PUBLIC_KEY' $$,
+    $$ values ('00000000-0000-0000-0000-0000000000b1'::text) $$
+);
+select extensions.is(private.get_default_secret('00000000-0000-0000-0000-0000000000b1'), '');
+
+-- a new keypair waits for the server, without a version of its own
+insert into public.environments (id, display, project_id) values ('00000000-0000-0000-0000-0000000000be', 'keypairs test', '00000000-0000-0000-0000-0000000000b0');
+select extensions.results_eq(
+    $$ select bool_and(s.pending), count(*) from public.secrets s where s.environment_id = '00000000-0000-0000-0000-0000000000be' $$,
+    $$ values (true, 2::bigint) $$
+);
+select extensions.is_empty($$
+    select 1 from private.secret_versions sv
+    join public.secrets s on s.id = sv.secret_id
+    where s.environment_id = '00000000-0000-0000-0000-0000000000be'
+$$);
+
+select set_config('projconf.x_admin_api_key', 'keypairs-test-key', true);
+select set_config('request.headers', '{"x-admin-api-key": "keypairs-test-key"}', true);
+
+select extensions.results_eq(
+    $$ select p.variable_id from public.pending_keypairs() p where p.environment_id = '00000000-0000-0000-0000-0000000000be' $$,
+    $$ values ('00000000-0000-0000-0000-0000000000b1'::uuid) $$
+);
+select extensions.results_eq(
+    $$ select m.variable_key, m.pending from public.variable_metadata('00000000-0000-0000-0000-0000000000be') m $$,
+    $$ values ('JWT_PRIVATE_KEY'::text, true), ('JWT_PUBLIC_KEY', true) $$,
+    'pending values are reported as such'
+);
+
+-- the keys written by the server are its first version
+select public.set_keypair('00000000-0000-0000-0000-0000000000be', '00000000-0000-0000-0000-0000000000b1', 'private key 1', 'public key 1');
+select extensions.results_eq(
+    $$ select bool_or(s.pending), max(sv.version) from public.secrets s
+       join private.secret_versions sv on sv.secret_id = s.id
+       where s.environment_id = '00000000-0000-0000-0000-0000000000be' $$,
+    $$ values (false, 1) $$
+);
+select extensions.is_empty(
+    $$ select 1 from public.variable_metadata('00000000-0000-0000-0000-0000000000be') m where m.pending $$
+);
+
+-- rotating either key rotates both, with the keys the server generated first
+select extensions.results_eq(
+    $$ select k.variable_id from public.keypairs_to_rotate('00000000-0000-0000-0000-0000000000be', (select v.id from public.variables v where v.key = 'JWT_PUBLIC_KEY')) k $$,
+    $$ values ('00000000-0000-0000-0000-0000000000b1'::uuid) $$
+);
+select extensions.throws_ok(
+    $$ select * from public.rotate_secrets('00000000-0000-0000-0000-0000000000be', (select v.id from public.variables v where v.key = 'JWT_PUBLIC_KEY')) $$,
+    '22023'
+);
+select extensions.results_eq(
+    $$ select r.variable_key, r.value from public.rotate_secrets(
+         '00000000-0000-0000-0000-0000000000be',
+         (select v.id from public.variables v where v.key = 'JWT_PUBLIC_KEY'),
+         p_values := jsonb_build_object(
+           '00000000-0000-0000-0000-0000000000b1', 'private key 2',
+           (select v.id from public.variables v where v.key = 'JWT_PUBLIC_KEY')::text, 'public key 2'
+         )
+       ) r $$,
+    $$ values ('JWT_PUBLIC_KEY'::text, 'public key 2'::text) $$
+);
+select extensions.results_eq(
+    $$ select v.key, private.decrypt_secret(s.id) from public.secrets s
+       join public.variables v on v.id = s.variable_id
+       where s.environment_id = '00000000-0000-0000-0000-0000000000be'
+       order by v.key $$,
+    $$ values ('JWT_PRIVATE_KEY'::text, 'private key 2'::text), ('JWT_PUBLIC_KEY', 'public key 2') $$
+);
+
+-- public keys are only created with their private key
+select extensions.throws_ok($$
+    insert into public.variables (key, description, project_id, generator_type, generator_data) values
+        ('OTHER_PUBLIC_KEY', '', '00000000-0000-0000-0000-0000000000b0', 'KEYPAIR', '{"private-key-id": "00000000-0000-0000-0000-0000000000b1"}')
+$$);
+select extensions.throws_ok($$
+    update public.variables set generator_type = 'RANDOM', generator_data = '{"length": 8, "letters": true, "numbers": true, "symbols": false}'
+    where key = 'JWT_PUBLIC_KEY'
+$$);
+
+-- and are deleted with it
+delete from public.variables where id = '00000000-0000-0000-0000-0000000000b1';
+select extensions.is_empty($$ select 1 from public.variables v where v.key = 'JWT_PUBLIC_KEY' $$);
+
+select * from extensions.finish();
+rollback;
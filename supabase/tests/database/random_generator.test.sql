@@ -0,0 +1,35 @@
+begin;
+
+select extensions.plan(14);
+select extensions.has_function('private', 'random_secret', array['jsonb']);
+
+-- generator data
+select extensions.ok(private.is_valid_generator_data('RANDOM', '{"length": 16, "letters": true, "numbers": true, "symbols": false}'));
+select extensions.ok(private.is_valid_generator_data('RANDOM', '{"length": 16, "letters": false, "numbers": false, "symbols": false, "alphabet": "abc123"}'));
+select extensions.ok(not private.is_valid_generator_data('RANDOM', '{"length": 16, "letters": false, "numbers": false, "symbols": false}'));
+select extensions.ok(not private.is_valid_generator_data('RANDOM', '{"length": 16, "letters": false, "numbers": true, "symbols": false, "exclude": "0123456789"}'));
+select extensions.ok(not private.is_valid_generator_data('RANDOM', '{"length": 16, "letters": true, "numbers": false, "symbols": false, "min_numbers": 1}'));
+select extensions.ok(not private.is_valid_generator_data('RANDOM', '{"length": 4, "letters": true, "numbers": true, "symbols": false, "min_numbers": 3, "min_uppercase": 2}'));
+select extensions.ok(not private.is_valid_generator_data('RANDOM', '{"length": 16, "letters": true, "numbers": true, "symbols": false, "min_numbers": -1}'));
+
+-- charsets
+select extensions.is(private.random_generator_charset('{"length": 1, "letters": false, "numbers": true, "symbols": false, "exclude_ambiguous": true}'), '23456789');
+select extensions.is(private.random_generator_charset('{"length": 1, "letters": false, "numbers": false, "symbols": false, "alphabet": "aabbc", "exclude": "c"}'), 'ab');
+select extensions.is(private.random_generator_class('aB3$', 'symbols'), '$');
+
+-- generated values
+select extensions.matches(
+    private.random_secret('{"length": 64, "letters": false, "numbers": false, "symbols": false, "alphabet": "xyz"}'),
+    '^[xyz]{64}$'
+);
+select extensions.matches(
+    private.random_secret('{"length": 4, "letters": true, "numbers": true, "symbols": true, "min_lowercase": 1, "min_uppercase": 1, "min_numbers": 1, "min_symbols": 1}'),
+    '^(?=.*[a-z])(?=.*[A-Z])(?=.*[0-9])(?=.*[^a-zA-Z0-9]).{4}$'
+);
+select extensions.unlike(
+    private.random_secret('{"length": 256, "letters": true, "numbers": true, "symbols": false, "exclude_ambiguous": true}'),
+    '%0%'
+);
+
+select * from extensions.finish();
+rollback;
@@ -0,0 +1,87 @@
+begin;
+
+-- chi-squared goodness of fit against a uniform distribution: every test compares the statistic
+-- to its critical value at p = 0.001, so a correct generator fails one in a thousand runs
+create function pg_temp.chi_squared(observed bigint[], expected numeric)
+    returns numeric
+    language sql
+as $$ select sum(power(o - expected, 2) / expected) from unnest(observed) o $$;
+
+select extensions.plan(7);
+
+-- bounds
+select extensions.is_empty($$ select 1 from generate_series(1, 1000) where private.random_int(7) not between 0 and 6 $$);
+select extensions.is(private.random_int(1), 0);
+select extensions.throws_ok($$ select private.random_int(0) $$, '22023');
+
+-- integers: 10 buckets, 10000 draws (9 degrees of freedom)
+select extensions.cmp_ok(
+    pg_temp.chi_squared(
+        (select array_agg(n) from (
+            select count(d.v) as n
+            from generate_series(0, 9) b
+            left join (select private.random_int(10) as v from generate_series(1, 10000)) d on d.v = b
+            group by b
+        ) counts),
+        1000
+    ),
+    '<',
+    27.877
+);
+
+-- a large range (where modulo bias is largest): 3 buckets of a range of 3 * 2^29, 6000 draws (2
+-- degrees of freedom); without rejection the first two buckets would be drawn 1.5x as often as the last
+select extensions.cmp_ok(
+    pg_temp.chi_squared(
+        (select array_agg(n) from (
+            select count(d.v) as n
+            from generate_series(0, 2) b
+            left join (select private.random_int(1610612736) / 536870912 as v from generate_series(1, 6000)) d on d.v = b
+            group by b
+        ) counts),
+        2000
+    ),
+    '<',
+    13.816
+);
+
+-- characters of a RANDOM value: 4 characters, 8000 draws (3 degrees of freedom)
+select extensions.cmp_ok(
+    pg_temp.chi_squared(
+        (select array_agg(n) from (
+            select count(c.v) as n
+            from unnest(array['a', 'b', 'c', 'd']) b
+            left join regexp_split_to_table(
+                private.random_secret('{"length": 4096, "letters": false, "numbers": false, "symbols": false, "alphabet": "abcd"}')
+                || private.random_secret('{"length": 3904, "letters": false, "numbers": false, "symbols": false, "alphabet": "abcd"}'),
+                ''
+            ) c(v) on c.v = b
+            group by b
+        ) counts),
+        2000
+    ),
+    '<',
+    16.266
+);
+
+-- positions of a shuffled minimum: the single uppercase letter of 500 values of length 5 is as
+-- likely in every position (4 degrees of freedom)
+select extensions.cmp_ok(
+    pg_temp.chi_squared(
+        (select array_agg(n) from (
+            select count(d.v) as n
+            from generate_series(1, 5) b
+            left join (
+                select strpos(private.random_secret('{"length": 5, "letters": false, "numbers": false, "symbols": false, "alphabet": "aB", "min_lowercase": 4, "min_uppercase": 1}'), 'B') as v
+                from generate_series(1, 500)
+            ) d on d.v = b
+            group by b
+        ) counts),
+        100
+    ),
+    '<',
+    18.467
+);
+
+select * from extensions.finish();
+rollback;
@@ -0,0 +1,10 @@
+begin;
+
+select extensions.plan(4);
+select extensions.has_function('public', 'resolve_secrets', array['uuid']);
+select extensions.has_function('private', 'decrypt_secret', array['uuid']);
+select extensions.has_function('private', 'can_read_environment', array['uuid']);
+select extensions.is_definer('private', 'decrypt_secret', array['uuid']);
+
+select * from extensions.finish();
+rollback;
@@ -0,0 +1,13 @@
+begin;
+
+select extensions.plan(5);
+select extensions.has_function('public', 'rotate_secrets', array['uuid', 'uuid', 'generator[]', 'jsonb']);
+select extensions.has_function('private', 'rotate_secrets', array['uuid', 'uuid', 'generator[]', 'jsonb']);
+select extensions.is_definer('private', 'rotate_secrets', array['uuid', 'uuid', 'generator[]', 'jsonb']);
+select extensions.hasnt_function('public', 'rotate_secrets', array['uuid', 'uuid', 'generator[]']);
+
+-- only admins rotate
+select extensions.throws_ok($$ select * from public.rotate_secrets('00000000-0000-0000-0000-000000000000'::uuid) $$, 'unauthorized');
+
+select * from extensions.finish();
+rollback;
\ No newline at end of file
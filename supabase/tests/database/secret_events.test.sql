@@ -0,0 +1,18 @@
+begin;
+
+select extensions.plan(10);
+select extensions.has_table('private', 'secret_events', 'secret events are not exposed through postgrest');
+select extensions.hasnt_column('private', 'secret_events', 'value', 'secret events never carry values');
+select extensions.has_column('private', 'secret_events', 'xact_id', 'secret events are served in commit order');
+select extensions.has_function('public', 'secret_events', array['uuid', 'bigint', 'integer']);
+select extensions.has_function('public', 'secret_events_cursor', array['uuid']);
+select extensions.has_trigger('public', 'secrets', 'secrets_notify');
+select extensions.has_trigger('public', 'variables', 'variables_notify');
+select extensions.has_trigger('private', 'secret_events', 'secret_events_after_actions');
+
+-- events have the same visibility as the secrets themselves
+select extensions.throws_ok($$ select * from public.secret_events('00000000-0000-0000-0000-000000000000'::uuid) $$, 'unauthorized');
+select extensions.throws_ok($$ select * from public.secret_events() $$, '22023');
+
+select * from extensions.finish();
+rollback;
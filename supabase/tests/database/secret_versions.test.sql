@@ -0,0 +1,42 @@
+begin;
+
+select extensions.plan(12);
+select extensions.has_table('private', 'secret_versions', 'secret versions are not exposed through postgrest');
+select extensions.hasnt_column('private', 'secret_versions', 'value', 'secret versions are never stored in plaintext');
+select extensions.has_function('public', 'secret_versions', array['uuid', 'uuid']);
+select extensions.has_function('public', 'rollback_secret', array['uuid', 'uuid', 'integer']);
+select extensions.is_definer('private', 'rollback_secret', array['uuid', 'uuid', 'integer']);
+select extensions.is_definer('private', 'write_secret', array['uuid', 'text', 'integer', 'boolean']);
+select extensions.has_trigger('public', 'secrets', 'secrets_after_actions');
+select extensions.has_column('public', 'secrets', 'overridden', 'secrets know whether their value was written by hand');
+select extensions.has_column('private', 'secret_versions', 'overridden', 'versions know whether their value was written by hand');
+
+-- every secret starts with a version (a pending one with the keys the server writes)
+select extensions.is_empty($$
+    select s.id from public.secrets s
+    where not s.pending
+      and not exists (select 1 from private.secret_versions sv where sv.secret_id = s.id and sv.version = 1)
+$$);
+
+-- a rolled back value is checked against the variable as it is now
+insert into public.projects (id, display) values ('00000000-0000-0000-0000-0000000000d0', 'secret versions test');
+insert into public.variables (id, key, description, project_id, generator_type, generator_data) values
+    ('00000000-0000-0000-0000-0000000000d1', 'PORT', '', '00000000-0000-0000-0000-0000000000d0', 'STATIC', '{"secret": "8080"}');
+insert into public.environments (id, display, project_id) values ('00000000-0000-0000-0000-0000000000de', 'secret versions test', '00000000-0000-0000-0000-0000000000d0');
+
+select set_config('projconf.x_admin_api_key', 'secret-versions-test-key', true);
+select set_config('request.headers', '{"x-admin-api-key": "secret-versions-test-key"}', true);
+
+select * from public.set_secret('00000000-0000-0000-0000-0000000000de', '00000000-0000-0000-0000-0000000000d1', 'eighty');
+update public.variables set value_type = 'INT' where id = '00000000-0000-0000-0000-0000000000d1';
+
+select extensions.throws_ok(
+    $$ select * from public.rollback_secret('00000000-0000-0000-0000-0000000000de', '00000000-0000-0000-0000-0000000000d1', 2) $$,
+    '22023'
+);
+select extensions.lives_ok(
+    $$ select * from public.rollback_secret('00000000-0000-0000-0000-0000000000de', '00000000-0000-0000-0000-0000000000d1', 1) $$
+);
+
+select * from extensions.finish();
+rollback;
@@ -0,0 +1,31 @@
+begin;
+
+select extensions.plan(8);
+select extensions.has_column('public', 'variables', 'sensitive');
+select extensions.col_default_is('public', 'variables', 'sensitive', 'true');
+select extensions.hasnt_function('public', 'variable_tags', array['uuid']);
+select extensions.has_function('public', 'reveal_secrets', array['uuid', 'uuid[]']);
+select extensions.is_definer('public', 'reveal_secrets', array['uuid', 'uuid[]']);
+select extensions.is_definer('private', 'write_secret', array['uuid', 'text', 'integer', 'boolean']);
+
+-- a project with a sensitive variable, and a variable that is not sensitive but interpolates it
+insert into public.projects (id, display) values ('00000000-0000-0000-0000-0000000005e0', 'sensitive test');
+insert into public.variables (id, key, description, project_id, generator_type, generator_data, sensitive) values
+    ('00000000-0000-0000-0000-0000000005e1', 'DB_PASSWORD', '', '00000000-0000-0000-0000-0000000005e0', 'STATIC', '{"secret": "s3cret"}', true),
+    ('00000000-0000-0000-0000-0000000005e2', 'DATABASE_URL', '', '00000000-0000-0000-0000-0000000005e0', 'STATIC', '{"secret": "postgres://app:${DB_PASSWORD}@db/app"}', false);
+insert into public.environments (id, display, project_id) values ('00000000-0000-0000-0000-0000000005ee', 'sensitive test', '00000000-0000-0000-0000-0000000005e0');
+
+select set_config('projconf.x_admin_api_key', 'sensitive-test-key', true);
+select set_config('request.headers', '{"x-admin-api-key": "sensitive-test-key"}', true);
+
+-- revealing the interpolated value is audited, though its variable is not sensitive itself
+select extensions.lives_ok(
+    $$ select public.reveal_secrets('00000000-0000-0000-0000-0000000005ee', array['00000000-0000-0000-0000-0000000005e2']::uuid[]) $$
+);
+select extensions.results_eq(
+    $$ select action, details from private.audit_log where environment_id = '00000000-0000-0000-0000-0000000005ee' and action = 'reveal' $$,
+    $$ values ('reveal'::text, '{"variables": ["DATABASE_URL"]}'::jsonb) $$
+);
+
+select * from extensions.finish();
+rollback;
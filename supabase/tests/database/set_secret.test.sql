@@ -0,0 +1,9 @@
+begin;
+
+select extensions.plan(3);
+select extensions.has_function('public', 'set_secret', array['uuid', 'uuid', 'text']);
+select extensions.has_function('private', 'set_secret', array['uuid', 'uuid', 'text']);
+select extensions.is_definer('private', 'set_secret', array['uuid', 'uuid', 'text']);
+
+select * from extensions.finish();
+rollback;
\ No newline at end of file
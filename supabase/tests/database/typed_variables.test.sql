@@ -0,0 +1,28 @@
+begin;
+
+select extensions.plan(18);
+select extensions.has_column('public', 'variables', 'value_type');
+select extensions.has_column('public', 'variables', 'value_constraints');
+select extensions.has_function('public', 'validate_secrets', array['uuid', 'jsonb']);
+select extensions.is_definer('public', 'validate_secrets', array['uuid', 'jsonb']);
+
+-- constraints
+select extensions.ok(private.is_valid_value_constraints('STRING', '{}'));
+select extensions.ok(private.is_valid_value_constraints('INT', '{"min": 1, "max": 65535}'));
+select extensions.ok(not private.is_valid_value_constraints('INT', '{"min": 10, "max": 1}'));
+select extensions.ok(not private.is_valid_value_constraints('ENUM', '{}'));
+select extensions.ok(not private.is_valid_value_constraints('REGEX', '{"pattern": "("}'));
+select extensions.ok(not private.is_valid_value_constraints('BOOL', '{"min": 1}'));
+
+-- values
+select extensions.is(private.value_violation('INT', '{"min": 1, "max": 65535}', '80800'), 'must be at most 65535');
+select extensions.is(private.value_violation('INT', '{}', '8080'), null);
+select extensions.is(private.value_violation('BOOL', '{}', 'yes'), 'must be true or false');
+select extensions.is(private.value_violation('URL', '{"schemes": ["https"]}', 'https://example.com/path'), null);
+select extensions.isnt(private.value_violation('URL', '{"schemes": ["https"]}', 'http://example.com'), null);
+select extensions.isnt(private.value_violation('ENUM', '{"values": ["debug", "info"]}', 'trace'), null);
+select extensions.is(private.value_violation('REGEX', '{"pattern": "[a-z]+"}', 'abc'), null);
+select extensions.isnt(private.value_violation('JSON', '{}', '{"a": '), null);
+
+select * from extensions.finish();
+rollback;
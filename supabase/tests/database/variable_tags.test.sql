@@ -0,0 +1,16 @@
+begin;
+
+select extensions.plan(9);
+select extensions.has_column('public', 'variables', 'tags');
+select extensions.has_column('public', 'variables', 'owner');
+select extensions.has_index('public', 'variables', 'variables_tags_idx');
+select extensions.has_function('public', 'variable_metadata', array['uuid']);
+select extensions.is_definer('public', 'variable_metadata', array['uuid']);
+
+select extensions.ok(private.is_valid_variable_tags('{}'));
+select extensions.ok(private.is_valid_variable_tags('{billing,service:api,tier=1}'));
+select extensions.ok(not private.is_valid_variable_tags('{Billing}'));
+select extensions.ok(not private.is_valid_variable_tags('{billing,billing}'));
+
+select * from extensions.finish();
+rollback;
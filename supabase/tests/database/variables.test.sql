@@ -0,0 +1,10 @@
+begin;
+
+select extensions.plan(4);
+select extensions.has_function('public', 'regenerate_secrets', array['uuid']);
+select extensions.has_function('private', 'regenerate_variable_secrets', array['uuid']);
+select extensions.is_definer('private', 'regenerate_variable_secrets', array['uuid']);
+select extensions.is_definer('private', 'variables_before_actions', array[]::text[]);
+
+select * from extensions.finish();
+rollback;
\ No newline at end of file